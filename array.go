@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -36,6 +38,7 @@ func ArrayWithKey[E Event, P Parent[E]](p P, key string) (arr *ArrayBuilder[E, P
 	if p.Enabled() {
 		arr = (*ArrayBuilder[E, P])(refPoolGet())
 		arr.a = p
+		arr.schema = p.Root().fieldSchema()
 		if arr.jsonMustUseDefault() {
 			arr.b = (defaultJSONSupport[E]{}).NewArray()
 		} else {
@@ -272,6 +275,138 @@ func (x *Context[E]) arrRawJSON(arr any, val json.RawMessage) (any, bool) {
 	return arr, false
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrInt8(arr any, val int8) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendInt8() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendInt8(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrInt16(arr any, val int16) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendInt16() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendInt16(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrInt32(arr any, val int32) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendInt32() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendInt32(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrUint(arr any, val uint) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendUint() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendUint(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrUint8(arr any, val uint8) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendUint8() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendUint8(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrUint16(arr any, val uint16) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendUint16() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendUint16(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrUint32(arr any, val uint32) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendUint32() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendUint32(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrIPAddr(arr any, val netip.Addr) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendIPAddr() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendIPAddr(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrIPPrefix(arr any, val netip.Prefix) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendIPPrefix() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendIPPrefix(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrURL(arr any, val *url.URL) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendURL() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendURL(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) arrHexBytes(arr any, val []byte) (any, bool) {
+	if x.logger.shared.json.iface.CanAppendHexBytes() {
+		a := arr.(*contextFieldData[E])
+		a.values = append(a.values, func(shared *loggerShared[E], arr any) any {
+			return shared.json.appendHexBytes(arr, val)
+		})
+		return arr, true
+	}
+	return arr, false
+}
+
 // Builder
 // ---
 // Uses the jsonSupport directly.
@@ -423,6 +558,94 @@ func (x *Builder[E]) arrRawJSON(arr any, val json.RawMessage) (any, bool) {
 	return arr, false
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrInt8(arr any, val int8) (any, bool) {
+	if x.shared.json.iface.CanAppendInt8() {
+		return x.shared.json.appendInt8(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrInt16(arr any, val int16) (any, bool) {
+	if x.shared.json.iface.CanAppendInt16() {
+		return x.shared.json.appendInt16(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrInt32(arr any, val int32) (any, bool) {
+	if x.shared.json.iface.CanAppendInt32() {
+		return x.shared.json.appendInt32(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrUint(arr any, val uint) (any, bool) {
+	if x.shared.json.iface.CanAppendUint() {
+		return x.shared.json.appendUint(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrUint8(arr any, val uint8) (any, bool) {
+	if x.shared.json.iface.CanAppendUint8() {
+		return x.shared.json.appendUint8(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrUint16(arr any, val uint16) (any, bool) {
+	if x.shared.json.iface.CanAppendUint16() {
+		return x.shared.json.appendUint16(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrUint32(arr any, val uint32) (any, bool) {
+	if x.shared.json.iface.CanAppendUint32() {
+		return x.shared.json.appendUint32(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrIPAddr(arr any, val netip.Addr) (any, bool) {
+	if x.shared.json.iface.CanAppendIPAddr() {
+		return x.shared.json.appendIPAddr(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrIPPrefix(arr any, val netip.Prefix) (any, bool) {
+	if x.shared.json.iface.CanAppendIPPrefix() {
+		return x.shared.json.appendIPPrefix(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrURL(arr any, val *url.URL) (any, bool) {
+	if x.shared.json.iface.CanAppendURL() {
+		return x.shared.json.appendURL(arr, val), true
+	}
+	return arr, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) arrHexBytes(arr any, val []byte) (any, bool) {
+	if x.shared.json.iface.CanAppendHexBytes() {
+		return x.shared.json.appendHexBytes(arr, val), true
+	}
+	return arr, false
+}
+
 // ArrayBuilder
 // ---
 // Uses the same array types as its parent, UNLESS its immediate parent is an
@@ -509,6 +732,76 @@ func (x *ArrayBuilder[E, P]) Int(val int) *ArrayBuilder[E, P] {
 	return x
 }
 
+func (x *ArrayBuilder[E, P]) Int8(val int8) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrInt8(x.b, val); !ok {
+			_ = x.methods().Int8(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Int16(val int16) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrInt16(x.b, val); !ok {
+			_ = x.methods().Int16(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Int32(val int32) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrInt32(x.b, val); !ok {
+			_ = x.methods().Int32(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Uint(val uint) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrUint(x.b, val); !ok {
+			_ = x.methods().Uint(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Uint8(val uint8) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrUint8(x.b, val); !ok {
+			_ = x.methods().Uint8(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Uint16(val uint16) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrUint16(x.b, val); !ok {
+			_ = x.methods().Uint16(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Uint32(val uint32) *ArrayBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.arrUint32(x.b, val); !ok {
+			_ = x.methods().Uint32(x.fields(), ``, val)
+		}
+	}
+	return x
+}
+
 func (x *ArrayBuilder[E, P]) Float32(val float32) *ArrayBuilder[E, P] {
 	_ = x.methods().Float32(x.fields(), ``, val)
 	return x
@@ -554,6 +847,26 @@ func (x *ArrayBuilder[E, P]) RawJSON(val json.RawMessage) *ArrayBuilder[E, P] {
 	return x
 }
 
+func (x *ArrayBuilder[E, P]) IPAddr(val netip.Addr) *ArrayBuilder[E, P] {
+	_ = x.methods().IPAddr(x.fields(), ``, val)
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) IPPrefix(val netip.Prefix) *ArrayBuilder[E, P] {
+	_ = x.methods().IPPrefix(x.fields(), ``, val)
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) URL(val *url.URL) *ArrayBuilder[E, P] {
+	_ = x.methods().URL(x.fields(), ``, val)
+	return x
+}
+
+func (x *ArrayBuilder[E, P]) Hex(val []byte) *ArrayBuilder[E, P] {
+	_ = x.methods().Hex(x.fields(), ``, val)
+	return x
+}
+
 func (x *ArrayBuilder[E, P]) as(key string) any {
 	return x.As(key)
 }
@@ -563,11 +876,17 @@ func (x *ArrayBuilder[E, P]) isArrayBuilder() *refPoolItem {
 }
 
 func (x *ArrayBuilder[E, P]) jsonMustUseDefault() bool {
+	return x.jsonMustUseDefaultFor(x.p().jsonSupport())
+}
+
+// jsonMustUseDefaultFor implements jsonMustUseDefault, given the parent's
+// JSON support, which avoids resolving it more than once, per level
+func (x *ArrayBuilder[E, P]) jsonMustUseDefaultFor(parent iJSONSupport[E]) bool {
 	switch getParentJSONType(x.a) {
 	case parentJSONTypeArray:
-		return !x.p().jsonSupport().CanAppendArray()
+		return !parent.CanAppendArray()
 	case parentJSONTypeObject:
-		return !x.p().jsonSupport().CanSetArray()
+		return !parent.CanSetArray()
 	default:
 		return false
 	}
@@ -580,10 +899,11 @@ func (x *ArrayBuilder[E, P]) Root() *Logger[E] {
 
 //lint:ignore U1000 it is or will be used
 func (x *ArrayBuilder[E, P]) jsonSupport() iJSONSupport[E] {
-	if x.jsonMustUseDefault() {
+	parent := x.p().jsonSupport()
+	if x.jsonMustUseDefaultFor(parent) {
 		return defaultJSONSupport[E]{}
 	}
-	return x.p().jsonSupport()
+	return parent
 }
 
 //lint:ignore U1000 it is or will be used
@@ -743,6 +1063,94 @@ func (x *ArrayBuilder[E, P]) objRawJSON(obj any, key string, val json.RawMessage
 	return x.p().objRawJSON(obj, key, val)
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objInt8(obj any, key string, val int8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objInt16(obj any, key string, val int16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objInt32(obj any, key string, val int32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objUint(obj any, key string, val uint) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objUint8(obj any, key string, val uint8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objUint16(obj any, key string, val uint16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objUint32(obj any, key string, val uint32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objIPAddr(obj any, key string, val netip.Addr) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objIPAddr(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objIPPrefix(obj any, key string, val netip.Prefix) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objIPPrefix(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objURL(obj any, key string, val *url.URL) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objURL(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) objHexBytes(obj any, key string, val []byte) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objHexBytes(obj, key, val)
+}
+
 //lint:ignore U1000 it is or will be used
 func (x *ArrayBuilder[E, P]) jsonNewArray(key string) any {
 	if key != `` {
@@ -899,6 +1307,94 @@ func (x *ArrayBuilder[E, P]) arrRawJSON(arr any, val json.RawMessage) (any, bool
 	return x.p().arrRawJSON(arr, val)
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrInt8(arr any, val int8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrInt16(arr any, val int16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrInt32(arr any, val int32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrUint(arr any, val uint) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrUint8(arr any, val uint8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrUint16(arr any, val uint16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrUint32(arr any, val uint32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrIPAddr(arr any, val netip.Addr) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrIPAddr(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrIPPrefix(arr any, val netip.Prefix) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrIPPrefix(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrURL(arr any, val *url.URL) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrURL(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ArrayBuilder[E, P]) arrHexBytes(arr any, val []byte) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrHexBytes(arr, val)
+}
+
 func (x *contextFieldData[E]) array(event E) error {
 	arr := x.shared.json.addStartOrNewArray(event, x.key)
 	for _, v := range x.values {
@@ -3,6 +3,8 @@ package logiface
 import (
 	"encoding/base64"
 	"encoding/json"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -12,7 +14,11 @@ type (
 )
 
 func (x *ArrayBuilder[E, P]) methods() modifierMethods[*arrayFields[E, P]] {
-	return modifierMethods[*arrayFields[E, P]]{}
+	if x == nil {
+		// nil builders are returned by disabled loggers, and must be no-ops
+		return modifierMethods[*arrayFields[E, P]]{}
+	}
+	return modifierMethods[*arrayFields[E, P]]{schema: x.schema}
 }
 
 func (x *ArrayBuilder[E, P]) fields() *arrayFields[E, P] {
@@ -99,6 +105,44 @@ func (x *arrayFields[E, P]) AddRawJSON(_ string, val json.RawMessage) (ok bool)
 	return
 }
 
+func (x *arrayFields[E, P]) AddIPAddr(_ string, val netip.Addr) (ok bool) {
+	x.b, ok = x.builder().arrIPAddr(x.b, val)
+	return
+}
+
+func (x *arrayFields[E, P]) AddIPPrefix(_ string, val netip.Prefix) (ok bool) {
+	x.b, ok = x.builder().arrIPPrefix(x.b, val)
+	return
+}
+
+func (x *arrayFields[E, P]) AddURL(_ string, val *url.URL) (ok bool) {
+	x.b, ok = x.builder().arrURL(x.b, val)
+	return
+}
+
+func (x *arrayFields[E, P]) AddHexBytes(_ string, val []byte) (ok bool) {
+	x.b, ok = x.builder().arrHexBytes(x.b, val)
+	return
+}
+
 func (x *arrayFields[E, P]) AddGroup(_ string) bool { return false }
 
+func (x *arrayFields[E, P]) AddStrings(string, []string) bool { return false }
+
+func (x *arrayFields[E, P]) AddInts(string, []int) bool { return false }
+
+func (x *arrayFields[E, P]) AddInt64s(string, []int64) bool { return false }
+
+func (x *arrayFields[E, P]) AddFloat64s(string, []float64) bool { return false }
+
+func (x *arrayFields[E, P]) AddBools(string, []bool) bool { return false }
+
+func (x *arrayFields[E, P]) AddDurations(string, []time.Duration) bool { return false }
+
+func (x *arrayFields[E, P]) AddTimes(string, []time.Time) bool { return false }
+
+func (x *arrayFields[E, P]) AddErrors(string, []error) bool { return false }
+
+func (x *arrayFields[E, P]) AddTimestamp(string, time.Time) bool { return false }
+
 func (x *arrayFields[E, P]) mustEmbedUnimplementedEvent() {}
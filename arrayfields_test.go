@@ -6,6 +6,8 @@ import (
 	"os"
 	"testing"
 	"time"
+
+	"github.com/joeycumines/logiface/internal/fieldtest"
 )
 
 var (
@@ -40,7 +42,7 @@ func ExampleArrayBuilder_defaultFieldFormats() {
 	//str=[(string)str val]
 	//bools=[(bool)true (bool)false]
 	//map=[(map[int]float64)map[1:1 2:0.5 3:0.3333333333333333]]
-	//err=[(*errors.errorString)some error]
+	//err=[(string)some error]
 	//int=[(int)123]
 	//uint64=[(string)123]
 	//int64=[(string)123]
@@ -69,3 +71,21 @@ func TestArrayFields_Level_nil(t *testing.T) {
 		t.Error(v)
 	}
 }
+
+func TestArrayBuilder_disabled(t *testing.T) {
+	logger := mockL.New(mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)))
+	for _, b := range [...]*Builder[*mockSimpleEvent]{
+		nil,
+		logger.Debug(),
+		(*Logger[*mockSimpleEvent])(nil).Info(),
+	} {
+		arr := Array[*mockSimpleEvent](b)
+		if arr != nil {
+			t.Fatal(arr)
+		}
+		fieldtest.FluentArrayTemplate(arr)
+		if v := arr.Object().Str(`k`, `v`).As(``).Array().Int(1).As(``).End(); v != nil {
+			t.Error(v)
+		}
+	}
+}
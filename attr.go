@@ -0,0 +1,477 @@
+package logiface
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/netip"
+	"net/url"
+	"slices"
+	"time"
+)
+
+type (
+	// Attr is a pre-built structured log field, which may be constructed
+	// once, e.g. using [AttrStr] or [AttrInt], then added to any number of events,
+	// using [Builder.With], [Context.With], or [Logger.With].
+	//
+	// Attr values are independent of the Event type, and are applied using
+	// the equivalent (typed) method, e.g. [Builder.Str], including the same
+	// fallback behavior. The zero value is ignored.
+	//
+	// The constructors are prefixed (e.g. AttrStr, rather than Str), as the
+	// package already exports [Array] and [Object], which construct builders,
+	// and names such as Err would be confused with the level methods, e.g.
+	// [Logger.Err].
+	Attr struct {
+		key  string
+		str  string
+		any  any
+		num  uint64
+		kind attrKind
+	}
+
+	attrKind int8
+
+	attrBase64 struct {
+		b   []byte
+		enc *base64.Encoding
+	}
+)
+
+const (
+	attrKindNone attrKind = iota
+	attrKindField
+	attrKindInterface
+	attrKindErr
+	attrKindStr
+	attrKindInt
+	attrKindInt64
+	attrKindUint64
+	attrKindFloat64
+	attrKindBool
+	attrKindTime
+	attrKindDur
+	attrKindInt8
+	attrKindInt16
+	attrKindInt32
+	attrKindUint
+	attrKindUint8
+	attrKindUint16
+	attrKindUint32
+	attrKindIPAddr
+	attrKindIPPrefix
+	attrKindURL
+	attrKindHex
+	attrKindFloat32
+	attrKindBase64
+	attrKindRawJSON
+	attrKindStringer
+	// slice kinds, which are handled by sliceAttr
+	attrKindStrs
+	attrKindInts
+	attrKindInt64s
+	attrKindFloat64s
+	attrKindBools
+	attrKindDurs
+	attrKindTimes
+	attrKindErrs
+)
+
+// AttrField returns an [Attr] for an arbitrary value, per [Builder.Field].
+func AttrField(key string, val any) Attr {
+	return Attr{key: key, any: val, kind: attrKindField}
+}
+
+// AttrAny returns an [Attr] for an arbitrary value, per [Builder.Interface].
+func AttrAny(key string, val any) Attr {
+	return Attr{key: key, any: val, kind: attrKindInterface}
+}
+
+// AttrErr returns an [Attr] for an error, per [Builder.Err], which determines
+// the key.
+func AttrErr(err error) Attr {
+	return Attr{any: err, kind: attrKindErr}
+}
+
+// AttrStr returns an [Attr] for a string, per [Builder.Str].
+func AttrStr(key string, val string) Attr {
+	return Attr{key: key, str: val, kind: attrKindStr}
+}
+
+// AttrInt returns an [Attr] for an int, per [Builder.Int].
+func AttrInt(key string, val int) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindInt}
+}
+
+// AttrInt64 returns an [Attr] for an int64, per [Builder.Int64].
+func AttrInt64(key string, val int64) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindInt64}
+}
+
+// AttrUint64 returns an [Attr] for a uint64, per [Builder.Uint64].
+func AttrUint64(key string, val uint64) Attr {
+	return Attr{key: key, num: val, kind: attrKindUint64}
+}
+
+// AttrFloat64 returns an [Attr] for a float64, per [Builder.Float64].
+func AttrFloat64(key string, val float64) Attr {
+	return Attr{key: key, num: math.Float64bits(val), kind: attrKindFloat64}
+}
+
+// AttrBool returns an [Attr] for a bool, per [Builder.Bool].
+func AttrBool(key string, val bool) Attr {
+	var num uint64
+	if val {
+		num = 1
+	}
+	return Attr{key: key, num: num, kind: attrKindBool}
+}
+
+// AttrTime returns an [Attr] for a time.Time, per [Builder.Time].
+func AttrTime(key string, val time.Time) Attr {
+	return Attr{key: key, any: val, kind: attrKindTime}
+}
+
+// AttrDur returns an [Attr] for a time.Duration, per [Builder.Dur].
+func AttrDur(key string, val time.Duration) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindDur}
+}
+
+// AttrInt8 returns an [Attr] for an int8, per [Builder.Int8].
+func AttrInt8(key string, val int8) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindInt8}
+}
+
+// AttrInt16 returns an [Attr] for an int16, per [Builder.Int16].
+func AttrInt16(key string, val int16) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindInt16}
+}
+
+// AttrInt32 returns an [Attr] for an int32, per [Builder.Int32].
+func AttrInt32(key string, val int32) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindInt32}
+}
+
+// AttrUint returns an [Attr] for a uint, per [Builder.Uint].
+func AttrUint(key string, val uint) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindUint}
+}
+
+// AttrUint8 returns an [Attr] for a uint8, per [Builder.Uint8].
+func AttrUint8(key string, val uint8) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindUint8}
+}
+
+// AttrUint16 returns an [Attr] for a uint16, per [Builder.Uint16].
+func AttrUint16(key string, val uint16) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindUint16}
+}
+
+// AttrUint32 returns an [Attr] for a uint32, per [Builder.Uint32].
+func AttrUint32(key string, val uint32) Attr {
+	return Attr{key: key, num: uint64(val), kind: attrKindUint32}
+}
+
+// AttrIPAddr returns an [Attr] for a netip.Addr, per [Builder.IPAddr].
+func AttrIPAddr(key string, val netip.Addr) Attr {
+	return Attr{key: key, any: val, kind: attrKindIPAddr}
+}
+
+// AttrIPPrefix returns an [Attr] for a netip.Prefix, per [Builder.IPPrefix].
+func AttrIPPrefix(key string, val netip.Prefix) Attr {
+	return Attr{key: key, any: val, kind: attrKindIPPrefix}
+}
+
+// AttrURL returns an [Attr] for a *url.URL, per [Builder.URL].
+func AttrURL(key string, val *url.URL) Attr {
+	return Attr{key: key, any: val, kind: attrKindURL}
+}
+
+// AttrHex returns an [Attr] for bytes, per [Builder.Hex]. The bytes are not
+// copied.
+func AttrHex(key string, val []byte) Attr {
+	return Attr{key: key, any: val, kind: attrKindHex}
+}
+
+// AttrFloat32 returns an [Attr] for a float32, per [Builder.Float32].
+func AttrFloat32(key string, val float32) Attr {
+	return Attr{key: key, num: uint64(math.Float32bits(val)), kind: attrKindFloat32}
+}
+
+// AttrBase64 returns an [Attr] for bytes, per [Builder.Base64]. The bytes are
+// not copied.
+func AttrBase64(key string, b []byte, enc *base64.Encoding) Attr {
+	return Attr{key: key, any: attrBase64{b: b, enc: enc}, kind: attrKindBase64}
+}
+
+// AttrRawJSON returns an [Attr] for pre-encoded JSON, per [Builder.RawJSON].
+// The bytes are not copied.
+func AttrRawJSON(key string, val json.RawMessage) Attr {
+	return Attr{key: key, any: val, kind: attrKindRawJSON}
+}
+
+// AttrStringer returns an [Attr] for a fmt.Stringer, per [Builder.Stringer].
+// The String method is called each time the attr is applied.
+func AttrStringer(key string, val fmt.Stringer) Attr {
+	return Attr{key: key, any: val, kind: attrKindStringer}
+}
+
+// AttrStrs returns an [Attr] for a []string, per [Builder.Strs]. The slice is
+// not copied.
+func AttrStrs(key string, val []string) Attr {
+	return Attr{key: key, any: val, kind: attrKindStrs}
+}
+
+// AttrInts returns an [Attr] for a []int, per [Builder.Ints]. The slice is
+// not copied.
+func AttrInts(key string, val []int) Attr {
+	return Attr{key: key, any: val, kind: attrKindInts}
+}
+
+// AttrInt64s returns an [Attr] for a []int64, per [Builder.Int64s]. The slice
+// is not copied.
+func AttrInt64s(key string, val []int64) Attr {
+	return Attr{key: key, any: val, kind: attrKindInt64s}
+}
+
+// AttrFloat64s returns an [Attr] for a []float64, per [Builder.Float64s]. The
+// slice is not copied.
+func AttrFloat64s(key string, val []float64) Attr {
+	return Attr{key: key, any: val, kind: attrKindFloat64s}
+}
+
+// AttrBools returns an [Attr] for a []bool, per [Builder.Bools]. The slice is
+// not copied.
+func AttrBools(key string, val []bool) Attr {
+	return Attr{key: key, any: val, kind: attrKindBools}
+}
+
+// AttrDurs returns an [Attr] for a []time.Duration, per [Builder.Durs]. The
+// slice is not copied.
+func AttrDurs(key string, val []time.Duration) Attr {
+	return Attr{key: key, any: val, kind: attrKindDurs}
+}
+
+// AttrTimes returns an [Attr] for a []time.Time, per [Builder.Times]. The
+// slice is not copied.
+func AttrTimes(key string, val []time.Time) Attr {
+	return Attr{key: key, any: val, kind: attrKindTimes}
+}
+
+// AttrErrs returns an [Attr] for a []error, per [Builder.Errs]. The slice is
+// not copied.
+func AttrErrs(key string, val []error) Attr {
+	return Attr{key: key, any: val, kind: attrKindErrs}
+}
+
+// Key returns the key of the field, which will be empty for [AttrErr].
+func (x Attr) Key() string {
+	return x.key
+}
+
+// Value returns the value of the field, as it would be passed to the
+// relevant method, e.g. a string for [AttrStr], or nil for the zero value.
+// For [AttrBase64], only the bytes are returned.
+func (x Attr) Value() any {
+	switch x.kind {
+	case attrKindStr:
+		return x.str
+	case attrKindInt:
+		return int(int64(x.num))
+	case attrKindInt64:
+		return int64(x.num)
+	case attrKindUint64:
+		return x.num
+	case attrKindFloat64:
+		return math.Float64frombits(x.num)
+	case attrKindBool:
+		return x.num != 0
+	case attrKindDur:
+		return time.Duration(x.num)
+	case attrKindInt8:
+		return int8(x.num)
+	case attrKindInt16:
+		return int16(x.num)
+	case attrKindInt32:
+		return int32(x.num)
+	case attrKindUint:
+		return uint(x.num)
+	case attrKindUint8:
+		return uint8(x.num)
+	case attrKindUint16:
+		return uint16(x.num)
+	case attrKindUint32:
+		return uint32(x.num)
+	case attrKindFloat32:
+		return math.Float32frombits(uint32(x.num))
+	case attrKindBase64:
+		return x.any.(attrBase64).b
+	default:
+		return x.any
+	}
+}
+
+func (x modifierMethods[E]) attr(event E, attr Attr) error {
+	switch attr.kind {
+	case attrKindField:
+		return x.Field(event, attr.key, attr.any)
+	case attrKindInterface:
+		return x.Interface(event, attr.key, attr.any)
+	case attrKindErr:
+		err, _ := attr.any.(error)
+		return x.Err(event, err)
+	case attrKindStr:
+		return x.Str(event, attr.key, attr.str)
+	case attrKindInt:
+		return x.Int(event, attr.key, int(int64(attr.num)))
+	case attrKindInt64:
+		return x.Int64(event, attr.key, int64(attr.num))
+	case attrKindUint64:
+		return x.Uint64(event, attr.key, attr.num)
+	case attrKindFloat64:
+		return x.Float64(event, attr.key, math.Float64frombits(attr.num))
+	case attrKindBool:
+		return x.Bool(event, attr.key, attr.num != 0)
+	case attrKindTime:
+		val, _ := attr.any.(time.Time)
+		return x.Time(event, attr.key, val)
+	case attrKindDur:
+		return x.Dur(event, attr.key, time.Duration(attr.num))
+	case attrKindInt8:
+		return x.Int8(event, attr.key, int8(attr.num))
+	case attrKindInt16:
+		return x.Int16(event, attr.key, int16(attr.num))
+	case attrKindInt32:
+		return x.Int32(event, attr.key, int32(attr.num))
+	case attrKindUint:
+		return x.Uint(event, attr.key, uint(attr.num))
+	case attrKindUint8:
+		return x.Uint8(event, attr.key, uint8(attr.num))
+	case attrKindUint16:
+		return x.Uint16(event, attr.key, uint16(attr.num))
+	case attrKindUint32:
+		return x.Uint32(event, attr.key, uint32(attr.num))
+	case attrKindIPAddr:
+		val, _ := attr.any.(netip.Addr)
+		return x.IPAddr(event, attr.key, val)
+	case attrKindIPPrefix:
+		val, _ := attr.any.(netip.Prefix)
+		return x.IPPrefix(event, attr.key, val)
+	case attrKindURL:
+		val, _ := attr.any.(*url.URL)
+		return x.URL(event, attr.key, val)
+	case attrKindHex:
+		val, _ := attr.any.([]byte)
+		return x.Hex(event, attr.key, val)
+	case attrKindFloat32:
+		return x.Float32(event, attr.key, math.Float32frombits(uint32(attr.num)))
+	case attrKindBase64:
+		val, _ := attr.any.(attrBase64)
+		return x.Base64(event, attr.key, val.b, val.enc)
+	case attrKindRawJSON:
+		val, _ := attr.any.(json.RawMessage)
+		return x.RawJSON(event, attr.key, val)
+	case attrKindStringer:
+		val, _ := attr.any.(fmt.Stringer)
+		return x.Stringer(event, attr.key, val)
+	default:
+		return nil
+	}
+}
+
+func (x modifierMethods[E]) With(event E, attrs []Attr) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	for _, attr := range attrs {
+		if err := x.attr(event, attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sliceAttr adds a slice kind [Attr], using the receiver's method, e.g.
+// [Builder.Strs], as the fallback array requires the logger's state
+func sliceAttr[T interface {
+	Strs(key string, val []string) T
+	Ints(key string, val []int) T
+	Int64s(key string, val []int64) T
+	Float64s(key string, val []float64) T
+	Bools(key string, val []bool) T
+	Durs(key string, val []time.Duration) T
+	Times(key string, val []time.Time) T
+	Errs(key string, val []error) T
+}](x T, attr Attr) {
+	switch val := attr.any.(type) {
+	case []string:
+		x.Strs(attr.key, val)
+	case []int:
+		x.Ints(attr.key, val)
+	case []int64:
+		x.Int64s(attr.key, val)
+	case []float64:
+		x.Float64s(attr.key, val)
+	case []bool:
+		x.Bools(attr.key, val)
+	case []time.Duration:
+		x.Durs(attr.key, val)
+	case []time.Time:
+		x.Times(attr.key, val)
+	case []error:
+		x.Errs(attr.key, val)
+	}
+}
+
+// withAttrs calls scalar for each run of non-slice attrs, and slice for each
+// slice attr, preserving order
+func withAttrs(attrs []Attr, scalar func(attrs []Attr), slice func(attr Attr)) {
+	for len(attrs) != 0 {
+		i := slices.IndexFunc(attrs, func(attr Attr) bool { return attr.kind >= attrKindStrs })
+		if i == -1 {
+			scalar(attrs)
+			return
+		}
+		if i != 0 {
+			scalar(attrs[:i])
+		}
+		slice(attrs[i])
+		attrs = attrs[i+1:]
+	}
+}
+
+// With adds each of the given [Attr] values, as structured log fields, in
+// order. The attrs slice is copied, and may be reused by the caller.
+func (x *Context[E]) With(attrs ...Attr) *Context[E] {
+	if x.Enabled() {
+		withAttrs(attrs, func(attrs []Attr) {
+			attrs = slices.Clone(attrs)
+			x.add(func(event E) error { return x.methods.With(event, attrs) })
+		}, func(attr Attr) {
+			sliceAttr(x, attr)
+		})
+	}
+	return x
+}
+
+// With adds each of the given [Attr] values, as structured log fields, in
+// order.
+func (x *Builder[E]) With(attrs ...Attr) *Builder[E] {
+	if x.Enabled() {
+		withAttrs(attrs, func(attrs []Attr) {
+			_ = x.methods.With(x.Event, attrs)
+		}, func(attr Attr) {
+			sliceAttr(x, attr)
+		})
+	}
+	return x
+}
+
+// With returns a sub-logger, which adds each of the given [Attr] values, as
+// structured log fields, to every event. It is shorthand for
+// `x.Clone().With(attrs...).Logger()`, see also [Context.With].
+func (x *Logger[E]) With(attrs ...Attr) *Logger[E] {
+	return x.Clone().With(attrs...).Logger()
+}
@@ -0,0 +1,139 @@
+package logiface
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttr_Value(t *testing.T) {
+	ts := time.Unix(0, 1616592449876543213).UTC()
+	err := errors.New(`some error`)
+	for _, tc := range [...]struct {
+		attr  Attr
+		key   string
+		value any
+	}{
+		{Attr{}, ``, nil},
+		{AttrField(`a`, 1), `a`, 1},
+		{AttrAny(`a`, []int{1}), `a`, []int{1}},
+		{AttrErr(err), ``, err},
+		{AttrStr(`a`, `b`), `a`, `b`},
+		{AttrInt(`a`, -1), `a`, -1},
+		{AttrInt64(`a`, -2), `a`, int64(-2)},
+		{AttrUint64(`a`, 3), `a`, uint64(3)},
+		{AttrFloat64(`a`, 4.5), `a`, 4.5},
+		{AttrBool(`a`, true), `a`, true},
+		{AttrBool(`a`, false), `a`, false},
+		{AttrTime(`a`, ts), `a`, ts},
+		{AttrDur(`a`, time.Second), `a`, time.Second},
+		{AttrInt8(`a`, -5), `a`, int8(-5)},
+		{AttrInt16(`a`, -6), `a`, int16(-6)},
+		{AttrInt32(`a`, -7), `a`, int32(-7)},
+		{AttrUint(`a`, 8), `a`, uint(8)},
+		{AttrUint8(`a`, 9), `a`, uint8(9)},
+		{AttrUint16(`a`, 10), `a`, uint16(10)},
+		{AttrUint32(`a`, 11), `a`, uint32(11)},
+		{AttrIPAddr(`a`, netip.MustParseAddr(`192.0.2.1`)), `a`, netip.MustParseAddr(`192.0.2.1`)},
+		{AttrIPPrefix(`a`, netip.MustParsePrefix(`10.0.0.0/8`)), `a`, netip.MustParsePrefix(`10.0.0.0/8`)},
+		{AttrURL(`a`, &url.URL{Scheme: `https`, Host: `example.com`}), `a`, &url.URL{Scheme: `https`, Host: `example.com`}},
+		{AttrHex(`a`, []byte{0xde, 0xad}), `a`, []byte{0xde, 0xad}},
+		{AttrFloat32(`a`, 12.5), `a`, float32(12.5)},
+		{AttrBase64(`a`, []byte{0xbe, 0xef}, nil), `a`, []byte{0xbe, 0xef}},
+		{AttrRawJSON(`a`, json.RawMessage(`{}`)), `a`, json.RawMessage(`{}`)},
+		{AttrStringer(`a`, time.Second), `a`, time.Second},
+		{AttrStrs(`a`, []string{`b`}), `a`, []string{`b`}},
+		{AttrInts(`a`, []int{1}), `a`, []int{1}},
+		{AttrInt64s(`a`, []int64{2}), `a`, []int64{2}},
+		{AttrFloat64s(`a`, []float64{3.5}), `a`, []float64{3.5}},
+		{AttrBools(`a`, []bool{true}), `a`, []bool{true}},
+		{AttrDurs(`a`, []time.Duration{time.Second}), `a`, []time.Duration{time.Second}},
+		{AttrTimes(`a`, []time.Time{ts}), `a`, []time.Time{ts}},
+		{AttrErrs(`a`, []error{err}), `a`, []error{err}},
+	} {
+		if v := tc.attr.Key(); v != tc.key {
+			t.Errorf(`unexpected key: %q`, v)
+		}
+		if v := tc.attr.Value(); !reflect.DeepEqual(v, tc.value) {
+			t.Errorf(`unexpected value: %#v`, v)
+		}
+	}
+}
+
+func TestBuilder_With(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf, Type: true}),
+	)
+	attrs := []Attr{
+		AttrStr(`str`, `s`),
+		{},
+		AttrInt(`int`, 1),
+		AttrInt64(`int64`, 2),
+		AttrUint64(`uint64`, 3),
+		AttrFloat64(`float64`, 4.5),
+		AttrBool(`bool`, true),
+		AttrDur(`dur`, time.Second),
+		AttrTime(`time`, time.Unix(0, 0)),
+		AttrErr(errors.New(`some error`)),
+		AttrField(`field`, time.Millisecond),
+		AttrAny(`any`, time.Millisecond),
+		AttrInt8(`int8`, -5),
+		AttrUint32(`uint32`, 11),
+		AttrIPAddr(`ip`, netip.MustParseAddr(`192.0.2.1`)),
+		AttrIPPrefix(`prefix`, netip.MustParsePrefix(`10.0.0.0/8`)),
+		AttrURL(`url`, &url.URL{Scheme: `https`, Host: `example.com`}),
+		AttrHex(`hex`, []byte{0xde, 0xad}),
+		AttrFloat32(`float32`, 12.5),
+		AttrBase64(`base64`, []byte{0xbe, 0xef}, nil),
+		AttrStringer(`stringer`, time.Second),
+		AttrStrs(`strs`, []string{`a`}),
+		AttrInts(`ints`, []int{1}),
+		AttrInt64s(`int64s`, []int64{2}),
+		AttrFloat64s(`float64s`, []float64{3.5}),
+		AttrBools(`bools`, []bool{true}),
+		AttrDurs(`durs`, []time.Duration{time.Second}),
+		AttrTimes(`times`, []time.Time{time.Unix(0, 0)}),
+		AttrErrs(`errs`, []error{errors.New(`e`)}),
+		AttrStr(`last`, `l`),
+	}
+	l.Info().With(attrs...).Log(`built`)
+	l.Debug().With(attrs...).Log(`disabled`)
+	if s := buf.String(); s != "[info] str=(string)s int=(int)1 int64=(string)2 uint64=(string)3 float64=(float64)4.5 bool=(bool)true dur=(string)1s time=(string)1970-01-01T00:00:00Z err=(string)some error field=(string)0.001s any=(time.Duration)1ms int8=(int)-5 uint32=(int)11 ip=(string)192.0.2.1 prefix=(string)10.0.0.0/8 url=(string)https://example.com hex=(string)dead float32=(float32)12.5 base64=(string)vu8= stringer=(string)1s strs=[(string)a] ints=[(int)1] int64s=[(string)2] float64s=[(float64)3.5] bools=[(bool)true] durs=[(string)1s] times=[(string)1970-01-01T00:00:00Z] errs=[(string)e] last=(string)l msg=(string)built\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestContext_With(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+	)
+	ints := []int{1}
+	attrs := []Attr{AttrStr(`a`, `b`), AttrInts(`s`, ints), AttrRawJSON(`r`, json.RawMessage(`[2]`)), AttrInt(`c`, 1)}
+	c := l.Clone().With(attrs...).Str(`d`, `e`)
+	sub := l.With(attrs[3:]...)
+	// the attrs (and slice values) are copied
+	attrs[0] = AttrStr(`a`, `modified`)
+	ints[0] = -1
+	attrs[3] = AttrInt(`c`, 2)
+	c.Logger().Info().Log(`context`)
+	sub.Info().With(attrs[3:]...).Log(`logger`)
+	if err := sub.Log(LevelNotice, nil); err != nil {
+		t.Fatal(err)
+	}
+	sub.Debug().Log(`disabled`)
+	if s := buf.String(); s != "[info] a=b s=[1] r=[2] c=1 d=e msg=context\n[info] c=1 c=2 msg=logger\n[notice] c=1\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	if v := (*Logger[*mockSimpleEvent])(nil).With(attrs...); v != nil {
+		t.Error(v)
+	}
+}
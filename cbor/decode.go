@@ -0,0 +1,394 @@
+package cbor
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type (
+	decoder struct {
+		r   *bufio.Reader
+		buf []byte
+		tmp []byte
+	}
+
+	head struct {
+		major byte
+		info  byte
+		arg   uint64
+	}
+)
+
+// maxDepth limits the nesting of decoded items
+const maxDepth = 1000
+
+// ToJSONLines converts a CBOR sequence, read from src, e.g. as written by
+// [Logger], to JSON lines, written to dst.
+//
+// Byte strings are converted to base64 (standard encoding) strings, times
+// (tag 1) to RFC 3339 strings (floating-point times are rounded to the nearest
+// microsecond), and durations (see [TagDuration]) to strings,
+// in the same format as logiface's fallback behavior. Non-finite floating
+// point values are converted to the strings "NaN", "+Inf", and "-Inf".
+// Non-string map keys are converted to strings, containing their JSON
+// representation. Other tags are ignored, i.e. only their content is
+// converted, and undefined, and unassigned simple values, are converted to
+// null.
+func ToJSONLines(dst io.Writer, src io.Reader) error {
+	d := decoder{r: bufio.NewReader(src)}
+	for {
+		if _, err := d.r.Peek(1); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := d.item(0); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		d.buf = append(d.buf, '\n')
+		if _, err := dst.Write(d.buf); err != nil {
+			return err
+		}
+		d.buf = d.buf[:0]
+	}
+}
+
+func (x *decoder) head() (h head, err error) {
+	b, err := x.r.ReadByte()
+	if err != nil {
+		return
+	}
+	h.major, h.info = b>>5, b&0x1f
+	switch {
+	case h.info < 24:
+		h.arg = uint64(h.info)
+	case h.info <= 27:
+		n := 1 << (h.info - 24)
+		for range n {
+			if b, err = x.r.ReadByte(); err != nil {
+				return
+			}
+			h.arg = h.arg<<8 | uint64(b)
+		}
+	case h.info == 31:
+		if h.major == majorUint>>5 || h.major == majorNegInt>>5 || h.major == majorTag>>5 {
+			err = fmt.Errorf(`cbor: invalid indefinite length, for major type %d`, h.major)
+		}
+	default:
+		err = fmt.Errorf(`cbor: invalid additional information %d`, h.info)
+	}
+	return
+}
+
+func (h head) indefinite() bool { return h.info == 31 }
+
+func (h head) isBreak() bool { return h.major == 7 && h.info == 31 }
+
+func (x *decoder) item(depth int) error {
+	h, err := x.head()
+	if err != nil {
+		return err
+	}
+	if h.isBreak() {
+		return errors.New(`cbor: unexpected break`)
+	}
+	return x.value(h, depth)
+}
+
+func (x *decoder) value(h head, depth int) error {
+	if depth > maxDepth {
+		return errors.New(`cbor: maximum depth exceeded`)
+	}
+	switch h.major {
+	case 0:
+		x.buf = strconv.AppendUint(x.buf, h.arg, 10)
+
+	case 1:
+		x.buf = append(x.buf, '-')
+		if h.arg == math.MaxUint64 {
+			x.buf = append(x.buf, `18446744073709551616`...)
+		} else {
+			x.buf = strconv.AppendUint(x.buf, h.arg+1, 10)
+		}
+
+	case 2:
+		b, err := x.readString(h)
+		if err != nil {
+			return err
+		}
+		x.buf = append(x.buf, '"')
+		x.buf = base64.StdEncoding.AppendEncode(x.buf, b)
+		x.buf = append(x.buf, '"')
+
+	case 3:
+		b, err := x.readString(h)
+		if err != nil {
+			return err
+		}
+		x.buf = jsonenc.AppendString(x.buf, string(b))
+
+	case 4:
+		x.buf = append(x.buf, '[')
+		for i := uint64(0); h.indefinite() || i < h.arg; i++ {
+			v, err := x.head()
+			if err != nil {
+				return err
+			}
+			if v.isBreak() {
+				if !h.indefinite() {
+					return errors.New(`cbor: unexpected break`)
+				}
+				break
+			}
+			if i != 0 {
+				x.buf = append(x.buf, ',')
+			}
+			if err := x.value(v, depth+1); err != nil {
+				return err
+			}
+		}
+		x.buf = append(x.buf, ']')
+
+	case 5:
+		x.buf = append(x.buf, '{')
+		for i := uint64(0); h.indefinite() || i < h.arg; i++ {
+			k, err := x.head()
+			if err != nil {
+				return err
+			}
+			if k.isBreak() {
+				if !h.indefinite() {
+					return errors.New(`cbor: unexpected break`)
+				}
+				break
+			}
+			if i != 0 {
+				x.buf = append(x.buf, ',')
+			}
+			if err := x.key(k, depth+1); err != nil {
+				return err
+			}
+			x.buf = append(x.buf, ':')
+			if err := x.item(depth + 1); err != nil {
+				return err
+			}
+		}
+		x.buf = append(x.buf, '}')
+
+	case 6:
+		return x.tag(h.arg, depth)
+
+	default:
+		return x.simple(h)
+	}
+
+	return nil
+}
+
+// key converts a map key, which must be a string, in JSON
+func (x *decoder) key(h head, depth int) error {
+	if h.major == 3 {
+		return x.value(h, depth)
+	}
+	n := len(x.buf)
+	if err := x.value(h, depth); err != nil {
+		return err
+	}
+	if x.buf[n] == '"' {
+		return nil
+	}
+	x.tmp = append(x.tmp[:0], x.buf[n:]...)
+	x.buf = jsonenc.AppendString(x.buf[:n], string(x.tmp))
+	return nil
+}
+
+func (x *decoder) tag(tag uint64, depth int) error {
+	h, err := x.head()
+	if err != nil {
+		return err
+	}
+	switch tag {
+	case TagEpochTime:
+		var t time.Time
+		switch {
+		case h.major == 0 && h.arg <= math.MaxInt64:
+			t = time.Unix(int64(h.arg), 0)
+		case h.major == 1 && h.arg < math.MaxInt64:
+			t = time.Unix(-1-int64(h.arg), 0)
+		case h.major == 7 && h.info >= 25 && h.info <= 27:
+			f := simpleFloat(h)
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return x.value(h, depth)
+			}
+			// the precision of float64 is insufficient for nanoseconds
+			sec, frac := math.Modf(f)
+			t = time.Unix(int64(sec), int64(math.Round(frac*1e6))*1e3)
+		default:
+			return x.value(h, depth)
+		}
+		x.buf = append(x.buf, '"')
+		x.buf = pbtime.AppendTimestamp(x.buf, t.UTC())
+		x.buf = append(x.buf, '"')
+		return nil
+
+	case TagExtendedTime:
+		if h.major == 5 && !h.indefinite() && h.arg <= 2 {
+			sec, ns, err := x.secondsNanos(h, `time`)
+			if err != nil {
+				return err
+			}
+			x.buf = append(x.buf, '"')
+			x.buf = pbtime.AppendTimestamp(x.buf, time.Unix(sec, ns).UTC())
+			x.buf = append(x.buf, '"')
+			return nil
+		}
+
+	case TagDuration:
+		if h.major == 5 && !h.indefinite() && h.arg <= 2 {
+			sec, ns, err := x.secondsNanos(h, `duration`)
+			if err != nil {
+				return err
+			}
+			x.buf = append(x.buf, '"')
+			x.buf = pbtime.AppendDuration(x.buf, time.Duration(sec)*time.Second+time.Duration(ns))
+			x.buf = append(x.buf, '"')
+			return nil
+		}
+	}
+	if h.isBreak() {
+		return errors.New(`cbor: unexpected break`)
+	}
+	return x.value(h, depth)
+}
+
+// secondsNanos reads the entries of the map h, per [TagDuration] and
+// [TagExtendedTime], with kind used for errors
+func (x *decoder) secondsNanos(h head, kind string) (sec, ns int64, err error) {
+	for range h.arg {
+		k, err := x.head()
+		if err != nil {
+			return 0, 0, err
+		}
+		v, err := x.head()
+		if err != nil {
+			return 0, 0, err
+		}
+		var n int64
+		switch {
+		case v.major == 0 && v.arg <= math.MaxInt64:
+			n = int64(v.arg)
+		case v.major == 1 && v.arg < math.MaxInt64:
+			n = -1 - int64(v.arg)
+		default:
+			return 0, 0, errors.New(`cbor: invalid ` + kind + ` value`)
+		}
+		switch {
+		case k.major == 0 && k.arg == 1:
+			sec += n
+		case k.major == 1 && k.arg == 8:
+			ns += n
+		default:
+			return 0, 0, errors.New(`cbor: invalid ` + kind + ` key`)
+		}
+	}
+	return
+}
+
+func (x *decoder) simple(h head) error {
+	switch h.info {
+	case 20:
+		x.buf = append(x.buf, `false`...)
+	case 21:
+		x.buf = append(x.buf, `true`...)
+	case 25, 27:
+		x.buf = jsonenc.AppendFloat64(x.buf, simpleFloat(h))
+	case 26:
+		x.buf = jsonenc.AppendFloat32(x.buf, float32(simpleFloat(h)))
+	default:
+		x.buf = append(x.buf, `null`...)
+	}
+	return nil
+}
+
+// readString reads the content of a (possibly indefinite-length) byte or
+// text string, into x.tmp
+func (x *decoder) readString(h head) ([]byte, error) {
+	x.tmp = x.tmp[:0]
+	if !h.indefinite() {
+		return x.tmp, x.readN(h.arg)
+	}
+	for {
+		c, err := x.head()
+		if err != nil {
+			return nil, err
+		}
+		if c.isBreak() {
+			return x.tmp, nil
+		}
+		if c.major != h.major || c.indefinite() {
+			return nil, errors.New(`cbor: invalid indefinite-length string chunk`)
+		}
+		if err := x.readN(c.arg); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (x *decoder) readN(n uint64) error {
+	for n != 0 {
+		chunk := int(min(n, 1<<16))
+		i := len(x.tmp)
+		x.tmp = slices.Grow(x.tmp, chunk)[:i+chunk]
+		if _, err := io.ReadFull(x.r, x.tmp[i:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		n -= uint64(chunk)
+	}
+	return nil
+}
+
+func simpleFloat(h head) float64 {
+	switch h.info {
+	case 25:
+		return float64(halfToFloat32(uint16(h.arg)))
+	case 26:
+		return float64(math.Float32frombits(uint32(h.arg)))
+	default:
+		return math.Float64frombits(h.arg)
+	}
+}
+
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+	switch exp {
+	case 0:
+		// zero or subnormal
+		f := float32(frac) / (1 << 24)
+		if sign != 0 {
+			f = -f
+		}
+		return f
+	case 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | frac<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | frac<<13)
+	}
+}
@@ -0,0 +1,87 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestToJSONLines(t *testing.T) {
+	for _, tc := range [...]struct {
+		Name   string
+		Input  string
+		Output string
+	}{
+		{`empty`, ``, ``},
+		{`sequence`, `0120f6f7`, "1\n-1\nnull\nnull\n"},
+		{`negative int min`, `3bffffffffffffffff`, "-18446744073709551616\n"},
+		{`half float`, `f93c00f97bfff97c00f9fc00f97e00f90001`, "1\n65504\n\"+Inf\"\n\"-Inf\"\n\"NaN\"\n5.960464477539063e-8\n"},
+		{`float32`, `fa47c35000`, "100000\n"},
+		{`bytes`, `4401020304`, "\"AQIDBA==\"\n"},
+		{`indefinite bytes`, `5f42010243030405ff`, "\"AQIDBAU=\"\n"},
+		{`indefinite text`, `7f657374726561646d696e67ff`, "\"streaming\"\n"},
+		{`text escaped`, `62220a`, "\"\\\"\\n\"\n"},
+		{`definite map`, `a26161016162820203`, "{\"a\":1,\"b\":[2,3]}\n"},
+		{`non-string keys`, `a301020304f5f6`, "{\"1\":2,\"3\":4,\"true\":null}\n"},
+		{`nested key`, `a1810102`, "{\"[1]\":2}\n"},
+		{`time`, `c11a514b67b0`, "\"2013-03-21T20:04:00Z\"\n"},
+		{`time float`, `c1fb41d452d9ec200000`, "\"2013-03-21T20:04:00.500Z\"\n"},
+		{`extended time`, `d903e9a2011a514b67b0281a1dcd6501`, "\"2013-03-21T20:04:00.500000001Z\"\n"},
+		{`time string`, `c074323031332d30332d32315432303a30343a30305a`, "\"2013-03-21T20:04:00Z\"\n"},
+		{`duration`, `d903eaa20121281a1dcd6500`, "\"-1.500s\"\n"},
+		{`unknown tag`, `d8200102`, "1\n2\n"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			if err := ToJSONLines(&out, bytes.NewReader(b)); err != nil {
+				t.Fatal(err)
+			}
+			if s := out.String(); s != tc.Output {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestToJSONLines_invalid(t *testing.T) {
+	for _, tc := range [...]struct {
+		Name  string
+		Input string
+		Err   string
+	}{
+		{`truncated head`, `19`, io.ErrUnexpectedEOF.Error()},
+		{`truncated string`, `6461`, io.ErrUnexpectedEOF.Error()},
+		{`truncated map`, `bf6161`, io.ErrUnexpectedEOF.Error()},
+		{`unexpected break`, `ff`, `cbor: unexpected break`},
+		{`break in definite array`, `82ff`, `cbor: unexpected break`},
+		{`invalid additional information`, `1c`, `cbor: invalid additional information 28`},
+		{`indefinite uint`, `1f`, `cbor: invalid indefinite length, for major type 0`},
+		{`invalid chunk`, `5f6161ff`, `cbor: invalid indefinite-length string chunk`},
+		{`invalid duration`, `d903eaa10201`, `cbor: invalid duration key`},
+		{`depth`, strings.Repeat(`81`, maxDepth+2) + `01`, `cbor: maximum depth exceeded`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ToJSONLines(io.Discard, bytes.NewReader(b)); err == nil || err.Error() != tc.Err {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestToJSONLines_writeError(t *testing.T) {
+	expected := errors.New(`some error`)
+	if err := ToJSONLines(errWriter{expected}, bytes.NewReader([]byte{0})); err != expected {
+		t.Error(err)
+	}
+}
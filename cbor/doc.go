@@ -0,0 +1,14 @@
+// Package cbor implements full logiface support, as a CBOR (RFC 8949) logger,
+// without any dependencies outside this module.
+//
+// The output is a CBOR sequence (RFC 8742), of one indefinite-length map per
+// event, which is both more compact, and cheaper to encode, than JSON.
+// Integers (including int64 and uint64) are encoded natively, as are floating
+// point values (including NaN and infinities), bytes (as byte strings, i.e.
+// not base64), and times (as tag 1, or [TagExtendedTime], if there are
+// fractional seconds). Durations are encoded using [TagDuration]. Nested objects, arrays, and groups are encoded as
+// indefinite-length maps and arrays, written directly to the buffer, in the
+// same manner as package jsonl.
+//
+// See [ToJSONLines], for converting the output to JSON lines, for humans.
+package cbor
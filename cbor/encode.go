@@ -0,0 +1,253 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TagDuration is the tag used to encode durations, which wraps a map, with
+// the (integer) key 1 mapped to the number of seconds, and, if non-zero, the
+// key -9 mapped to the additional, non-negative, number of nanoseconds. This
+// is the same as the duration tag, from the CBOR extended time draft (tag
+// 1002), restricted to nanosecond precision.
+const TagDuration = 1002
+
+// TagEpochTime is the standard date/time tag, for a numeric Unix time. It's
+// used for times with no fractional seconds, see also [TagExtendedTime].
+const TagEpochTime = 1
+
+// TagExtendedTime is the tag used to encode times with fractional seconds,
+// which wraps a map, in the same format as [TagDuration], i.e. the key 1
+// mapped to the Unix time, in seconds, and the key -9 mapped to the
+// additional number of nanoseconds. This is the extended time tag, from the
+// CBOR extended time draft (tag 1001), and, unlike a floating-point
+// [TagEpochTime], preserves nanosecond precision.
+const TagExtendedTime = 1001
+
+const (
+	majorUint   byte = 0 << 5
+	majorNegInt byte = 1 << 5
+	majorBytes  byte = 2 << 5
+	majorText   byte = 3 << 5
+	majorArray  byte = 4 << 5
+	majorMap    byte = 5 << 5
+	majorTag    byte = 6 << 5
+	majorSimple byte = 7 << 5
+
+	falseCode   = majorSimple | 20
+	trueCode    = majorSimple | 21
+	nullCode    = majorSimple | 22
+	float32Code = majorSimple | 26
+	float64Code = majorSimple | 27
+	startArray  = majorArray | 31
+	startMap    = majorMap | 31
+	breakCode   = majorSimple | 31
+)
+
+func appendHead(dst []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(dst, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(dst, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return append(dst, major|25, byte(n>>8), byte(n))
+	case n <= math.MaxUint32:
+		return append(dst, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	default:
+		return append(dst, major|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32), byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+// appendText appends a text string, replacing any invalid UTF-8 with U+FFFD
+func appendText(dst []byte, val string) []byte {
+	if !utf8.ValidString(val) {
+		val = strings.ToValidUTF8(val, string(utf8.RuneError))
+	}
+	dst = appendHead(dst, majorText, uint64(len(val)))
+	return append(dst, val...)
+}
+
+func appendBytes(dst []byte, val []byte) []byte {
+	dst = appendHead(dst, majorBytes, uint64(len(val)))
+	return append(dst, val...)
+}
+
+func appendInt64(dst []byte, val int64) []byte {
+	if val < 0 {
+		return appendHead(dst, majorNegInt, uint64(-1-val))
+	}
+	return appendHead(dst, majorUint, uint64(val))
+}
+
+func appendUint64(dst []byte, val uint64) []byte {
+	return appendHead(dst, majorUint, val)
+}
+
+func appendFloat64(dst []byte, val float64) []byte {
+	v := math.Float64bits(val)
+	return append(dst, float64Code, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendFloat32(dst []byte, val float32) []byte {
+	v := math.Float32bits(val)
+	return append(dst, float32Code, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendBool(dst []byte, val bool) []byte {
+	if val {
+		return append(dst, trueCode)
+	}
+	return append(dst, falseCode)
+}
+
+func appendTime(dst []byte, val time.Time) []byte {
+	if ns := val.Nanosecond(); ns != 0 {
+		dst = appendHead(dst, majorTag, TagExtendedTime)
+		dst = appendHead(dst, majorMap, 2)
+		dst = appendInt64(dst, 1)
+		dst = appendInt64(dst, val.Unix())
+		dst = appendInt64(dst, -9)
+		return appendInt64(dst, int64(ns))
+	}
+	dst = appendHead(dst, majorTag, TagEpochTime)
+	return appendInt64(dst, val.Unix())
+}
+
+func appendDuration(dst []byte, val time.Duration) []byte {
+	dst = appendHead(dst, majorTag, TagDuration)
+	sec, ns := int64(val/time.Second), int64(val%time.Second)
+	if ns < 0 {
+		sec--
+		ns += int64(time.Second)
+	}
+	if ns == 0 {
+		dst = appendHead(dst, majorMap, 1)
+		dst = appendInt64(dst, 1)
+		return appendInt64(dst, sec)
+	}
+	dst = appendHead(dst, majorMap, 2)
+	dst = appendInt64(dst, 1)
+	dst = appendInt64(dst, sec)
+	dst = appendInt64(dst, -9)
+	return appendInt64(dst, ns)
+}
+
+// appendInterface appends val, natively encoding common types, falling back
+// to converting the JSON encoding
+func appendInterface(dst []byte, val any) ([]byte, error) {
+	switch val := val.(type) {
+	case nil:
+		return append(dst, nullCode), nil
+	case string:
+		return appendText(dst, val), nil
+	case bool:
+		return appendBool(dst, val), nil
+	case int:
+		return appendInt64(dst, int64(val)), nil
+	case int8:
+		return appendInt64(dst, int64(val)), nil
+	case int16:
+		return appendInt64(dst, int64(val)), nil
+	case int32:
+		return appendInt64(dst, int64(val)), nil
+	case int64:
+		return appendInt64(dst, val), nil
+	case uint:
+		return appendUint64(dst, uint64(val)), nil
+	case uint8:
+		return appendUint64(dst, uint64(val)), nil
+	case uint16:
+		return appendUint64(dst, uint64(val)), nil
+	case uint32:
+		return appendUint64(dst, uint64(val)), nil
+	case uint64:
+		return appendUint64(dst, val), nil
+	case float32:
+		return appendFloat32(dst, val), nil
+	case float64:
+		return appendFloat64(dst, val), nil
+	case []byte:
+		return appendBytes(dst, val), nil
+	case time.Time:
+		return appendTime(dst, val), nil
+	case time.Duration:
+		return appendDuration(dst, val), nil
+	case json.RawMessage:
+		return appendJSON(dst, val)
+	case error:
+		return appendText(dst, val.Error()), nil
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return dst, err
+	}
+	return appendJSON(dst, b)
+}
+
+// appendJSON converts a single JSON value to CBOR, using indefinite-length
+// maps and arrays
+func appendJSON(dst []byte, val []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(val))
+	d.UseNumber()
+	var depth int
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return dst, err
+		}
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{':
+				dst = append(dst, startMap)
+				depth++
+			case '[':
+				dst = append(dst, startArray)
+				depth++
+			default:
+				dst = append(dst, breakCode)
+				depth--
+			}
+		case string:
+			dst = appendText(dst, tok)
+		case json.Number:
+			dst = appendNumber(dst, tok)
+		case bool:
+			dst = appendBool(dst, tok)
+		case nil:
+			dst = append(dst, nullCode)
+		default:
+			return dst, fmt.Errorf(`cbor: unexpected json token %T`, tok)
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return dst, errors.New(`cbor: invalid json: trailing data`)
+	}
+	return dst, nil
+}
+
+func appendNumber(dst []byte, val json.Number) []byte {
+	if v, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+		return appendInt64(dst, v)
+	}
+	if v, err := strconv.ParseUint(string(val), 10, 64); err == nil {
+		return appendUint64(dst, v)
+	}
+	v, _ := strconv.ParseFloat(string(val), 64)
+	return appendFloat64(dst, v)
+}
@@ -0,0 +1,65 @@
+package cbor
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// most of the expected values are from RFC 8949, Appendix A
+func TestAppend(t *testing.T) {
+	for _, tc := range [...]struct {
+		Name   string
+		Append func(dst []byte) []byte
+		Output string
+	}{
+		{`uint 0`, func(b []byte) []byte { return appendUint64(b, 0) }, `00`},
+		{`uint 23`, func(b []byte) []byte { return appendUint64(b, 23) }, `17`},
+		{`uint 24`, func(b []byte) []byte { return appendUint64(b, 24) }, `1818`},
+		{`uint 1000`, func(b []byte) []byte { return appendUint64(b, 1000) }, `1903e8`},
+		{`uint 1000000`, func(b []byte) []byte { return appendUint64(b, 1000000) }, `1a000f4240`},
+		{`uint 1000000000000`, func(b []byte) []byte { return appendUint64(b, 1000000000000) }, `1b000000e8d4a51000`},
+		{`uint max`, func(b []byte) []byte { return appendUint64(b, math.MaxUint64) }, `1bffffffffffffffff`},
+		{`int -1`, func(b []byte) []byte { return appendInt64(b, -1) }, `20`},
+		{`int -100`, func(b []byte) []byte { return appendInt64(b, -100) }, `3863`},
+		{`int -1000`, func(b []byte) []byte { return appendInt64(b, -1000) }, `3903e7`},
+		{`int min`, func(b []byte) []byte { return appendInt64(b, math.MinInt64) }, `3b7fffffffffffffff`},
+		{`float64 1.1`, func(b []byte) []byte { return appendFloat64(b, 1.1) }, `fb3ff199999999999a`},
+		{`float32 100000`, func(b []byte) []byte { return appendFloat32(b, 100000) }, `fa47c35000`},
+		{`float64 nan`, func(b []byte) []byte { return appendFloat64(b, math.NaN()) }, `fb7ff8000000000001`},
+		{`true`, func(b []byte) []byte { return appendBool(b, true) }, `f5`},
+		{`false`, func(b []byte) []byte { return appendBool(b, false) }, `f4`},
+		{`text empty`, func(b []byte) []byte { return appendText(b, ``) }, `60`},
+		{`text IETF`, func(b []byte) []byte { return appendText(b, `IETF`) }, `6449455446`},
+		{`text invalid utf-8`, func(b []byte) []byte { return appendText(b, "a\xffb") }, `6561efbfbd62`},
+		{`bytes`, func(b []byte) []byte { return appendBytes(b, []byte{1, 2, 3, 4}) }, `4401020304`},
+		{`time`, func(b []byte) []byte { return appendTime(b, time.Unix(1363896240, 0)) }, `c11a514b67b0`},
+		{`time fraction`, func(b []byte) []byte { return appendTime(b, time.Unix(1363896240, 5e8)) }, `d903e9a2011a514b67b0281a1dcd6500`},
+		{`duration`, func(b []byte) []byte { return appendDuration(b, 3*time.Second) }, `d903eaa10103`},
+		{`duration fraction`, func(b []byte) []byte { return appendDuration(b, 1500*time.Millisecond) }, `d903eaa20101281a1dcd6500`},
+		{`duration negative`, func(b []byte) []byte { return appendDuration(b, -1500*time.Millisecond) }, `d903eaa20121281a1dcd6500`},
+		{`json`, func(b []byte) []byte {
+			b, err := appendJSON(b, json.RawMessage(` {"a": [1, -2, 1.5, 18446744073709551615, "x", null, true]} `))
+			if err != nil {
+				t.Error(err)
+			}
+			return b
+		}, `bf61619f0121fb3ff80000000000001bffffffffffffffff6178f6f5ffff`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if s := hex.EncodeToString(tc.Append(nil)); s != tc.Output {
+				t.Errorf("unexpected output: %s", s)
+			}
+		})
+	}
+}
+
+func TestAppendJSON_invalid(t *testing.T) {
+	for _, s := range [...]string{``, `{`, `{"a":1`, `[1,]`, `1 2`, `}`} {
+		if _, err := appendJSON(nil, json.RawMessage(s)); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+package cbor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		logger *Logger
+		buf    []byte
+		// off is a stack with the index of the (empty) key placeholder for each nested map or array
+		// negative values indicate already set keys
+		off []int
+		// msg is the message, if it was added while within a group, see AddGroup
+		msg string
+		// groups is the number of groups (objects) that need to be closed, on write
+		groups int
+		lvl    logiface.Level
+		hasMsg bool
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Bytes returns the current buffer, for the event.
+// It may be used, for example, to customise the writer.
+// Always missing the final break code, for the (indefinite-length) map, and
+// that of any groups.
+func (x *Event) Bytes() []byte {
+	return x.buf
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.appendKey(key)
+	x.appendInterface(val)
+}
+func (*Logger) SetField(obj *Event, key string, val any) *Event {
+	obj.appendKey(key)
+	obj.appendInterface(val)
+	return obj
+}
+func (*Logger) AppendField(arr *Event, val any) *Event {
+	arr.appendInterface(val)
+	return arr
+}
+
+// AddMessage adds the message field. If called within a group (see
+// [Event.AddGroup]), the message will be written after the group is closed,
+// i.e. it will always be a top-level field.
+func (x *Event) AddMessage(msg string) bool {
+	if x.groups != 0 {
+		x.msg = msg
+		x.hasMsg = true
+	} else {
+		x.appendMessage(msg)
+	}
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.appendErrorKey()
+		// this seems sensible, even if it's inefficient
+		x.appendString(fmt.Sprint(err))
+	}
+	return true
+}
+func (*Logger) CanSetError() bool { return true }
+func (*Logger) SetError(obj *Event, err error) *Event {
+	obj.appendErrorKey()
+	// differs from AddError in that it will always set the error field, even if nil
+	obj.appendError(err)
+	return obj
+}
+func (*Logger) CanAppendError() bool { return true }
+func (*Logger) AppendError(arr *Event, err error) *Event {
+	arr.appendError(err)
+	return arr
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendKey(key)
+	x.appendString(val)
+	return true
+}
+func (*Logger) CanSetString() bool { return true }
+func (*Logger) SetString(obj *Event, key string, val string) *Event {
+	obj.appendKey(key)
+	obj.appendString(val)
+	return obj
+}
+func (*Logger) CanAppendString() bool { return true }
+func (*Logger) AppendString(arr *Event, val string) *Event {
+	arr.appendString(val)
+	return arr
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendKey(key)
+	x.appendInt(val)
+	return true
+}
+func (*Logger) CanSetInt() bool { return true }
+func (*Logger) SetInt(obj *Event, key string, val int) *Event {
+	obj.appendKey(key)
+	obj.appendInt(val)
+	return obj
+}
+func (*Logger) CanAppendInt() bool { return true }
+func (*Logger) AppendInt(arr *Event, val int) *Event {
+	arr.appendInt(val)
+	return arr
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendKey(key)
+	x.appendFloat32(val)
+	return true
+}
+func (*Logger) CanSetFloat32() bool { return true }
+func (*Logger) SetFloat32(obj *Event, key string, val float32) *Event {
+	obj.appendKey(key)
+	obj.appendFloat32(val)
+	return obj
+}
+func (*Logger) CanAppendFloat32() bool { return true }
+func (*Logger) AppendFloat32(arr *Event, val float32) *Event {
+	arr.appendFloat32(val)
+	return arr
+}
+
+// AddTime adds a standard date/time value (tag 1), as the integer number of
+// seconds, since the Unix epoch, or, if there are fractional seconds, an
+// extended time value, see [TagExtendedTime].
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendKey(key)
+	x.appendTime(val)
+	return true
+}
+func (*Logger) CanSetTime() bool { return true }
+func (*Logger) SetTime(obj *Event, key string, val time.Time) *Event {
+	obj.appendKey(key)
+	obj.appendTime(val)
+	return obj
+}
+func (*Logger) CanAppendTime() bool { return true }
+func (*Logger) AppendTime(arr *Event, val time.Time) *Event {
+	arr.appendTime(val)
+	return arr
+}
+
+// AddDuration adds a duration value, see [TagDuration].
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendKey(key)
+	x.appendDuration(val)
+	return true
+}
+func (*Logger) CanSetDuration() bool { return true }
+func (*Logger) SetDuration(obj *Event, key string, val time.Duration) *Event {
+	obj.appendKey(key)
+	obj.appendDuration(val)
+	return obj
+}
+func (*Logger) CanAppendDuration() bool { return true }
+func (*Logger) AppendDuration(arr *Event, val time.Duration) *Event {
+	arr.appendDuration(val)
+	return arr
+}
+
+// AddBase64Bytes adds a byte string, i.e. enc is ignored.
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendKey(key)
+	x.appendBase64Bytes(val, enc)
+	return true
+}
+func (*Logger) CanSetBase64Bytes() bool { return true }
+func (*Logger) SetBase64Bytes(obj *Event, key string, val []byte, enc *base64.Encoding) *Event {
+	obj.appendKey(key)
+	obj.appendBase64Bytes(val, enc)
+	return obj
+}
+func (*Logger) CanAppendBase64Bytes() bool { return true }
+func (*Logger) AppendBase64Bytes(arr *Event, val []byte, enc *base64.Encoding) *Event {
+	arr.appendBase64Bytes(val, enc)
+	return arr
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendKey(key)
+	x.appendBool(val)
+	return true
+}
+func (*Logger) CanSetBool() bool { return true }
+func (*Logger) SetBool(obj *Event, key string, val bool) *Event {
+	obj.appendKey(key)
+	obj.appendBool(val)
+	return obj
+}
+func (*Logger) CanAppendBool() bool { return true }
+func (*Logger) AppendBool(arr *Event, val bool) *Event {
+	arr.appendBool(val)
+	return arr
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendKey(key)
+	x.appendFloat64(val)
+	return true
+}
+func (*Logger) CanSetFloat64() bool { return true }
+func (*Logger) SetFloat64(obj *Event, key string, val float64) *Event {
+	obj.appendKey(key)
+	obj.appendFloat64(val)
+	return obj
+}
+func (*Logger) CanAppendFloat64() bool { return true }
+func (*Logger) AppendFloat64(arr *Event, val float64) *Event {
+	arr.appendFloat64(val)
+	return arr
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendKey(key)
+	x.appendInt64(val)
+	return true
+}
+func (*Logger) CanSetInt64() bool { return true }
+func (*Logger) SetInt64(obj *Event, key string, val int64) *Event {
+	obj.appendKey(key)
+	obj.appendInt64(val)
+	return obj
+}
+func (*Logger) CanAppendInt64() bool { return true }
+func (*Logger) AppendInt64(arr *Event, val int64) *Event {
+	arr.appendInt64(val)
+	return arr
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendKey(key)
+	x.appendUint64(val)
+	return true
+}
+func (*Logger) CanSetUint64() bool { return true }
+func (*Logger) SetUint64(obj *Event, key string, val uint64) *Event {
+	obj.appendKey(key)
+	obj.appendUint64(val)
+	return obj
+}
+func (*Logger) CanAppendUint64() bool { return true }
+func (*Logger) AppendUint64(arr *Event, val uint64) *Event {
+	arr.appendUint64(val)
+	return arr
+}
+
+// AddRawJSON adds the CBOR equivalent of val, which must be valid JSON, or it
+// will be added as a text string. Numbers are encoded as integers, if
+// possible, with objects and arrays encoded as indefinite-length maps and
+// arrays.
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.appendKey(key)
+	x.appendRawJSON(val)
+	return true
+}
+func (*Logger) CanSetRawJSON() bool { return true }
+func (*Logger) SetRawJSON(obj *Event, key string, val json.RawMessage) *Event {
+	obj.appendKey(key)
+	obj.appendRawJSON(val)
+	return obj
+}
+func (*Logger) CanAppendRawJSON() bool { return true }
+func (*Logger) AppendRawJSON(arr *Event, val json.RawMessage) *Event {
+	arr.appendRawJSON(val)
+	return arr
+}
+
+// AddGroup nests all subsequent fields within a map, with the given key.
+// Groups are closed when the event is written.
+func (x *Event) AddGroup(name string) bool {
+	x.appendKey(name)
+	x.buf = append(x.buf, startMap)
+	x.groups++
+	return true
+}
+
+func (x *Event) appendMessage(msg string) {
+	x.buf = append(x.buf, x.logger.messageField...)
+	x.appendString(msg)
+}
+
+func (x *Event) appendError(err error) {
+	if err == nil {
+		x.buf = append(x.buf, nullCode)
+	} else {
+		x.appendString(fmt.Sprint(err))
+	}
+}
+
+func (x *Event) appendString(val string) {
+	x.buf = appendText(x.buf, val)
+}
+
+func (x *Event) appendInt(val int) {
+	x.buf = appendInt64(x.buf, int64(val))
+}
+
+func (x *Event) appendFloat64(val float64) {
+	x.buf = appendFloat64(x.buf, val)
+}
+
+func (x *Event) appendFloat32(val float32) {
+	x.buf = appendFloat32(x.buf, val)
+}
+
+func (x *Event) appendBool(val bool) {
+	x.buf = appendBool(x.buf, val)
+}
+
+func (x *Event) appendTime(val time.Time) {
+	x.buf = appendTime(x.buf, val)
+}
+
+func (x *Event) appendDuration(val time.Duration) {
+	x.buf = appendDuration(x.buf, val)
+}
+
+func (x *Event) appendBase64Bytes(val []byte, _ *base64.Encoding) {
+	x.buf = appendBytes(x.buf, val)
+}
+
+func (x *Event) appendInt64(val int64) {
+	x.buf = appendInt64(x.buf, val)
+}
+
+func (x *Event) appendUint64(val uint64) {
+	x.buf = appendUint64(x.buf, val)
+}
+
+func (x *Event) appendRawJSON(val json.RawMessage) {
+	if len(val) == 0 {
+		x.buf = append(x.buf, nullCode)
+		return
+	}
+	n := len(x.buf)
+	var err error
+	if x.buf, err = appendJSON(x.buf, val); err != nil {
+		x.buf = appendText(x.buf[:n], string(val))
+	}
+}
+
+func (x *Event) appendInterface(val any) {
+	var err error
+	if x.buf, err = appendInterface(x.buf, val); err != nil {
+		x.appendString(fmt.Sprintf("marshaling error: %v", err))
+	}
+}
+
+func (x *Event) appendKey(key string) {
+	x.appendString(key)
+}
+
+func (x *Event) appendErrorKey() {
+	x.buf = append(x.buf, x.logger.errorField...)
+}
+
+func (x *Event) enterKey(key string) {
+	if key == `` {
+		// off is the index of the placeholder, to be replaced by the key
+		x.off = append(x.off, len(x.buf))
+		x.buf = appendText(x.buf, ``)
+	} else {
+		// key already set, so off is -1
+		x.off = append(x.off, -1)
+		x.appendString(key)
+	}
+}
+
+func (x *Event) exitKey(key string) {
+	if index := x.off[len(x.off)-1]; index >= 0 && key != `` {
+		x.replaceKey(index, key)
+	}
+	x.off = x.off[:len(x.off)-1]
+}
+
+// replaceKey replaces the (single byte) empty key placeholder at index
+func (x *Event) replaceKey(index int, key string) {
+	n := len(x.buf)
+	x.buf = appendText(x.buf, key)
+	// rotate the appended key into position, without allocating
+	reverse(x.buf[index:n])
+	reverse(x.buf[n:])
+	reverse(x.buf[index:])
+	// remove the placeholder, which now immediately follows the key
+	i := index + len(x.buf) - n
+	x.buf = append(x.buf[:i], x.buf[i+1:]...)
+	n = len(x.buf) - n
+	for i = len(x.off) - 1; i >= 0; i-- {
+		if x.off[i] < 0 {
+			continue
+		}
+		if x.off[i] <= index {
+			break
+		}
+		x.off[i] += n
+	}
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
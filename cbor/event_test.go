@@ -0,0 +1,252 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLogger_fieldTypes(t *testing.T) {
+	t.Parallel()
+
+	type Harness struct {
+		L *logiface.Logger[*Event]
+		B bytes.Buffer
+	}
+
+	newHarness := func(t *testing.T, options ...logiface.Option[*Event]) *Harness {
+		var h Harness
+		h.L = L.New(append([]logiface.Option[*Event]{L.WithCBOR(WithWriter(&h.B), WithLevelField(``))}, options...)...)
+		return &h
+	}
+
+	for _, tc := range [...]struct {
+		Name   string
+		Log    func(l *logiface.Logger[*Event])
+		Output string
+	}{
+		{
+			Name:   `message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Log(`some message`) },
+			Output: `{"msg":"some message"}`,
+		},
+		{
+			Name: `any`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Any(`a`, map[string]int{`z`: 1}).
+					Object().Any(`ba`, true).As(`b`).End().
+					Array().Any(nil).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":{"z":1},"b":{"ba":true},"c":[null]}`,
+		},
+		{
+			Name: `error`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Err(nil).
+					Err(errors.New(`err 1`)).
+					Object().Err(nil).Err(errors.New(`err 2`)).As(`obj`).End().
+					Array().Err(nil).Err(errors.New(`err 3`)).As(`arr`).End().
+					Log(``)
+			},
+			Output: `{"err":"err 1","obj":{"err":null,"err":"err 2"},"arr":[null,"err 3"]}`,
+		},
+		{
+			Name: `string`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Str(`a`, "A\"\n\x01").
+					Object().Str(`ba`, `BA`).As(`b`).End().
+					Array().Str(`CA`).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":"A\"\n\u0001","b":{"ba":"BA"},"c":["CA"]}`,
+		},
+		{
+			Name: `int`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Int(`a`, 1).
+					Object().Int(`ba`, -2).As(`b`).End().
+					Array().Int(3).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":1,"b":{"ba":-2},"c":[3]}`,
+		},
+		{
+			Name: `float32`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Float32(`a`, 1.1).
+					Object().Float32(`ba`, float32(math.Inf(1))).As(`b`).End().
+					Array().Float32(3.3).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":1.1,"b":{"ba":"+Inf"},"c":[3.3]}`,
+		},
+		{
+			Name: `float64`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Float64(`a`, 1.1).
+					Object().Float64(`ba`, 1e-7).As(`b`).End().
+					Array().Float64(math.NaN()).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":1.1,"b":{"ba":1e-7},"c":["NaN"]}`,
+		},
+		{
+			Name: `bool`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Bool(`a`, true).
+					Object().Bool(`ba`, false).As(`b`).End().
+					Array().Bool(true).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":true,"b":{"ba":false},"c":[true]}`,
+		},
+		{
+			Name: `int64`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Int64(`a`, 1).
+					Object().Int64(`ba`, math.MinInt64).As(`b`).End().
+					Array().Int64(3).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":1,"b":{"ba":-9223372036854775808},"c":[3]}`,
+		},
+		{
+			Name: `uint64`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Uint64(`a`, 1).
+					Object().Uint64(`ba`, math.MaxUint64).As(`b`).End().
+					Array().Uint64(3).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":1,"b":{"ba":18446744073709551615},"c":[3]}`,
+		},
+		{
+			Name: `time`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Time(`a`, time.Unix(0, 1558069640361696123)).
+					Object().Time(`ba`, time.Unix(1558069640, 0)).As(`b`).End().
+					Array().Time(time.Unix(0, 1558069640361000000)).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":"2019-05-17T05:07:20.361696123Z","b":{"ba":"2019-05-17T05:07:20Z"},"c":["2019-05-17T05:07:20.361Z"]}`,
+		},
+		{
+			Name: `duration`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Dur(`a`, time.Second*3/2).
+					Object().Dur(`ba`, -time.Nanosecond).As(`b`).End().
+					Array().Dur(0).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":"1.500s","b":{"ba":"-0.000000001s"},"c":["0s"]}`,
+		},
+		{
+			Name: `base64`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Base64(`a`, []byte(`val 7`), nil).
+					Object().Base64(`ba`, []byte(`val 7`), base64.RawURLEncoding).As(`b`).End().
+					Array().Base64(nil, nil).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":"dmFsIDc=","b":{"ba":"dmFsIDc="},"c":[""]}`,
+		},
+		{
+			Name: `raw json`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					RawJSON(`a`, json.RawMessage(`{"k":[1]}`)).
+					Object().RawJSON(`ba`, nil).As(`b`).End().
+					Array().RawJSON(json.RawMessage(`true`)).As(`c`).End().
+					Log(``)
+			},
+			Output: `{"a":{"k":[1]},"b":{"ba":null},"c":[true]}`,
+		},
+		{
+			Name: `nested`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Object().
+					Array().Str(`a`).Object().Int(`b`, 1).Add().Array().Add().As(`c`).
+					Object().As(`"d"`).
+					As(`e`).End().
+					Log(`msg`)
+			},
+			Output: `{"e":{"c":["a",{"b":1},[]],"\"d\"":{}},"msg":"msg"}`,
+		},
+		{
+			Name: `group`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Str(`a`, `A`).
+					Group(`g1`).
+					Str(`b`, `B`).
+					Group(`g2`).
+					Log(`msg`)
+			},
+			Output: `{"a":"A","g1":{"b":"B","g2":{}},"msg":"msg"}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			h := newHarness(t)
+			tc.Log(h.L)
+			var out bytes.Buffer
+			if err := ToJSONLines(&out, &h.B); err != nil {
+				t.Fatal(err)
+			}
+			if s := out.String(); s != tc.Output+"\n" {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestEvent_Level_nil(t *testing.T) {
+	if v := (*Event)(nil).Level(); v != logiface.LevelDisabled {
+		t.Error(v)
+	}
+}
+
+func TestEvent_Bytes(t *testing.T) {
+	var buf bytes.Buffer
+	var bytesBefore, bytesAfter string
+	logger := L.New(
+		L.WithCBOR(WithWriter(&buf)),
+		L.WithWriter(L.NewWriterFunc(func(event *Event) error {
+			bytesAfter = string(event.Bytes())
+			return nil
+		})),
+		L.WithModifier(L.NewModifierFunc(func(event *Event) error {
+			bytesBefore = string(event.Bytes())
+			return nil
+		})),
+	)
+	logger.Info().Group(`g`).Str(`k`, `v`).Log(`m`)
+	if bytesBefore != "\xbf\x63lvl\x64info" {
+		t.Errorf("unexpected bytes before: %q", bytesBefore)
+	}
+	if bytesAfter != "\xbf\x63lvl\x64info\x61g\xbf\x61k\x61v" {
+		t.Errorf("unexpected bytes after: %q", bytesAfter)
+	}
+	if s := buf.String(); s != "" {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
@@ -0,0 +1,44 @@
+package cbor_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/joeycumines/logiface/cbor"
+	"os"
+	"time"
+)
+
+func ExampleWithCBOR() {
+	var buf bytes.Buffer
+
+	logger := cbor.L.New(cbor.L.WithCBOR(
+		cbor.WithWriter(&buf),
+		cbor.WithLevelField(`level`),
+	))
+
+	logger.Info().
+		Str(`request_id`, `c7d5a8f1`).
+		Uint64(`bytes`, 18446744073709551615).
+		Dur(`latency`, 1500*time.Millisecond).
+		Base64(`token`, []byte{0xde, 0xad, 0xbe, 0xef}, nil).
+		Object().
+		Str(`method`, `GET`).
+		Array().Str(`admin`).Str(`user`).As(`roles`).
+		As(`request`).End().
+		Log(`handled request`)
+
+	logger.Err().
+		Err(fmt.Errorf(`an error`)).
+		Log(`what happened`)
+
+	fmt.Printf("%d bytes\n", buf.Len())
+
+	if err := cbor.ToJSONLines(os.Stdout, &buf); err != nil {
+		panic(err)
+	}
+
+	//output:
+	//182 bytes
+	//{"level":"info","request_id":"c7d5a8f1","bytes":18446744073709551615,"latency":"1.500s","token":"3q2+7w==","request":{"method":"GET","roles":["admin","user"]},"msg":"handled request"}
+	//{"level":"err","err":"an error","msg":"what happened"}
+}
@@ -0,0 +1,172 @@
+package cbor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type errWriter struct{ err error }
+
+func (x errWriter) Write([]byte) (int, error) { return 0, x.err }
+
+func TestNewLogger_defaults(t *testing.T) {
+	l := NewLogger()
+	if l.writer != os.Stderr {
+		t.Error(l.writer)
+	}
+	if l.timeField != `` || l.levelField != "\x63lvl" || l.messageField != "\x63msg" || l.errorField != "\x63err" {
+		t.Errorf(`unexpected fields: %+v`, l)
+	}
+}
+
+func TestNewLogger_fields(t *testing.T) {
+	l := NewLogger(
+		WithTimeField(`t"s`),
+		WithLevelField(`level`),
+		WithMessageField(``),
+		WithErrorField(`error`),
+	)
+	if l.timeField != "\x63t\"s" || l.levelField != "\x65level" || l.messageField != "\x63msg" || l.errorField != "\x65error" {
+		t.Errorf(`unexpected fields: %+v`, l)
+	}
+}
+
+func TestLogger_timeField(t *testing.T) {
+	old := timeNow
+	defer func() { timeNow = old }()
+	timeNow = func() time.Time { return time.Unix(1680693679, 496235772) }
+
+	var buf bytes.Buffer
+	logger := L.New(L.WithCBOR(WithWriter(&buf), WithTimeField(`ts`)), L.WithLevel(logiface.LevelTrace))
+	logger.Trace().Err(errors.New(`some error`)).Log(`hello`)
+	logger.Build(100).Log(``)
+	var out bytes.Buffer
+	if err := ToJSONLines(&out, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := out.String(); s != `{"ts":"2023-04-05T11:21:19.496235772Z","lvl":"trace","err":"some error","msg":"hello"}`+"\n"+
+		`{"ts":"2023-04-05T11:21:19.496235772Z","lvl":"100"}`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_Write_error(t *testing.T) {
+	expected := errors.New(`some error`)
+	logger := L.New(L.WithCBOR(WithWriter(errWriter{expected})))
+	if err := logger.Log(logiface.LevelError, nil); err != expected {
+		t.Error(err)
+	}
+}
+
+func TestLogger_ReleaseEvent_oversized(t *testing.T) {
+	l := NewLogger(WithWriter(io.Discard))
+	e := l.NewEvent(logiface.LevelInformational)
+	e.buf = make([]byte, 0, 1<<17)
+	l.ReleaseEvent(e)
+	if e.logger != l {
+		t.Error(`expected oversized event to be discarded, without being reset`)
+	}
+}
+
+func TestLogger_nestedKeys(t *testing.T) {
+	var buf bytes.Buffer
+	logger := L.New(L.WithCBOR(WithWriter(&buf), WithLevelField(``)))
+	logger.Info().
+		Object().
+		Object().
+		Array().Int(1).Object().Str(`k`, `v`).Add().As(`a key which is longer than 23 bytes`).CurObject().
+		Str(`b`, `c`).
+		As(`another key which is longer than 255 bytes` + strings.Repeat(`.`, 256)).
+		As(`o`).
+		End().
+		Log(`m`)
+	var out bytes.Buffer
+	if err := ToJSONLines(&out, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := out.String(); s != `{"o":{"another key which is longer than 255 bytes`+strings.Repeat(`.`, 256)+`":{"a key which is longer than 23 bytes":[1,{"k":"v"}],"b":"c"}},"msg":"m"}`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_allocs(t *testing.T) {
+	logger := L.New(L.WithCBOR(WithWriter(io.Discard), WithTimeField(`time`)))
+	b := []byte(`bytes`)
+	if n := testing.AllocsPerRun(100, func() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Time(`time`, time.Time{}).
+			Base64(`base64`, b, nil).
+			Object().
+			Str(`a`, `b`).
+			Array().Int(1).Int(2).As(`c`).
+			As(`obj`).End().
+			Log(`the message`)
+	}); n != 0 {
+		t.Errorf(`unexpected allocs: %v`, n)
+	}
+}
+
+func BenchmarkLogger_message(b *testing.B) {
+	logger := L.New(L.WithCBOR(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_fields(b *testing.B) {
+	logger := L.New(L.WithCBOR(WithWriter(io.Discard), WithTimeField(`time`)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_nested(b *testing.B) {
+	logger := L.New(L.WithCBOR(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Object().
+			Str(`a`, `b`).
+			Array().Int(1).Int(2).Object().Bool(`c`, true).Add().As(`d`).
+			As(`obj`).End().
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_parallel(b *testing.B) {
+	logger := L.New(L.WithCBOR(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			logger.Info().
+				Str(`str`, `some string`).
+				Int(`int`, 123).
+				Log(`The quick brown fox jumps over the lazy dog`)
+		}
+	})
+}
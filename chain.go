@@ -3,6 +3,8 @@ package logiface
 import (
 	"encoding/base64"
 	"encoding/json"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -77,6 +79,17 @@ type (
 		objInt64(obj any, key string, val int64) (any, bool)
 		objUint64(obj any, key string, val uint64) (any, bool)
 		objRawJSON(obj any, key string, val json.RawMessage) (any, bool)
+		objInt8(obj any, key string, val int8) (any, bool)
+		objInt16(obj any, key string, val int16) (any, bool)
+		objInt32(obj any, key string, val int32) (any, bool)
+		objUint(obj any, key string, val uint) (any, bool)
+		objUint8(obj any, key string, val uint8) (any, bool)
+		objUint16(obj any, key string, val uint16) (any, bool)
+		objUint32(obj any, key string, val uint32) (any, bool)
+		objIPAddr(obj any, key string, val netip.Addr) (any, bool)
+		objIPPrefix(obj any, key string, val netip.Prefix) (any, bool)
+		objURL(obj any, key string, val *url.URL) (any, bool)
+		objHexBytes(obj any, key string, val []byte) (any, bool)
 
 		arrNewObject(arr any) any
 		arrWriteObject(arr, val any) (any, bool)
@@ -97,6 +110,17 @@ type (
 		arrInt64(arr any, val int64) (any, bool)
 		arrUint64(arr any, val uint64) (any, bool)
 		arrRawJSON(arr any, val json.RawMessage) (any, bool)
+		arrInt8(arr any, val int8) (any, bool)
+		arrInt16(arr any, val int16) (any, bool)
+		arrInt32(arr any, val int32) (any, bool)
+		arrUint(arr any, val uint) (any, bool)
+		arrUint8(arr any, val uint8) (any, bool)
+		arrUint16(arr any, val uint16) (any, bool)
+		arrUint32(arr any, val uint32) (any, bool)
+		arrIPAddr(arr any, val netip.Addr) (any, bool)
+		arrIPPrefix(arr any, val netip.Prefix) (any, bool)
+		arrURL(arr any, val *url.URL) (any, bool)
+		arrHexBytes(arr any, val []byte) (any, bool)
 	}
 
 	chainInterface interface {
@@ -522,6 +546,61 @@ func (x *Chain[E, P]) objRawJSON(obj any, key string, val json.RawMessage) (any,
 	return x.current().objRawJSON(obj, key, val)
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objInt8(obj any, key string, val int8) (any, bool) {
+	return x.current().objInt8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objInt16(obj any, key string, val int16) (any, bool) {
+	return x.current().objInt16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objInt32(obj any, key string, val int32) (any, bool) {
+	return x.current().objInt32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objUint(obj any, key string, val uint) (any, bool) {
+	return x.current().objUint(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objUint8(obj any, key string, val uint8) (any, bool) {
+	return x.current().objUint8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objUint16(obj any, key string, val uint16) (any, bool) {
+	return x.current().objUint16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objUint32(obj any, key string, val uint32) (any, bool) {
+	return x.current().objUint32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objIPAddr(obj any, key string, val netip.Addr) (any, bool) {
+	return x.current().objIPAddr(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objIPPrefix(obj any, key string, val netip.Prefix) (any, bool) {
+	return x.current().objIPPrefix(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objURL(obj any, key string, val *url.URL) (any, bool) {
+	return x.current().objURL(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) objHexBytes(obj any, key string, val []byte) (any, bool) {
+	return x.current().objHexBytes(obj, key, val)
+}
+
 //lint:ignore U1000 it is or will be used
 func (x *Chain[E, P]) jsonNewArray(key string) any {
 	return x.current().jsonNewArray(key)
@@ -617,6 +696,61 @@ func (x *Chain[E, P]) arrRawJSON(arr any, val json.RawMessage) (any, bool) {
 	return x.current().arrRawJSON(arr, val)
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrInt8(arr any, val int8) (any, bool) {
+	return x.current().arrInt8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrInt16(arr any, val int16) (any, bool) {
+	return x.current().arrInt16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrInt32(arr any, val int32) (any, bool) {
+	return x.current().arrInt32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrUint(arr any, val uint) (any, bool) {
+	return x.current().arrUint(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrUint8(arr any, val uint8) (any, bool) {
+	return x.current().arrUint8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrUint16(arr any, val uint16) (any, bool) {
+	return x.current().arrUint16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrUint32(arr any, val uint32) (any, bool) {
+	return x.current().arrUint32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrIPAddr(arr any, val netip.Addr) (any, bool) {
+	return x.current().arrIPAddr(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrIPPrefix(arr any, val netip.Prefix) (any, bool) {
+	return x.current().arrIPPrefix(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrURL(arr any, val *url.URL) (any, bool) {
+	return x.current().arrURL(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Chain[E, P]) arrHexBytes(arr any, val []byte) (any, bool) {
+	return x.current().arrHexBytes(arr, val)
+}
+
 func (x *Chain[E, P]) current() (p Parent[E]) {
 	if x != nil {
 		p, _ = x.b.(Parent[E])
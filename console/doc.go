@@ -0,0 +1,13 @@
+// Package console implements a human-friendly logiface logger, intended for
+// local development.
+//
+// Each event is written as a single line, consisting of the timestamp, a
+// (optionally coloured) level keyword, the message (unquoted, but with
+// control characters escaped), then any fields, as key=value pairs. Nested objects and arrays (see [logiface.ObjectBuilder],
+// and [logiface.ArrayBuilder]) are rendered inline, preserving field order,
+// and the rate limit metadata (`_limited`, by default, see [WithFieldSchema])
+// is rendered in a compact form.
+//
+// Colour is enabled automatically, if the writer is a terminal, and the
+// NO_COLOR environment variable is not set, see also [WithColor].
+package console
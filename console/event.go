@@ -0,0 +1,141 @@
+package console
+
+import (
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"time"
+)
+
+type (
+	// Event implements [logiface.Event], buffering the fields, in order, to
+	// be formatted by [Logger.Write].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// Time is the time the event was created.
+		Time time.Time
+
+		// Message is the log message, if any.
+		Message string
+
+		// Fields are the fields of the event, in the order they were added.
+		Fields []Field
+
+		logger *Logger
+		group  string
+		lvl    logiface.Level
+	}
+
+	// Object is the [logiface.JSONSupport] object type, for [Event].
+	Object struct {
+		Fields []Field
+	}
+
+	// Array is the [logiface.JSONSupport] array type, for [Event].
+	Array struct {
+		Values []any
+	}
+
+	// Field is a key-value pair, of an [Event] or [Object].
+	Field struct {
+		Key   string
+		Value any
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.add(key, val)
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	x.Message = msg
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.add(`err`, err)
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.add(key, val)
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.add(key, val)
+	return true
+}
+
+// AddGroup prefixes the keys of all subsequent fields with the group name,
+// and a period.
+func (x *Event) AddGroup(name string) bool {
+	x.group += name + `.`
+	return true
+}
+
+func (x *Event) add(key string, val any) {
+	if x.group != `` {
+		key = x.group + key
+	}
+	x.Fields = append(x.Fields, Field{Key: key, Value: val})
+}
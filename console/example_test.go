@@ -0,0 +1,35 @@
+package console_test
+
+import (
+	"errors"
+	"github.com/joeycumines/logiface/console"
+	"os"
+)
+
+func ExampleWithConsole() {
+	logger := console.L.New(console.L.WithConsole(
+		console.WithWriter(os.Stdout),
+		console.WithTimeFormat(``), // disabled for the example
+		console.WithMessageWidth(20),
+	))
+
+	logger.Info().
+		Str(`request_id`, `c7d5a8f1`).
+		Int(`status`, 200).
+		Object().
+		Str(`method`, `GET`).
+		Array().Str(`admin`).Str(`user`).As(`roles`).
+		As(`request`).End().
+		Log(`handled request`)
+
+	logger.Err().
+		Err(errors.New(`connection refused`)).
+		Log(`failed to connect`)
+
+	logger.Warning().Log(`no fields`)
+
+	//output:
+	//INF handled request      request_id=c7d5a8f1 status=200 request={method=GET roles=[admin user]}
+	//ERR failed to connect    err="connection refused"
+	//WRN no fields
+}
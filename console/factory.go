@@ -0,0 +1,187 @@
+package console
+
+import (
+	"github.com/joeycumines/logiface"
+	"io"
+	"os"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		writer       io.Writer
+		color        *bool
+		timeFormat   *string
+		messageWidth *int
+		schema       *logiface.FieldSchema
+	}
+)
+
+const (
+	// DefaultTimeFormat is the default layout used to format the timestamp.
+	DefaultTimeFormat = `15:04:05.000`
+
+	// DefaultMessageWidth is the default width the message is padded to, if
+	// the event has fields.
+	DefaultMessageWidth = 40
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithConsole configures a logiface logger to write human-friendly lines,
+// using the implementations provided by this package.
+//
+// By default, events are written to [os.Stderr]. If [WithFieldSchema] is
+// provided, the schema is also applied to the logiface logger.
+func WithConsole(options ...Option) logiface.Option[*Event] {
+	l := NewLogger(options...)
+	opts := []logiface.Option[*Event]{
+		L.WithWriter(l),
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+		logiface.WithJSONSupport[*Event, *Object, *Array](l),
+	}
+	if l.schema != nil {
+		opts = append(opts, L.WithFieldSchema(*l.schema))
+	}
+	return L.WithOptions(opts...)
+}
+
+// WithConsole is an alias of the package function of the same name.
+func (LoggerFactory) WithConsole(options ...Option) logiface.Option[*Event] {
+	return WithConsole(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.EventReleaser], [logiface.Writer], and
+// [logiface.JSONSupport] interfaces. Most users should prefer [WithConsole].
+func NewLogger(options ...Option) *Logger {
+	var c loggerConfig
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{
+		writer:       c.writer,
+		timeFormat:   DefaultTimeFormat,
+		messageWidth: DefaultMessageWidth,
+	}
+
+	if l.writer == nil {
+		l.writer = os.Stderr
+	}
+
+	if c.color != nil {
+		l.color = *c.color
+	} else {
+		l.color = isTerminal(l.writer) && os.Getenv(`NO_COLOR`) == ``
+	}
+
+	if c.timeFormat != nil {
+		l.timeFormat = *c.timeFormat
+	}
+
+	if c.messageWidth != nil {
+		l.messageWidth = max(*c.messageWidth, 0)
+	}
+
+	l.limitedKey, l.limitedCategoryKey, l.limitedUntilKey = `_limited`, `category`, `until`
+	if c.schema != nil {
+		l.schema = c.schema
+		if c.schema.LimitedKey != `` {
+			l.limitedKey = c.schema.LimitedKey
+		}
+		if c.schema.LimitedCategoryKey != `` {
+			l.limitedCategoryKey = c.schema.LimitedCategoryKey
+		}
+		if c.schema.LimitedUntilKey != `` {
+			l.limitedUntilKey = c.schema.LimitedUntilKey
+		}
+	}
+
+	return &l
+}
+
+// WithWriter configures the destination for the logger, which defaults to
+// [os.Stderr]. Each event is written using a single call to Write.
+func WithWriter(writer io.Writer) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.writer = writer
+	})
+}
+
+// WithColor explicitly enables or disables colour, which is otherwise
+// enabled only if the writer is a terminal, and the NO_COLOR environment
+// variable is unset or empty.
+func WithColor(enabled bool) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.color = &enabled
+	})
+}
+
+// WithTimeFormat configures the layout used to format the timestamp, which
+// defaults to [DefaultTimeFormat]. An empty layout disables the timestamp.
+func WithTimeFormat(layout string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.timeFormat = &layout
+	})
+}
+
+// WithMessageWidth configures the width the message is padded to, in order
+// to align the fields of subsequent lines, which defaults to
+// [DefaultMessageWidth]. A width of 0 disables padding.
+func WithMessageWidth(width int) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.messageWidth = &width
+	})
+}
+
+// WithFieldSchema configures the [logiface.FieldSchema] used to recognise the
+// rate limit metadata, see [logiface.Builder.Limit], which must match the
+// schema of the logiface logger. [WithConsole] applies it to both.
+func WithFieldSchema(schema logiface.FieldSchema) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.schema = &schema
+	})
+}
+
+// isTerminal reports whether w is a character device, e.g. a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Stat() (os.FileInfo, error) })
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,291 @@
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"path"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	colorReset   = "\x1b[0m"
+	colorBold    = "\x1b[1m"
+	colorRed     = "\x1b[31m"
+	colorGreen   = "\x1b[32m"
+	colorYellow  = "\x1b[33m"
+	colorBlue    = "\x1b[34m"
+	colorMagenta = "\x1b[35m"
+	colorCyan    = "\x1b[36m"
+	colorGray    = "\x1b[90m"
+)
+
+// LevelKeyword returns the (fixed width) keyword used to identify the level.
+// Custom levels are formatted as integers.
+func LevelKeyword(level logiface.Level) string {
+	switch level {
+	case logiface.LevelEmergency:
+		return `EMG`
+	case logiface.LevelAlert:
+		return `ALR`
+	case logiface.LevelCritical:
+		return `CRT`
+	case logiface.LevelError:
+		return `ERR`
+	case logiface.LevelWarning:
+		return `WRN`
+	case logiface.LevelNotice:
+		return `NTC`
+	case logiface.LevelInformational:
+		return `INF`
+	case logiface.LevelDebug:
+		return `DBG`
+	case logiface.LevelTrace:
+		return `TRC`
+	default:
+		return strconv.FormatInt(int64(level), 10)
+	}
+}
+
+func levelColor(level logiface.Level) string {
+	switch level {
+	case logiface.LevelEmergency, logiface.LevelAlert, logiface.LevelCritical:
+		return colorBold + colorRed
+	case logiface.LevelError:
+		return colorRed
+	case logiface.LevelWarning:
+		return colorYellow
+	case logiface.LevelNotice:
+		return colorCyan
+	case logiface.LevelInformational:
+		return colorGreen
+	case logiface.LevelDebug:
+		return colorBlue
+	case logiface.LevelTrace:
+		return colorMagenta
+	default:
+		return colorBold
+	}
+}
+
+func (x *Logger) appendEvent(dst []byte, e *Event) []byte {
+	if x.timeFormat != `` {
+		dst = x.appendColor(dst, colorGray)
+		dst = e.Time.AppendFormat(dst, x.timeFormat)
+		dst = x.appendReset(dst, colorGray)
+		dst = append(dst, ' ')
+	}
+
+	dst = x.appendColor(dst, levelColor(e.lvl))
+	dst = append(dst, LevelKeyword(e.lvl)...)
+	dst = x.appendReset(dst, levelColor(e.lvl))
+
+	if e.Message != `` || len(e.Fields) != 0 {
+		dst = append(dst, ' ')
+	}
+
+	start := len(dst)
+	dst = appendMessage(dst, e.Message)
+
+	if len(e.Fields) != 0 {
+		for n := utf8.RuneCount(dst[start:]); n < x.messageWidth; n++ {
+			dst = append(dst, ' ')
+		}
+		for i, field := range e.Fields {
+			if i != 0 || x.messageWidth == 0 || e.Message != `` {
+				dst = append(dst, ' ')
+			}
+			dst = x.appendField(dst, field, true)
+		}
+	}
+
+	return append(dst, '\n')
+}
+
+func (x *Logger) appendField(dst []byte, field Field, top bool) []byte {
+	dst = x.appendColor(dst, colorCyan)
+	dst = appendString(dst, field.Key)
+	dst = append(dst, '=')
+	dst = x.appendReset(dst, colorCyan)
+
+	if top && field.Key == x.limitedKey {
+		if b, ok := x.appendLimited(dst, field.Value); ok {
+			return b
+		}
+	}
+
+	if _, ok := field.Value.(error); ok {
+		dst = x.appendColor(dst, colorRed)
+		dst = x.appendValue(dst, field.Value)
+		return x.appendReset(dst, colorRed)
+	}
+
+	return x.appendValue(dst, field.Value)
+}
+
+// appendLimited renders the rate limit metadata in the compact form
+// {file:line until}, returning false if the value is not in the expected
+// format.
+func (x *Logger) appendLimited(dst []byte, val any) ([]byte, bool) {
+	limited, ok := val.(*Object)
+	if !ok {
+		return dst, false
+	}
+	category, ok := limited.get(x.limitedCategoryKey).(*Object)
+	if !ok {
+		return dst, false
+	}
+	file, ok := category.get(`file`).(string)
+	if !ok {
+		return dst, false
+	}
+	line, ok := category.get(`line`).(int)
+	if !ok {
+		return dst, false
+	}
+	until, ok := limited.get(x.limitedUntilKey).(time.Duration)
+	if !ok {
+		return dst, false
+	}
+	dst = x.appendColor(dst, colorYellow)
+	dst = append(dst, '{')
+	dst = appendString(dst, path.Base(file))
+	dst = append(dst, ':')
+	dst = strconv.AppendInt(dst, int64(line), 10)
+	dst = append(dst, ' ')
+	dst = append(dst, until.Round(time.Millisecond).String()...)
+	dst = append(dst, '}')
+	return x.appendReset(dst, colorYellow), true
+}
+
+func (x *Logger) appendValue(dst []byte, val any) []byte {
+	switch val := val.(type) {
+	case nil:
+		return append(dst, `<nil>`...)
+	case string:
+		return appendString(dst, val)
+	case error:
+		return appendString(dst, val.Error())
+	case bool:
+		return strconv.AppendBool(dst, val)
+	case int:
+		return strconv.AppendInt(dst, int64(val), 10)
+	case int64:
+		return strconv.AppendInt(dst, val, 10)
+	case uint64:
+		return strconv.AppendUint(dst, val, 10)
+	case float32:
+		return strconv.AppendFloat(dst, float64(val), 'g', -1, 32)
+	case float64:
+		return strconv.AppendFloat(dst, val, 'g', -1, 64)
+	case time.Time:
+		return val.AppendFormat(dst, time.RFC3339Nano)
+	case time.Duration:
+		return append(dst, val.String()...)
+	case json.RawMessage:
+		return appendJSON(dst, val)
+	case *Object:
+		dst = append(dst, '{')
+		for i, field := range val.Fields {
+			if i != 0 {
+				dst = append(dst, ' ')
+			}
+			dst = x.appendField(dst, field, false)
+		}
+		return append(dst, '}')
+	case *Array:
+		dst = append(dst, '[')
+		for i, v := range val.Values {
+			if i != 0 {
+				dst = append(dst, ' ')
+			}
+			dst = x.appendValue(dst, v)
+		}
+		return append(dst, ']')
+	case fmt.Stringer:
+		return appendString(dst, val.String())
+	}
+	if b, err := json.Marshal(val); err == nil {
+		return appendJSON(dst, b)
+	}
+	return appendString(dst, fmt.Sprint(val))
+}
+
+func (x *Logger) appendColor(dst []byte, color string) []byte {
+	if x.color {
+		dst = append(dst, color...)
+	}
+	return dst
+}
+
+func (x *Logger) appendReset(dst []byte, color string) []byte {
+	if x.color && color != `` {
+		dst = append(dst, colorReset...)
+	}
+	return dst
+}
+
+func (x *Object) get(key string) any {
+	for _, field := range x.Fields {
+		if field.Key == key {
+			return field.Value
+		}
+	}
+	return nil
+}
+
+// appendMessage appends val unquoted, escaping (only) control characters,
+// other non-printable characters, and invalid UTF-8, per strconv.Quote, so
+// that each event is a single line.
+func appendMessage(dst []byte, val string) []byte {
+	for i := 0; i < len(val); {
+		r, size := utf8.DecodeRuneInString(val[i:])
+		if (r == utf8.RuneError && size == 1) || !strconv.IsPrint(r) {
+			q := strconv.Quote(val[i : i+size])
+			dst = append(dst, q[1:len(q)-1]...)
+		} else {
+			dst = append(dst, val[i:i+size]...)
+		}
+		i += size
+	}
+	return dst
+}
+
+// appendString appends val, quoting it only if necessary.
+func appendString(dst []byte, val string) []byte {
+	if needsQuote(val) {
+		return strconv.AppendQuote(dst, val)
+	}
+	return append(dst, val...)
+}
+
+func needsQuote(val string) bool {
+	if val == `` {
+		return true
+	}
+	for _, r := range val {
+		switch r {
+		case '"', '=', '\\', '{', '}', '[', ']', utf8.RuneError:
+			return true
+		}
+		if r <= ' ' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendJSON(dst []byte, val json.RawMessage) []byte {
+	if len(val) == 0 {
+		return append(dst, `null`...)
+	}
+	buf := bytes.NewBuffer(dst)
+	if err := json.Compact(buf, val); err != nil {
+		return append(dst, val...)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,170 @@
+package console
+
+import (
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"io"
+	"sync"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser],
+	// [logiface.Writer], and [logiface.JSONSupport], for [Event].
+	// See also [NewLogger], and [WithConsole].
+	Logger struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedJSONSupport
+
+		writer       io.Writer
+		timeFormat   string
+		messageWidth int
+		color        bool
+
+		schema             *logiface.FieldSchema
+		limitedKey         string
+		limitedCategoryKey string
+		limitedUntilKey    string
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedJSONSupport = logiface.UnimplementedJSONSupport[*Event, *Object, *Array]
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]                 = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event]                = (*Logger)(nil)
+	_ logiface.Writer[*Event]                       = (*Logger)(nil)
+	_ logiface.JSONSupport[*Event, *Object, *Array] = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{Fields: make([]Field, 0, 16)}
+	}}
+	bufPool = sync.Pool{New: func() any {
+		b := make([]byte, 0, 1<<10)
+		return &b
+	}}
+	timeNow = time.Now
+)
+
+func (x *Logger) NewEvent(level logiface.Level) *Event {
+	e := eventPool.Get().(*Event)
+	e.logger = x
+	e.lvl = level
+	if x.timeFormat != `` {
+		e.Time = timeNow()
+	}
+	return e
+}
+
+// Write formats the event, writing it to the underlying io.Writer, as a
+// single line, terminated by a newline.
+func (x *Logger) Write(event *Event) error {
+	buf := bufPool.Get().(*[]byte)
+	*buf = x.appendEvent((*buf)[:0], event)
+	_, err := x.writer.Write(*buf)
+	if cap(*buf) <= 1<<16 {
+		bufPool.Put(buf)
+	}
+	return err
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.Fields) <= 1<<10 {
+		// clear references that might need to be garbage collected
+		clear(e.Fields)
+		*e = Event{Fields: e.Fields[:0]}
+		eventPool.Put(e)
+	}
+}
+
+func (x *Logger) NewObject() *Object {
+	return new(Object)
+}
+
+func (x *Logger) AddObject(evt *Event, key string, obj *Object) {
+	evt.add(key, obj)
+}
+
+func (x *Logger) SetField(obj *Object, key string, val any) *Object {
+	obj.Fields = append(obj.Fields, Field{Key: key, Value: val})
+	return obj
+}
+
+func (x *Logger) CanSetObject() bool { return true }
+
+func (x *Logger) SetObject(obj *Object, key string, val *Object) *Object {
+	return x.SetField(obj, key, val)
+}
+
+func (x *Logger) CanSetArray() bool { return true }
+
+func (x *Logger) SetArray(obj *Object, key string, val *Array) *Object {
+	return x.SetField(obj, key, val)
+}
+
+func (x *Logger) CanSetTime() bool { return true }
+
+func (x *Logger) SetTime(obj *Object, key string, t time.Time) *Object {
+	return x.SetField(obj, key, t)
+}
+
+func (x *Logger) CanSetDuration() bool { return true }
+
+func (x *Logger) SetDuration(obj *Object, key string, d time.Duration) *Object {
+	return x.SetField(obj, key, d)
+}
+
+func (x *Logger) CanSetRawJSON() bool { return true }
+
+func (x *Logger) SetRawJSON(obj *Object, key string, b json.RawMessage) *Object {
+	return x.SetField(obj, key, b)
+}
+
+func (x *Logger) NewArray() *Array {
+	return new(Array)
+}
+
+func (x *Logger) AddArray(evt *Event, key string, arr *Array) {
+	evt.add(key, arr)
+}
+
+func (x *Logger) AppendField(arr *Array, val any) *Array {
+	arr.Values = append(arr.Values, val)
+	return arr
+}
+
+func (x *Logger) CanAppendObject() bool { return true }
+
+func (x *Logger) AppendObject(arr *Array, val *Object) *Array {
+	return x.AppendField(arr, val)
+}
+
+func (x *Logger) CanAppendArray() bool { return true }
+
+func (x *Logger) AppendArray(arr *Array, val *Array) *Array {
+	return x.AppendField(arr, val)
+}
+
+func (x *Logger) CanAppendTime() bool { return true }
+
+func (x *Logger) AppendTime(arr *Array, t time.Time) *Array {
+	return x.AppendField(arr, t)
+}
+
+func (x *Logger) CanAppendDuration() bool { return true }
+
+func (x *Logger) AppendDuration(arr *Array, d time.Duration) *Array {
+	return x.AppendField(arr, d)
+}
+
+func (x *Logger) CanAppendRawJSON() bool { return true }
+
+func (x *Logger) AppendRawJSON(arr *Array, b json.RawMessage) *Array {
+	return x.AppendField(arr, b)
+}
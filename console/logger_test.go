@@ -0,0 +1,194 @@
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestLogger_fieldTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range [...]struct {
+		Name   string
+		Log    func(l *logiface.Logger[*Event])
+		Output string
+	}{
+		{
+			Name:   `message only`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Log(`some message`) },
+			Output: `INF some message`,
+		},
+		{
+			Name:   `no message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Notice().Log(``) },
+			Output: `NTC`,
+		},
+		{
+			Name: `scalars`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Warning().
+					Str(`str`, `value`).
+					Str(`quoted`, `a "b" c`).
+					Str(`empty`, ``).
+					Int(`int`, -1).
+					Int64(`int64`, 2).
+					Uint64(`uint64`, 3).
+					Float32(`float32`, 1.5).
+					Float64(`float64`, 2.25).
+					Bool(`bool`, true).
+					Dur(`dur`, time.Second*3/2).
+					Time(`time`, time.Unix(1680693679, 0).UTC()).
+					Base64(`b64`, []byte(`hi`), nil).
+					RawJSON(`raw`, json.RawMessage("{\"a\": [1,\n 2]}")).
+					Any(`any`, map[string]int{`k`: 1}).
+					Err(errors.New(`some error`)).
+					Log(`msg`)
+			},
+			Output: `WRN msg str=value quoted="a \"b\" c" empty="" int=-1 int64=2 uint64=3 float32=1.5 float64=2.25 bool=true dur=1.5s time=2023-04-05T11:21:19Z b64="aGk=" raw={"a":[1,2]} any={"k":1} err="some error"`,
+		},
+		{
+			Name: `nested`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Err().
+					Object().
+					Str(`a`, `b`).
+					Time(`t`, time.Unix(0, 0).UTC()).
+					Dur(`dur`, time.Millisecond).
+					Array().Int(1).Err(errors.New(`e`)).Object().Bool(`c`, true).Add().Array().Add().As(`d`).
+					As(`obj`).End().
+					Log(`msg`)
+			},
+			Output: `ERR msg obj={a=b t=1970-01-01T00:00:00Z dur=1ms d=[1 e {c=true} []]}`,
+		},
+		{
+			Name: `group`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Debug().
+					Str(`a`, `A`).
+					Group(`g1`).
+					Str(`b`, `B`).
+					Group(`g2`).
+					Str(`c`, `C`).
+					Log(`msg`)
+			},
+			Output: `DBG msg a=A g1.b=B g1.g2.c=C`,
+		},
+		{
+			Name:   `custom level`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Build(100).Log(`msg`) },
+			Output: `100 msg`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			l := L.New(
+				L.WithConsole(WithWriter(&buf), WithTimeFormat(``), WithMessageWidth(0)),
+				L.WithLevel(logiface.LevelTrace),
+			)
+			tc.Log(l)
+			if s := buf.String(); s != tc.Output+"\n" {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestLogger_alignment(t *testing.T) {
+	old := timeNow
+	defer func() { timeNow = old }()
+	timeNow = func() time.Time { return time.Date(2023, 4, 5, 11, 21, 19, 496235772, time.UTC) }
+
+	var buf bytes.Buffer
+	l := L.New(L.WithConsole(WithWriter(&buf), WithMessageWidth(10)))
+	l.Info().Str(`k`, `v`).Log(`short`)
+	l.Info().Str(`k`, `v`).Log(`a much longer message`)
+	l.Info().Str(`k`, `v`).Log(``)
+	l.Info().Log(`no fields`)
+	if s := buf.String(); s != "11:21:19.496 INF short      k=v\n"+
+		"11:21:19.496 INF a much longer message k=v\n"+
+		"11:21:19.496 INF           k=v\n"+
+		"11:21:19.496 INF no fields\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_messageEscaped(t *testing.T) {
+	var buf bytes.Buffer
+	l := L.New(L.WithConsole(WithWriter(&buf), WithTimeFormat(``), WithMessageWidth(12)))
+	l.Info().Str(`k`, "a\nb").Log("line 1\nline 2\t\x1b[31m\xff \"ok\" \\ é")
+	l.Info().Str(`k`, `v`).Log("a\nb")
+	if s := buf.String(); s != `INF line 1\nline 2\t\x1b[31m\xff "ok" \ é k="a\nb"`+"\n"+
+		`INF a\nb         k=v`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_color(t *testing.T) {
+	var buf bytes.Buffer
+	l := L.New(L.WithConsole(WithWriter(&buf), WithColor(true), WithTimeFormat(``), WithMessageWidth(0)))
+	l.Err().Str(`k`, `v`).Err(errors.New(`e`)).Log(`msg`)
+	if s := buf.String(); s != "\x1b[31mERR\x1b[0m msg \x1b[36mk=\x1b[0mv \x1b[36merr=\x1b[0m\x1b[31me\x1b[0m\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_limited(t *testing.T) {
+	var buf bytes.Buffer
+	l := L.New(
+		L.WithConsole(WithWriter(&buf), WithTimeFormat(``), WithMessageWidth(0)),
+		L.WithCategoryRateLimits(map[time.Duration]int{time.Hour: 1}),
+	)
+	for range 2 {
+		l.Info().Limit().Log(`msg`)
+	}
+	if s := buf.String(); !regexp.MustCompile(`^INF msg _limited={logger_test\.go:\d+ \d[^ }]*}\n$`).MatchString(s) {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_limitedFieldSchema(t *testing.T) {
+	var buf bytes.Buffer
+	l := L.New(
+		L.WithConsole(WithWriter(&buf), WithTimeFormat(``), WithMessageWidth(0), WithFieldSchema(logiface.ECSFieldSchema())),
+		L.WithCategoryRateLimits(map[time.Duration]int{time.Hour: 1}),
+	)
+	for range 2 {
+		l.Info().Limit().Log(`msg`)
+	}
+	if s := buf.String(); !regexp.MustCompile(`^INF msg log\.rate_limit={logger_test\.go:\d+ \d[^ }]*}\n$`).MatchString(s) {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_limitedFallback(t *testing.T) {
+	var buf bytes.Buffer
+	l := L.New(L.WithConsole(WithWriter(&buf), WithTimeFormat(``), WithMessageWidth(0)))
+	l.Info().Object().Str(`k`, `v`).As(`_limited`).End().Log(`msg`)
+	if s := buf.String(); s != "INF msg _limited={k=v}\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestNewLogger_color(t *testing.T) {
+	if l := NewLogger(WithWriter(&bytes.Buffer{})); l.color {
+		t.Error(`expected no color for a non-file writer`)
+	}
+	f, err := os.CreateTemp(t.TempDir(), `console`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if l := NewLogger(WithWriter(f)); l.color {
+		t.Error(`expected no color for a regular file`)
+	}
+	if l := NewLogger(WithWriter(f), WithColor(true)); !l.color {
+		t.Error(`expected color`)
+	}
+}
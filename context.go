@@ -1,13 +1,20 @@
 package logiface
 
 import (
+	"encoding"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"math"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
 	"time"
+
+	"github.com/joeycumines/logiface/internal/pbtime"
 )
 
 const (
@@ -33,6 +40,9 @@ type (
 		methods   modifierMethods[E]
 		logger    *Logger[E]
 		Modifiers ModifierSlice[E]
+
+		// lazy are the deferred fields, see Context.Func
+		lazy ModifierSlice[E]
 	}
 
 	// Builder is used to build a log event, see Logger.Build, Logger.Info, etc.
@@ -53,11 +63,18 @@ type (
 		methods modifierMethods[E]
 		shared  *loggerShared[E]
 
+		// lazy are the deferred fields, see Builder.Func, applied just prior
+		// to writing the event, including any from the logger
+		lazy ModifierSlice[E]
+
 		// mode provides switching behavior in the form of bit flags
 		mode builderMode
 	}
 
-	modifierMethods[E Event] struct{}
+	modifierMethods[E Event] struct {
+		// schema configures the fallback behavior, and may be nil
+		schema *FieldSchema
+	}
 
 	// builderMode models bit flags for [Builder], for special behavior
 	builderMode int32
@@ -100,6 +117,35 @@ func (x *Context[E]) add(fn ModifierFunc[E]) {
 	x.Modifiers = append(x.Modifiers, fn)
 }
 
+// LevelVar configures the sub-logger to use the given level, in place of the
+// parent's, if the receiver is enabled, and level is non-nil. This allows
+// the level of a sub-logger (e.g. per component) to be changed at runtime,
+// independently of the parent, see also [LevelVar].
+//
+// This method should be called immediately after [Logger.Clone], and, unlike
+// most Context methods, it does not apply to loggers already derived from
+// this Context.
+func (x *Context[E]) LevelVar(level *LevelVar) *Context[E] {
+	if x.Enabled() && level != nil {
+		x.derive(func(shared *loggerShared[E]) {
+			shared.levelVar = level
+		})
+	}
+	return x
+}
+
+// derive replaces the sub-logger, with one using a modified copy of the
+// shared state, which retains the root, and rate limiter
+func (x *Context[E]) derive(fn func(shared *loggerShared[E])) {
+	shared := *x.logger.shared
+	fn(&shared)
+	x.logger = &Logger[E]{
+		modifier: x.logger.modifier,
+		lazy:     x.logger.lazy,
+		shared:   &shared,
+	}
+}
+
 // Root returns the root [Logger] for this instance.
 func (x *Context[E]) Root() *Logger[E] {
 	if x != nil {
@@ -149,8 +195,8 @@ func (x *Builder[E]) Call(fn func(b *Builder[E])) *Builder[E] {
 // modifier, if the relevant conditions are met (e.g. configured log level).
 //
 // The field for the message will either be determined by the implementation,
-// if Event.AddMessage is implemented, or the default field name "msg" will be
-// used.
+// if Event.AddMessage is implemented, or FieldSchema.MessageKey (default
+// "msg") will be used.
 //
 // This method calls [Builder.Release].
 // This method is not implemented by [Context].
@@ -168,8 +214,8 @@ func (x *Builder[E]) Log(msg string) {
 // modifier, if the relevant conditions are met (e.g. configured log level).
 //
 // The field for the message will either be determined by the implementation,
-// if Event.AddMessage is implemented, or the default field name "msg" will be
-// used.
+// if Event.AddMessage is implemented, or FieldSchema.MessageKey (default
+// "msg") will be used.
 //
 // This method calls [Builder.Release].
 // This method is not implemented by [Context].
@@ -188,8 +234,8 @@ func (x *Builder[E]) Logf(format string, args ...any) {
 // (e.g. configured log level).
 //
 // The field for the message will either be determined by the implementation,
-// if Event.AddMessage is implemented, or the default field name "msg" will be
-// used.
+// if Event.AddMessage is implemented, or FieldSchema.MessageKey (default
+// "msg") will be used.
 //
 // Note that the function will not be called if, for example, the given log
 // level is not enabled.
@@ -217,10 +263,20 @@ func (x *Builder[E]) log(msg string) {
 			x.attachCallerRateLimitWarning(caller, next)
 		}
 	}
-	if msg != `` && !x.Event.AddMessage(msg) {
-		x.Event.AddField(`msg`, msg)
+	// the event is dropped if a lazy field fails, as per Builder.Modifier,
+	// but any panic or exit must still occur
+	if err := x.lazy.Modify(x.Event); err != nil {
+		if !errors.Is(err, ErrDisabled) && !errors.Is(err, ErrLimited) {
+			x.shared.root.DPanic().
+				Err(err).
+				Log("modifier error")
+		}
+	} else {
+		if msg != `` && !x.Event.AddMessage(msg) {
+			x.Event.AddField(x.methods.schema.messageKey(), msg)
+		}
+		_ = x.shared.writer.Write(x.Event)
 	}
-	_ = x.shared.writer.Write(x.Event)
 	if x.mode != 0 {
 		if (x.mode & builderModePanic) == builderModePanic {
 			if msg == `` {
@@ -265,6 +321,8 @@ func (x *Builder[E]) release(event bool) {
 		// this is important in cases where the E value is also pooled
 		x.Event = *new(E)
 		// reset the remaining state...
+		clear(x.lazy)
+		x.lazy = x.lazy[:0]
 		x.mode = 0
 		// ...and return to the pool
 		shared.pool.Put(x)
@@ -296,13 +354,13 @@ func (x modifierMethods[E]) base64(event E, key string, val []byte, enc *base64.
 
 func (x modifierMethods[E]) time(event E, key string, val time.Time) {
 	if !event.AddTime(key, val) {
-		x.str(event, key, formatTimestamp(val))
+		x.fallbackTime(event, key, val)
 	}
 }
 
 func (x modifierMethods[E]) dur(event E, key string, val time.Duration) {
 	if !event.AddDuration(key, val) {
-		x.str(event, key, formatDuration(val))
+		x.fallbackDur(event, key, val)
 	}
 }
 
@@ -312,6 +370,15 @@ func (x modifierMethods[E]) int(event E, key string, val int) {
 	}
 }
 
+func (x modifierMethods[E]) uint32(event E, key string, val uint32) {
+	if uint64(val) > math.MaxInt {
+		// int is 32 bits
+		x.int64(event, key, int64(val))
+		return
+	}
+	x.int(event, key, int(val))
+}
+
 func (x modifierMethods[E]) float32(event E, key string, val float32) {
 	if !event.AddFloat32(key, val) {
 		event.AddField(key, val)
@@ -332,13 +399,13 @@ func (x modifierMethods[E]) float64(event E, key string, val float64) {
 
 func (x modifierMethods[E]) int64(event E, key string, val int64) {
 	if !event.AddInt64(key, val) {
-		x.str(event, key, strconv.FormatInt(val, 10))
+		x.fallbackInt64(event, key, val)
 	}
 }
 
 func (x modifierMethods[E]) uint64(event E, key string, val uint64) {
 	if !event.AddUint64(key, val) {
-		x.str(event, key, strconv.FormatUint(val, 10))
+		x.fallbackUint64(event, key, val)
 	}
 }
 
@@ -348,32 +415,52 @@ func (x modifierMethods[E]) rawJSON(event E, key string, val json.RawMessage) {
 	}
 }
 
+func (x modifierMethods[E]) ipAddr(event E, key string, val netip.Addr) {
+	if !event.AddIPAddr(key, val) {
+		// same as MarshalText
+		if val == (netip.Addr{}) {
+			x.str(event, key, ``)
+		} else {
+			x.str(event, key, val.String())
+		}
+	}
+}
+
+func (x modifierMethods[E]) ipPrefix(event E, key string, val netip.Prefix) {
+	if !event.AddIPPrefix(key, val) {
+		// same as MarshalText
+		if val == (netip.Prefix{}) {
+			x.str(event, key, ``)
+		} else {
+			x.str(event, key, val.String())
+		}
+	}
+}
+
+func (x modifierMethods[E]) url(event E, key string, val *url.URL) {
+	if val == nil {
+		event.AddField(key, nil)
+	} else if !event.AddURL(key, val) {
+		x.str(event, key, val.Redacted())
+	}
+}
+
+func (x modifierMethods[E]) hexBytes(event E, key string, val []byte) {
+	if !event.AddHexBytes(key, val) {
+		x.str(event, key, hex.EncodeToString(val))
+	}
+}
+
 // formatTimestamp uses the same behavior as protobuf's timestamp.
 // "1972-01-01T10:00:20.021Z"	Uses RFC 3339, where generated output will always be Z-normalized and uses 0, 3, 6 or 9 fractional digits. Offsets other than "Z" are also accepted.
 func formatTimestamp(t time.Time) string {
-	t = t.UTC()
-	x := t.Format("2006-01-02T15:04:05.000000000") // RFC 3339
-	x = strings.TrimSuffix(x, "000")
-	x = strings.TrimSuffix(x, "000")
-	x = strings.TrimSuffix(x, ".000")
-	return x + "Z"
+	return pbtime.FormatTimestamp(t)
 }
 
 // formatDuration uses the same behavior as protobuf's duration.
 // "1.000340012s", "1s"	Generated output always contains 0, 3, 6, or 9 fractional digits, depending on required precision, followed by the suffix "s". Accepted are any fractional digits (also none) as long as they fit into nano-seconds precision and the suffix "s" is required.
 func formatDuration(d time.Duration) string {
-	nanos := d.Nanoseconds()
-	secs := nanos / 1e9
-	nanos -= secs * 1e9
-	sign := ""
-	if secs < 0 || nanos < 0 {
-		sign, secs, nanos = "-", -1*secs, -1*nanos
-	}
-	x := fmt.Sprintf("%s%d.%09d", sign, secs, nanos)
-	x = strings.TrimSuffix(x, "000")
-	x = strings.TrimSuffix(x, "000")
-	x = strings.TrimSuffix(x, ".000")
-	return x + "s"
+	return pbtime.FormatDuration(d)
 }
 
 // Implementations of non-field methods that are shared between Context and Builder.
@@ -397,7 +484,15 @@ func (x modifierMethods[E]) Field(event E, key string, val any) error {
 	if !event.Level().Enabled() {
 		return ErrDisabled
 	}
-	// TODO add cases for pointers
+	if !x.field(event, key, val) {
+		event.AddField(key, val)
+	}
+	return nil
+}
+
+// field attempts to add val using the most appropriate typed method,
+// returning false if val should be passed through to Event.AddField
+func (x modifierMethods[E]) field(event E, key string, val any) bool {
 	switch val := val.(type) {
 	case string:
 		x.str(event, key, val)
@@ -421,15 +516,255 @@ func (x modifierMethods[E]) Field(event E, key string, val any) error {
 		x.uint64(event, key, val)
 	case json.RawMessage:
 		x.rawJSON(event, key, val)
+	case int8:
+		x.int(event, key, int(val))
+	case int16:
+		x.int(event, key, int(val))
+	case int32:
+		x.int(event, key, int(val))
+	case uint:
+		x.uint64(event, key, uint64(val))
+	case uint8:
+		x.int(event, key, int(val))
+	case uint16:
+		x.int(event, key, int(val))
+	case uint32:
+		x.uint32(event, key, val)
+	case uintptr:
+		x.uint64(event, key, uint64(val))
+	case netip.Addr:
+		x.ipAddr(event, key, val)
+	case netip.Prefix:
+		x.ipPrefix(event, key, val)
+	case net.IP:
+		if len(val) == 0 {
+			// same as MarshalText
+			x.str(event, key, ``)
+		} else if addr, ok := netip.AddrFromSlice(val); ok {
+			x.ipAddr(event, key, addr.Unmap())
+		} else {
+			return false
+		}
+	case *url.URL:
+		if val == nil {
+			return false
+		}
+		x.url(event, key, val)
+	case url.URL:
+		x.url(event, key, &val)
+	case nil:
+		return false
 	default:
-		event.AddField(key, val)
+		return x.fieldPtr(event, key, val) ||
+			x.fieldMarshaler(event, key, val) ||
+			x.fieldKind(event, key, val)
 	}
-	return nil
+	return true
+}
+
+// fieldPtr handles non-nil pointers to the basic types supported by field,
+// nil pointers are passed through to Event.AddField
+func (x modifierMethods[E]) fieldPtr(event E, key string, val any) bool {
+	switch val := val.(type) {
+	case *string:
+		if val != nil {
+			x.str(event, key, *val)
+			return true
+		}
+	case *time.Time:
+		if val != nil {
+			x.time(event, key, *val)
+			return true
+		}
+	case *time.Duration:
+		if val != nil {
+			x.dur(event, key, *val)
+			return true
+		}
+	case *bool:
+		if val != nil {
+			x.bool(event, key, *val)
+			return true
+		}
+	case *int:
+		if val != nil {
+			x.int(event, key, *val)
+			return true
+		}
+	case *int8:
+		if val != nil {
+			x.int(event, key, int(*val))
+			return true
+		}
+	case *int16:
+		if val != nil {
+			x.int(event, key, int(*val))
+			return true
+		}
+	case *int32:
+		if val != nil {
+			x.int(event, key, int(*val))
+			return true
+		}
+	case *int64:
+		if val != nil {
+			x.int64(event, key, *val)
+			return true
+		}
+	case *uint:
+		if val != nil {
+			x.uint64(event, key, uint64(*val))
+			return true
+		}
+	case *uint8:
+		if val != nil {
+			x.int(event, key, int(*val))
+			return true
+		}
+	case *uint16:
+		if val != nil {
+			x.int(event, key, int(*val))
+			return true
+		}
+	case *uint32:
+		if val != nil {
+			x.uint32(event, key, *val)
+			return true
+		}
+	case *uint64:
+		if val != nil {
+			x.uint64(event, key, *val)
+			return true
+		}
+	case *uintptr:
+		if val != nil {
+			x.uint64(event, key, uint64(*val))
+			return true
+		}
+	case *float32:
+		if val != nil {
+			x.float32(event, key, *val)
+			return true
+		}
+	case *float64:
+		if val != nil {
+			x.float64(event, key, *val)
+			return true
+		}
+	case *[]byte:
+		if val != nil {
+			x.base64(event, key, *val, nil)
+			return true
+		}
+	case *json.RawMessage:
+		if val != nil {
+			x.rawJSON(event, key, *val)
+			return true
+		}
+	case *netip.Addr:
+		if val != nil {
+			x.ipAddr(event, key, *val)
+			return true
+		}
+	case *netip.Prefix:
+		if val != nil {
+			x.ipPrefix(event, key, *val)
+			return true
+		}
+	}
+	return false
+}
+
+// fieldMarshaler handles types that customise their own encoding, in order of
+// precedence: error, json.Marshaler, encoding.TextMarshaler, fmt.Stringer
+func (x modifierMethods[E]) fieldMarshaler(event E, key string, val any) bool {
+	switch val.(type) {
+	case error, json.Marshaler, encoding.TextMarshaler, fmt.Stringer:
+	default:
+		return false
+	}
+	if v := reflect.ValueOf(val); v.Kind() == reflect.Pointer && v.IsNil() {
+		// the methods may not be nil-safe
+		return false
+	}
+	switch val := val.(type) {
+	case error:
+		x.str(event, key, val.Error())
+	case json.Marshaler:
+		b, err := val.MarshalJSON()
+		if err != nil {
+			return false
+		}
+		x.rawJSON(event, key, b)
+	case encoding.TextMarshaler:
+		b, err := val.MarshalText()
+		if err != nil {
+			return false
+		}
+		x.str(event, key, string(b))
+	case fmt.Stringer:
+		x.str(event, key, val.String())
+	}
+	return true
+}
+
+// fieldKind handles named types (e.g. `type ID int32`), using the underlying
+// kind, and dereferences any remaining (non-nil) pointers
+func (x modifierMethods[E]) fieldKind(event E, key string, val any) bool {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.String:
+		x.str(event, key, v.String())
+	case reflect.Bool:
+		x.bool(event, key, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		x.int(event, key, int(v.Int()))
+	case reflect.Int64:
+		x.int64(event, key, v.Int())
+	case reflect.Uint8, reflect.Uint16:
+		x.int(event, key, int(v.Uint()))
+	case reflect.Uint32:
+		x.uint32(event, key, uint32(v.Uint()))
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		x.uint64(event, key, v.Uint())
+	case reflect.Float32:
+		x.float32(event, key, float32(v.Float()))
+	case reflect.Float64:
+		x.float64(event, key, v.Float())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return false
+		}
+		x.base64(event, key, v.Bytes(), nil)
+	case reflect.Pointer:
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Pointer {
+			return false
+		}
+		return x.field(event, key, v.Elem().Interface())
+	default:
+		return false
+	}
+	return true
 }
 
 // Field adds a field to the log context, making an effort to choose the most
 // appropriate handler for the value.
 //
+// The handler is chosen using the following order of precedence:
+//
+//  1. Supported types (e.g. string, int32, []byte, time.Time, netip.Addr,
+//     net.IP, url.URL) and non-nil pointers to them, per the equivalent
+//     method (e.g. Str, Int32, Base64, IPAddr, URL)
+//  2. Non-nil error values, per Str, using the error message
+//  3. Non-nil json.Marshaler values, per RawJSON
+//  4. Non-nil encoding.TextMarshaler values, per Str
+//  5. Non-nil fmt.Stringer values, per Str, using the result of String
+//  6. Named types with an underlying type of string, bool, []byte, or a
+//     supported numeric type, per 1., and any other non-nil pointers, which
+//     are dereferenced, then handled per this list
+//  7. Anything else (including nil values and nil pointers, or if
+//     MarshalJSON or MarshalText fail), per Interface
+//
 // WARNING: The behavior of this method may change without notice, to
 // facilitate the addition of new field types.
 //
@@ -443,7 +778,7 @@ func (x *Context[E]) Field(key string, val any) *Context[E] {
 }
 
 // Field adds a field to the log event, making an effort to choose the most
-// appropriate handler for the value.
+// appropriate handler for the value, per [Context.Field].
 //
 // WARNING: The behavior of this method may change without notice, to
 // facilitate the addition of new field types.
@@ -494,14 +829,19 @@ func (x modifierMethods[E]) Err(event E, err error) error {
 		return ErrDisabled
 	}
 	if !event.AddError(err) {
-		event.AddField(`err`, err)
+		if err == nil {
+			event.AddField(x.schema.errorKey(), nil)
+		} else {
+			// per Str, as the key may be a string field, e.g. error.message
+			x.str(event, x.schema.errorKey(), err.Error())
+		}
 	}
 	return nil
 }
 
 // Err adds an error as a structured log field, the key for which will either
-// be determined by the Event.AddError method, or will be "err" if not
-// implemented.
+// be determined by the Event.AddError method, or will be
+// FieldSchema.ErrorKey (default "err") if not implemented.
 func (x *Context[E]) Err(err error) *Context[E] {
 	if x.Enabled() {
 		x.add(func(event E) error { return x.methods.Err(event, err) })
@@ -510,8 +850,8 @@ func (x *Context[E]) Err(err error) *Context[E] {
 }
 
 // Err adds an error as a structured log field, the key for which will either
-// be determined by the Event.AddError method, or will be "err" if not
-// implemented.
+// be determined by the Event.AddError method, or will be
+// FieldSchema.ErrorKey (default "err") if not implemented.
 func (x *Builder[E]) Err(err error) *Builder[E] {
 	if x.Enabled() {
 		_ = x.methods.Err(x.Event, err)
@@ -603,6 +943,188 @@ func (x *Builder[E]) Int(key string, val int) *Builder[E] {
 	return x
 }
 
+func (x modifierMethods[E]) Int8(event E, key string, val int8) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.int(event, key, int(val))
+	return nil
+}
+
+// Int8 adds an int8 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Context[E]) Int8(key string, val int8) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Int8(event, key, val) })
+	}
+	return x
+}
+
+// Int8 adds an int8 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Builder[E]) Int8(key string, val int8) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Int8(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Int16(event E, key string, val int16) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.int(event, key, int(val))
+	return nil
+}
+
+// Int16 adds an int16 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Context[E]) Int16(key string, val int16) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Int16(event, key, val) })
+	}
+	return x
+}
+
+// Int16 adds an int16 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Builder[E]) Int16(key string, val int16) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Int16(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Int32(event E, key string, val int32) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.int(event, key, int(val))
+	return nil
+}
+
+// Int32 adds an int32 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Context[E]) Int32(key string, val int32) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Int32(event, key, val) })
+	}
+	return x
+}
+
+// Int32 adds an int32 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Builder[E]) Int32(key string, val int32) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Int32(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Uint(event E, key string, val uint) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.uint64(event, key, uint64(val))
+	return nil
+}
+
+// Uint adds a uint as a structured log field, per [Context.Uint64].
+func (x *Context[E]) Uint(key string, val uint) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Uint(event, key, val) })
+	}
+	return x
+}
+
+// Uint adds a uint as a structured log field, per [Builder.Uint64].
+func (x *Builder[E]) Uint(key string, val uint) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Uint(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Uint8(event E, key string, val uint8) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.int(event, key, int(val))
+	return nil
+}
+
+// Uint8 adds a uint8 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Context[E]) Uint8(key string, val uint8) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Uint8(event, key, val) })
+	}
+	return x
+}
+
+// Uint8 adds a uint8 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Builder[E]) Uint8(key string, val uint8) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Uint8(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Uint16(event E, key string, val uint16) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.int(event, key, int(val))
+	return nil
+}
+
+// Uint16 adds a uint16 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Context[E]) Uint16(key string, val uint16) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Uint16(event, key, val) })
+	}
+	return x
+}
+
+// Uint16 adds a uint16 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField.
+func (x *Builder[E]) Uint16(key string, val uint16) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Uint16(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Uint32(event E, key string, val uint32) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.uint32(event, key, val)
+	return nil
+}
+
+// Uint32 adds a uint32 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField. If int is 32 bits, and
+// val is greater than math.MaxInt, it will be added per [Context.Int64].
+func (x *Context[E]) Uint32(key string, val uint32) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Uint32(event, key, val) })
+	}
+	return x
+}
+
+// Uint32 adds a uint32 as a structured log field, using Event.AddInt if
+// available, otherwise falling back to Event.AddField. If int is 32 bits, and
+// val is greater than math.MaxInt, it will be added per [Builder.Int64].
+func (x *Builder[E]) Uint32(key string, val uint32) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Uint32(x.Event, key, val)
+	}
+	return x
+}
+
 func (x modifierMethods[E]) Float32(event E, key string, val float32) error {
 	if !event.Level().Enabled() {
 		return ErrDisabled
@@ -916,3 +1438,133 @@ func (x *Builder[E]) RawJSON(key string, val json.RawMessage) *Builder[E] {
 	}
 	return x
 }
+
+func (x modifierMethods[E]) IPAddr(event E, key string, val netip.Addr) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.ipAddr(event, key, val)
+	return nil
+}
+
+// IPAddr adds a netip.Addr as a structured log field, using Event.AddIPAddr
+// if available, otherwise falling back to encoding val as a string, per
+// netip.Addr.MarshalText.
+//
+// For the fallback, the behavior of [Context.Str] is used, to add the field.
+func (x *Context[E]) IPAddr(key string, val netip.Addr) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.IPAddr(event, key, val) })
+	}
+	return x
+}
+
+// IPAddr adds a netip.Addr as a structured log field, using Event.AddIPAddr
+// if available, otherwise falling back to encoding val as a string, per
+// netip.Addr.MarshalText.
+//
+// For the fallback, the behavior of [Builder.Str] is used, to add the field.
+func (x *Builder[E]) IPAddr(key string, val netip.Addr) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.IPAddr(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) IPPrefix(event E, key string, val netip.Prefix) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.ipPrefix(event, key, val)
+	return nil
+}
+
+// IPPrefix adds a netip.Prefix as a structured log field, using
+// Event.AddIPPrefix if available, otherwise falling back to encoding val as a
+// string, per netip.Prefix.MarshalText.
+//
+// For the fallback, the behavior of [Context.Str] is used, to add the field.
+func (x *Context[E]) IPPrefix(key string, val netip.Prefix) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.IPPrefix(event, key, val) })
+	}
+	return x
+}
+
+// IPPrefix adds a netip.Prefix as a structured log field, using
+// Event.AddIPPrefix if available, otherwise falling back to encoding val as a
+// string, per netip.Prefix.MarshalText.
+//
+// For the fallback, the behavior of [Builder.Str] is used, to add the field.
+func (x *Builder[E]) IPPrefix(key string, val netip.Prefix) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.IPPrefix(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) URL(event E, key string, val *url.URL) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.url(event, key, val)
+	return nil
+}
+
+// URL adds a *url.URL as a structured log field, using Event.AddURL if
+// available, otherwise falling back to encoding val as a string, per
+// url.URL.Redacted (i.e. any password is replaced with "xxxxx"). Nil values
+// will be passed through to Event.AddField, as nil.
+//
+// For the fallback, the behavior of [Context.Str] is used, to add the field.
+func (x *Context[E]) URL(key string, val *url.URL) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.URL(event, key, val) })
+	}
+	return x
+}
+
+// URL adds a *url.URL as a structured log field, using Event.AddURL if
+// available, otherwise falling back to encoding val as a string, per
+// url.URL.Redacted (i.e. any password is replaced with "xxxxx"). Nil values
+// will be passed through to Event.AddField, as nil.
+//
+// For the fallback, the behavior of [Builder.Str] is used, to add the field.
+func (x *Builder[E]) URL(key string, val *url.URL) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.URL(x.Event, key, val)
+	}
+	return x
+}
+
+func (x modifierMethods[E]) Hex(event E, key string, val []byte) error {
+	if !event.Level().Enabled() {
+		return ErrDisabled
+	}
+	x.hexBytes(event, key, val)
+	return nil
+}
+
+// Hex adds bytes as a structured log field, using Event.AddHexBytes if
+// available, otherwise falling back to encoding val as a lowercase hex
+// string, e.g. for identifiers such as UUIDs. See also [Context.Base64].
+//
+// For the fallback, the behavior of [Context.Str] is used, to add the field.
+func (x *Context[E]) Hex(key string, val []byte) *Context[E] {
+	if x.Enabled() {
+		x.add(func(event E) error { return x.methods.Hex(event, key, val) })
+	}
+	return x
+}
+
+// Hex adds bytes as a structured log field, using Event.AddHexBytes if
+// available, otherwise falling back to encoding val as a lowercase hex
+// string, e.g. for identifiers such as UUIDs. See also [Builder.Base64].
+//
+// For the fallback, the behavior of [Builder.Str] is used, to add the field.
+func (x *Builder[E]) Hex(key string, val []byte) *Builder[E] {
+	if x.Enabled() {
+		_ = x.methods.Hex(x.Event, key, val)
+	}
+	return x
+}
@@ -0,0 +1,403 @@
+package eventtest
+
+import (
+	"github.com/joeycumines/logiface"
+)
+
+// capability bits correspond to the CanX methods of logiface.JSONSupport
+const (
+	capSetObject capability = 1 << iota
+	capSetArray
+	capAddStartObject
+	capSetStartObject
+	capSetStartArray
+	capSetString
+	capSetBool
+	capSetBase64Bytes
+	capSetDuration
+	capSetError
+	capSetInt
+	capSetFloat32
+	capSetTime
+	capSetFloat64
+	capSetInt64
+	capSetUint64
+	capSetRawJSON
+	capSetInt8
+	capSetInt16
+	capSetInt32
+	capSetUint
+	capSetUint8
+	capSetUint16
+	capSetUint32
+	capSetIPAddr
+	capSetIPPrefix
+	capSetURL
+	capSetHexBytes
+	capAppendArray
+	capAppendObject
+	capAddStartArray
+	capAppendStartObject
+	capAppendStartArray
+	capAppendString
+	capAppendBool
+	capAppendBase64Bytes
+	capAppendDuration
+	capAppendError
+	capAppendInt
+	capAppendFloat32
+	capAppendTime
+	capAppendFloat64
+	capAppendInt64
+	capAppendUint64
+	capAppendRawJSON
+	capAppendInt8
+	capAppendInt16
+	capAppendInt32
+	capAppendUint
+	capAppendUint8
+	capAppendUint16
+	capAppendUint32
+	capAppendIPAddr
+	capAppendIPPrefix
+	capAppendURL
+	capAppendHexBytes
+
+	capabilityNone capability = 0
+	capabilityAll             = (capAppendHexBytes<<1 - 1) &^ capabilityStart
+
+	// capabilityStart are never disabled, as implementations may not support
+	// the alternative (e.g. NewObject, instead of AddStartObject)
+	capabilityStart = capAddStartObject | capSetStartObject | capSetStartArray |
+		capAddStartArray | capAppendStartObject | capAppendStartArray
+)
+
+type (
+	// capability models a set of CanX methods, which will return false
+	capability uint64
+
+	// maskedJSONSupport wraps a JSONSupport implementation, masking the CanX
+	// methods per mask
+	maskedJSONSupport[E logiface.Event, O any, A any] struct {
+		logiface.JSONSupport[E, O, A]
+		mask capability
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.JSONSupport[logiface.Event, any, any] = (*maskedJSONSupport[logiface.Event, any, any])(nil)
+)
+
+var (
+	// capabilityNames are the names of each (single bit) capability, in order
+	capabilityNames = [...]string{
+		`CanSetObject`,
+		`CanSetArray`,
+		`CanAddStartObject`,
+		`CanSetStartObject`,
+		`CanSetStartArray`,
+		`CanSetString`,
+		`CanSetBool`,
+		`CanSetBase64Bytes`,
+		`CanSetDuration`,
+		`CanSetError`,
+		`CanSetInt`,
+		`CanSetFloat32`,
+		`CanSetTime`,
+		`CanSetFloat64`,
+		`CanSetInt64`,
+		`CanSetUint64`,
+		`CanSetRawJSON`,
+		`CanSetInt8`,
+		`CanSetInt16`,
+		`CanSetInt32`,
+		`CanSetUint`,
+		`CanSetUint8`,
+		`CanSetUint16`,
+		`CanSetUint32`,
+		`CanSetIPAddr`,
+		`CanSetIPPrefix`,
+		`CanSetURL`,
+		`CanSetHexBytes`,
+		`CanAppendArray`,
+		`CanAppendObject`,
+		`CanAddStartArray`,
+		`CanAppendStartObject`,
+		`CanAppendStartArray`,
+		`CanAppendString`,
+		`CanAppendBool`,
+		`CanAppendBase64Bytes`,
+		`CanAppendDuration`,
+		`CanAppendError`,
+		`CanAppendInt`,
+		`CanAppendFloat32`,
+		`CanAppendTime`,
+		`CanAppendFloat64`,
+		`CanAppendInt64`,
+		`CanAppendUint64`,
+		`CanAppendRawJSON`,
+		`CanAppendInt8`,
+		`CanAppendInt16`,
+		`CanAppendInt32`,
+		`CanAppendUint`,
+		`CanAppendUint8`,
+		`CanAppendUint16`,
+		`CanAppendUint32`,
+		`CanAppendIPAddr`,
+		`CanAppendIPPrefix`,
+		`CanAppendURL`,
+		`CanAppendHexBytes`,
+	}
+)
+
+// capabilities returns each capability mask to test, with a name for each,
+// i.e. none, each single capability, all but each single capability, and all
+func capabilities() (names []string, values []capability) {
+	names = append(names, `none`)
+	values = append(values, capabilityNone)
+	for i, name := range capabilityNames {
+		if capabilityStart.has(1 << i) {
+			continue
+		}
+		names = append(names, name)
+		values = append(values, 1<<i)
+	}
+	for i, name := range capabilityNames {
+		if capabilityStart.has(1 << i) {
+			continue
+		}
+		names = append(names, `all-`+name)
+		values = append(values, capabilityAll&^(1<<i))
+	}
+	names = append(names, `all`)
+	values = append(values, capabilityAll)
+	return
+}
+
+func (x capability) has(c capability) bool { return x&c != 0 }
+
+func (x *maskedJSONSupport[E, O, A]) CanSetObject() bool {
+	return !x.mask.has(capSetObject) && x.JSONSupport.CanSetObject()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetArray() bool {
+	return !x.mask.has(capSetArray) && x.JSONSupport.CanSetArray()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAddStartObject() bool {
+	return !x.mask.has(capAddStartObject) && x.JSONSupport.CanAddStartObject()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetStartObject() bool {
+	return !x.mask.has(capSetStartObject) && x.JSONSupport.CanSetStartObject()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetStartArray() bool {
+	return !x.mask.has(capSetStartArray) && x.JSONSupport.CanSetStartArray()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetString() bool {
+	return !x.mask.has(capSetString) && x.JSONSupport.CanSetString()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetBool() bool {
+	return !x.mask.has(capSetBool) && x.JSONSupport.CanSetBool()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetBase64Bytes() bool {
+	return !x.mask.has(capSetBase64Bytes) && x.JSONSupport.CanSetBase64Bytes()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetDuration() bool {
+	return !x.mask.has(capSetDuration) && x.JSONSupport.CanSetDuration()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetError() bool {
+	return !x.mask.has(capSetError) && x.JSONSupport.CanSetError()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetInt() bool {
+	return !x.mask.has(capSetInt) && x.JSONSupport.CanSetInt()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetFloat32() bool {
+	return !x.mask.has(capSetFloat32) && x.JSONSupport.CanSetFloat32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetTime() bool {
+	return !x.mask.has(capSetTime) && x.JSONSupport.CanSetTime()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetFloat64() bool {
+	return !x.mask.has(capSetFloat64) && x.JSONSupport.CanSetFloat64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetInt64() bool {
+	return !x.mask.has(capSetInt64) && x.JSONSupport.CanSetInt64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetUint64() bool {
+	return !x.mask.has(capSetUint64) && x.JSONSupport.CanSetUint64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetRawJSON() bool {
+	return !x.mask.has(capSetRawJSON) && x.JSONSupport.CanSetRawJSON()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetInt8() bool {
+	return !x.mask.has(capSetInt8) && x.JSONSupport.CanSetInt8()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetInt16() bool {
+	return !x.mask.has(capSetInt16) && x.JSONSupport.CanSetInt16()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetInt32() bool {
+	return !x.mask.has(capSetInt32) && x.JSONSupport.CanSetInt32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetUint() bool {
+	return !x.mask.has(capSetUint) && x.JSONSupport.CanSetUint()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetUint8() bool {
+	return !x.mask.has(capSetUint8) && x.JSONSupport.CanSetUint8()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetUint16() bool {
+	return !x.mask.has(capSetUint16) && x.JSONSupport.CanSetUint16()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetUint32() bool {
+	return !x.mask.has(capSetUint32) && x.JSONSupport.CanSetUint32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetIPAddr() bool {
+	return !x.mask.has(capSetIPAddr) && x.JSONSupport.CanSetIPAddr()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetIPPrefix() bool {
+	return !x.mask.has(capSetIPPrefix) && x.JSONSupport.CanSetIPPrefix()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetURL() bool {
+	return !x.mask.has(capSetURL) && x.JSONSupport.CanSetURL()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanSetHexBytes() bool {
+	return !x.mask.has(capSetHexBytes) && x.JSONSupport.CanSetHexBytes()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendArray() bool {
+	return !x.mask.has(capAppendArray) && x.JSONSupport.CanAppendArray()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendObject() bool {
+	return !x.mask.has(capAppendObject) && x.JSONSupport.CanAppendObject()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAddStartArray() bool {
+	return !x.mask.has(capAddStartArray) && x.JSONSupport.CanAddStartArray()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendStartObject() bool {
+	return !x.mask.has(capAppendStartObject) && x.JSONSupport.CanAppendStartObject()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendStartArray() bool {
+	return !x.mask.has(capAppendStartArray) && x.JSONSupport.CanAppendStartArray()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendString() bool {
+	return !x.mask.has(capAppendString) && x.JSONSupport.CanAppendString()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendBool() bool {
+	return !x.mask.has(capAppendBool) && x.JSONSupport.CanAppendBool()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendBase64Bytes() bool {
+	return !x.mask.has(capAppendBase64Bytes) && x.JSONSupport.CanAppendBase64Bytes()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendDuration() bool {
+	return !x.mask.has(capAppendDuration) && x.JSONSupport.CanAppendDuration()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendError() bool {
+	return !x.mask.has(capAppendError) && x.JSONSupport.CanAppendError()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendInt() bool {
+	return !x.mask.has(capAppendInt) && x.JSONSupport.CanAppendInt()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendFloat32() bool {
+	return !x.mask.has(capAppendFloat32) && x.JSONSupport.CanAppendFloat32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendTime() bool {
+	return !x.mask.has(capAppendTime) && x.JSONSupport.CanAppendTime()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendFloat64() bool {
+	return !x.mask.has(capAppendFloat64) && x.JSONSupport.CanAppendFloat64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendInt64() bool {
+	return !x.mask.has(capAppendInt64) && x.JSONSupport.CanAppendInt64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendUint64() bool {
+	return !x.mask.has(capAppendUint64) && x.JSONSupport.CanAppendUint64()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendRawJSON() bool {
+	return !x.mask.has(capAppendRawJSON) && x.JSONSupport.CanAppendRawJSON()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendInt8() bool {
+	return !x.mask.has(capAppendInt8) && x.JSONSupport.CanAppendInt8()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendInt16() bool {
+	return !x.mask.has(capAppendInt16) && x.JSONSupport.CanAppendInt16()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendInt32() bool {
+	return !x.mask.has(capAppendInt32) && x.JSONSupport.CanAppendInt32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendUint() bool {
+	return !x.mask.has(capAppendUint) && x.JSONSupport.CanAppendUint()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendUint8() bool {
+	return !x.mask.has(capAppendUint8) && x.JSONSupport.CanAppendUint8()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendUint16() bool {
+	return !x.mask.has(capAppendUint16) && x.JSONSupport.CanAppendUint16()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendUint32() bool {
+	return !x.mask.has(capAppendUint32) && x.JSONSupport.CanAppendUint32()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendIPAddr() bool {
+	return !x.mask.has(capAppendIPAddr) && x.JSONSupport.CanAppendIPAddr()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendIPPrefix() bool {
+	return !x.mask.has(capAppendIPPrefix) && x.JSONSupport.CanAppendIPPrefix()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendURL() bool {
+	return !x.mask.has(capAppendURL) && x.JSONSupport.CanAppendURL()
+}
+
+func (x *maskedJSONSupport[E, O, A]) CanAppendHexBytes() bool {
+	return !x.mask.has(capAppendHexBytes) && x.JSONSupport.CanAppendHexBytes()
+}
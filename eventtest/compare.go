@@ -0,0 +1,164 @@
+package eventtest
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// oneOf is a normalized value that is equivalent to any of its values,
+	// see merge
+	oneOf []any
+)
+
+// merge combines normalized values, which may differ (e.g. depending on how
+// nested values are encoded), such that each part may match either
+func merge(a, b any) any {
+	if reflect.DeepEqual(a, b) {
+		return a
+	}
+	switch a := a.(type) {
+	case map[string]any:
+		if b, ok := b.(map[string]any); ok && len(a) == len(b) {
+			m := make(map[string]any, len(a))
+			for k, v := range a {
+				w, ok := b[k]
+				if !ok {
+					return oneOf{a, b}
+				}
+				m[k] = merge(v, w)
+			}
+			return m
+		}
+	case []any:
+		if b, ok := b.([]any); ok && len(a) == len(b) {
+			s := make([]any, len(a))
+			for i := range a {
+				s[i] = merge(a[i], b[i])
+			}
+			return s
+		}
+	}
+	return oneOf{a, b}
+}
+
+// MarshalJSON uses the first value, for use in error messages
+func (x oneOf) MarshalJSON() ([]byte, error) { return json.Marshal(x[0]) }
+
+// equivalent compares normalized values, see the package docs
+func equivalent(got, want any) bool {
+	if reflect.DeepEqual(got, want) {
+		return true
+	}
+	switch want := want.(type) {
+	case oneOf:
+		for _, want := range want {
+			if equivalent(got, want) {
+				return true
+			}
+		}
+		return false
+	case map[string]any:
+		got, ok := got.(map[string]any)
+		if !ok || len(got) != len(want) {
+			return false
+		}
+		for k, w := range want {
+			if g, ok := got[k]; !ok || !equivalent(g, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		got, ok := got.([]any)
+		if !ok || len(got) != len(want) {
+			return false
+		}
+		for i := range want {
+			if !equivalent(got[i], want[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		switch got := got.(type) {
+		case json.Number:
+			return numbersEqual(string(got), string(want))
+		case string:
+			return numbersEqual(got, string(want))
+		}
+	case string:
+		switch got := got.(type) {
+		case json.Number:
+			return numbersEqual(string(got), want)
+		case string:
+			return timestampsEqual(got, want) || durationsEqual(got, want)
+		}
+	}
+	return false
+}
+
+// numbersEqual compares decimal numbers, exactly, or with the precision of a
+// float32, if either is not an integer, as the encoding of float32 values may
+// vary
+func numbersEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, ok := new(big.Rat).SetString(a)
+	if !ok {
+		return false
+	}
+	y, ok := new(big.Rat).SetString(b)
+	if !ok {
+		return false
+	}
+	if x.Cmp(y) == 0 {
+		return true
+	}
+	if isInteger(a) && isInteger(b) {
+		return false
+	}
+	f, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+	g, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return false
+	}
+	return float32(f) == float32(g) && !math.IsInf(float64(float32(f)), 0)
+}
+
+func isInteger(s string) bool {
+	return !strings.ContainsAny(s, `.eE`)
+}
+
+func timestampsEqual(a, b string) bool {
+	x, err := time.Parse(time.RFC3339Nano, a)
+	if err != nil {
+		return false
+	}
+	y, err := time.Parse(time.RFC3339Nano, b)
+	if err != nil {
+		return false
+	}
+	return x.Equal(y)
+}
+
+func durationsEqual(a, b string) bool {
+	x, err := time.ParseDuration(a)
+	if err != nil {
+		return false
+	}
+	y, err := time.ParseDuration(b)
+	if err != nil {
+		return false
+	}
+	return x == y
+}
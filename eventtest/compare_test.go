@@ -0,0 +1,48 @@
+package eventtest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEquivalent(t *testing.T) {
+	for _, tc := range [...]struct {
+		Name string
+		Got  any
+		Want any
+		OK   bool
+	}{
+		{`nil`, nil, nil, true},
+		{`nil vs string`, nil, ``, false},
+		{`same string`, `a`, `a`, true},
+		{`different string`, `a`, `b`, false},
+		{`number`, json.Number(`1`), json.Number(`1.0`), true},
+		{`number different`, json.Number(`1`), json.Number(`2`), false},
+		{`number as string`, `9223372036854775807`, json.Number(`9223372036854775807`), true},
+		{`number as string different`, `9223372036854775806`, json.Number(`9223372036854775807`), false},
+		{`string as number`, json.Number(`5`), `5`, true},
+		{`number vs non numeric string`, `a`, json.Number(`5`), false},
+		{`float32 precision`, json.Number(`3.4028235e+38`), json.Number(`3.4028234663852886e+38`), true},
+		{`float32 overflow`, json.Number(`1.7976931348623157e+308`), json.Number(`1.7976931348623155e+308`), false},
+		{`timestamp offset`, `2019-05-17T15:07:20.361696123+10:00`, `2019-05-17T05:07:20.361696123Z`, true},
+		{`timestamp different`, `2019-05-17T15:07:20.361696123Z`, `2019-05-17T05:07:20.361696123Z`, false},
+		{`duration`, `1.5s`, `1.500s`, true},
+		{`duration different`, `1.5s`, `1.501s`, false},
+		{`map`, map[string]any{`a`: `1`}, map[string]any{`a`: json.Number(`1`)}, true},
+		{`map missing`, map[string]any{`b`: `1`}, map[string]any{`a`: json.Number(`1`)}, false},
+		{`map extra`, map[string]any{`a`: `1`, `b`: `1`}, map[string]any{`a`: json.Number(`1`)}, false},
+		{`slice`, []any{`1`, nil}, []any{json.Number(`1`), nil}, true},
+		{`slice length`, []any{`1`}, []any{json.Number(`1`), nil}, false},
+		{`type mismatch`, []any{}, map[string]any{}, false},
+		{`one of`, `b`, oneOf{`a`, `b`}, true},
+		{`one of none`, `c`, oneOf{`a`, `b`}, false},
+		{`merged mixed`, []any{`x`, map[string]any{}}, merge([]any{`x`, `x`}, []any{map[string]any{}, map[string]any{}}), true},
+		{`merged map key`, map[string]any{`b`: `x`}, merge(map[string]any{`a`: `x`}, map[string]any{`b`: `y`}), false},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if ok := equivalent(tc.Got, tc.Want); ok != tc.OK {
+				t.Errorf("expected %v, got %v", tc.OK, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package eventtest provides a conformance test suite, for implementations of
+// [logiface.Event], see [Run].
+//
+// The suite exercises every method of [logiface.Builder],
+// [logiface.Context], [logiface.ObjectBuilder], and [logiface.ArrayBuilder],
+// both directly, and with the optional Add* methods of the event masked (i.e.
+// returning false), so that the fallback behavior of logiface is also
+// exercised, then compares the decoded output of the implementation, against
+// that of a reference implementation.
+//
+// Comparisons are performed against normalized values (see
+// [logifacetest.Normalize]), and are tolerant of the expected variance between
+// encodings, e.g. integers encoded as strings, timestamps with a different
+// (but equivalent) offset, and the precision of float32 values. Values added
+// via [logiface.Event.AddField] may also be encoded as per [encoding/json].
+//
+// Groups (see [logiface.Event.AddGroup]) are not exercised, as their encoding
+// is implementation-specific.
+package eventtest
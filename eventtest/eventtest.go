@@ -0,0 +1,426 @@
+package eventtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/fieldtest"
+	"github.com/joeycumines/logiface/logifacetest"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type (
+	// Config models the implementation under test, see [Run].
+	Config[E logiface.Event] struct {
+		// EventFactory is the implementation under test. Required.
+		EventFactory logiface.EventFactory[E]
+
+		// Writer writes events, such that they may be decoded, see
+		// Decode. Required.
+		Writer logiface.Writer[E]
+
+		// EventReleaser is optional, and will be called after each event is
+		// written.
+		EventReleaser logiface.EventReleaser[E]
+
+		// Options are any additional options, e.g. [logiface.WithJSONSupport],
+		// which are only applied for the tests that don't mask the optional
+		// methods of the event, as the event type will differ.
+		Options []logiface.Option[E]
+
+		// Decode returns the events written since the last call. Required.
+		// See also [JSONDecoder].
+		Decode func() ([]Event, error)
+	}
+
+	// Event is a decoded event, see [Config.Decode].
+	Event struct {
+		// Fields are all fields, including the message and error, which must
+		// use the keys "msg" and "err", respectively. Values will be
+		// normalized, see [logifacetest.Normalize].
+		Fields map[string]any
+
+		// Level is the level of the event.
+		Level logiface.Level
+	}
+
+	testCase struct {
+		name string
+		fn   func(l *logiface.Logger[logiface.Event])
+	}
+)
+
+var (
+	// testCases are run against both the implementation and the reference
+	testCases = [...]testCase{
+		{`Builder`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Call(fieldtest.FluentObjectTemplate[*logiface.Builder[logiface.Event]]).
+				Log(`Builder`)
+		}},
+		{`Context`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				Call(fieldtest.FluentObjectTemplate[*logiface.Context[logiface.Event]]).
+				Logger().
+				Notice().
+				Log(`Context`)
+		}},
+		{`Context and Builder`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				Str(`context`, `a`).
+				Logger().
+				Warning().
+				Str(`builder`, `b`).
+				Log(`Context and Builder`)
+		}},
+		{`Builder.Object`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				Call(fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+				As(`object`).
+				End().
+				Log(`Builder.Object`)
+		}},
+		{`Builder.Array`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Array().
+				Call(fieldtest.FluentArrayTemplate[logiface.BuilderArray]).
+				As(`array`).
+				End().
+				Log(`Builder.Array`)
+		}},
+		{`Builder.ObjectFunc`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				ObjectFunc(`object`, fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+				Log(`Builder.ObjectFunc`)
+		}},
+		{`Builder.ArrayFunc`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				ArrayFunc(`array`, fieldtest.FluentArrayTemplate[logiface.BuilderArray]).
+				Log(`Builder.ArrayFunc`)
+		}},
+		{`Context.Object`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				Object().
+				Call(fieldtest.FluentObjectTemplate[logiface.ContextObject]).
+				As(`object`).
+				End().
+				Logger().
+				Info().
+				Log(`Context.Object`)
+		}},
+		{`Context.Array`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				Array().
+				Call(fieldtest.FluentArrayTemplate[logiface.ContextArray]).
+				As(`array`).
+				End().
+				Logger().
+				Info().
+				Log(`Context.Array`)
+		}},
+		{`nested objects`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				Str(`before`, `a`).
+				Object().
+				Call(fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+				As(`inner`).
+				CurObject().
+				Str(`after`, `b`).
+				As(`outer`).
+				End().
+				Log(`nested objects`)
+		}},
+		{`nested arrays`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Array().
+				Str(`before`).
+				Array().
+				Call(fieldtest.FluentArrayTemplate[logiface.BuilderArray]).
+				Add().
+				Array().
+				Add().
+				CurArray().
+				Str(`after`).
+				As(`outer`).
+				End().
+				Log(`nested arrays`)
+		}},
+		{`object in array`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Array().
+				Object().
+				Call(fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+				Add().
+				Object().
+				Add().
+				As(`array`).
+				End().
+				Log(`object in array`)
+		}},
+		{`array in object`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				Array().
+				Call(fieldtest.FluentArrayTemplate[logiface.BuilderArray]).
+				As(`array`).
+				Array().
+				As(`empty`).
+				As(`object`).
+				End().
+				Log(`array in object`)
+		}},
+		{`empty message`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Str(`key`, `val`).
+				Log(``)
+		}},
+		{`levels`, func(l *logiface.Logger[logiface.Event]) {
+			for _, level := range [...]logiface.Level{
+				logiface.LevelEmergency,
+				logiface.LevelAlert,
+				logiface.LevelCritical,
+				logiface.LevelError,
+				logiface.LevelWarning,
+				logiface.LevelNotice,
+				logiface.LevelInformational,
+				logiface.LevelDebug,
+				logiface.LevelTrace,
+			} {
+				l.Build(level).Log(level.String())
+			}
+		}},
+	}
+)
+
+// Run runs the conformance test suite, see the package docs. Each event
+// written must be decodable, using [Config.Decode], after each is logged.
+func Run[E logiface.Event](t *testing.T, cfg Config[E]) {
+	t.Helper()
+
+	cfg.validate(t)
+
+	t.Run(`direct`, func(t *testing.T) {
+		h := newHarness(cfg.newLogger(), cfg.Decode, maskNone)
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) { h.check(t, tc.fn) })
+		}
+	})
+
+	names, values := masks()
+	for i := range names {
+		m := values[i]
+		t.Run(`mask=`+names[i], func(t *testing.T) {
+			options := []logiface.Option[*maskedEvent[E]]{
+				logiface.WithEventFactory[*maskedEvent[E]](logiface.NewEventFactoryFunc(func(level logiface.Level) *maskedEvent[E] {
+					return &maskedEvent[E]{event: cfg.EventFactory.NewEvent(level), mask: m}
+				})),
+				logiface.WithWriter[*maskedEvent[E]](logiface.NewWriterFunc(func(event *maskedEvent[E]) error {
+					return cfg.Writer.Write(event.event)
+				})),
+				logiface.WithLevel[*maskedEvent[E]](logiface.LevelTrace),
+				logiface.WithDPanicLevel[*maskedEvent[E]](logiface.LevelCritical),
+			}
+			if cfg.EventReleaser != nil {
+				options = append(options, logiface.WithEventReleaser[*maskedEvent[E]](logiface.NewEventReleaserFunc(func(event *maskedEvent[E]) {
+					cfg.EventReleaser.ReleaseEvent(event.event)
+				})))
+			}
+			h := newHarness(logiface.New(options...).Logger(), cfg.Decode, m)
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) { h.check(t, tc.fn) })
+			}
+		})
+	}
+}
+
+func (x *Config[E]) validate(t testing.TB) {
+	t.Helper()
+	if x.EventFactory == nil || x.Writer == nil || x.Decode == nil {
+		t.Fatal(`eventtest: the EventFactory, Writer, and Decode fields are required`)
+	}
+}
+
+// newLogger initializes a logger using the config, and any options, which
+// are applied after Config.Options
+func (x *Config[E]) newLogger(options ...logiface.Option[E]) *logiface.Logger[logiface.Event] {
+	all := []logiface.Option[E]{
+		logiface.WithEventFactory[E](x.EventFactory),
+		logiface.WithWriter[E](x.Writer),
+	}
+	if x.EventReleaser != nil {
+		all = append(all, logiface.WithEventReleaser[E](x.EventReleaser))
+	}
+	all = append(all, x.Options...)
+	all = append(all, options...)
+	all = append(all,
+		logiface.WithLevel[E](logiface.LevelTrace),
+		logiface.WithDPanicLevel[E](logiface.LevelCritical),
+	)
+	return logiface.New(all...).Logger()
+}
+
+// harness compares the output of a logger, against that of the reference
+type harness struct {
+	logger    *logiface.Logger[logiface.Event]
+	reference *logiface.Logger[logiface.Event]
+	decode    func() ([]Event, error)
+	want      []*refEvent
+}
+
+// newHarness initializes a harness, with a reference logger that uses the
+// default JSON support, and the same mask as the logger
+func newHarness(logger *logiface.Logger[logiface.Event], decode func() ([]Event, error), m mask) *harness {
+	h := harness{logger: logger, decode: decode}
+	h.reference = logiface.New(
+		logiface.WithEventFactory[*maskedEvent[*refEvent]](logiface.NewEventFactoryFunc(func(level logiface.Level) *maskedEvent[*refEvent] {
+			return &maskedEvent[*refEvent]{event: newRefEvent(level), mask: m}
+		})),
+		logiface.WithWriter[*maskedEvent[*refEvent]](logiface.NewWriterFunc(func(event *maskedEvent[*refEvent]) error {
+			h.want = append(h.want, event.event)
+			return nil
+		})),
+		logiface.WithLevel[*maskedEvent[*refEvent]](logiface.LevelTrace),
+		logiface.WithDPanicLevel[*maskedEvent[*refEvent]](logiface.LevelCritical),
+	).Logger()
+	return &h
+}
+
+// check calls fn with each of the reference and the logger, and compares
+// the events that were written
+func (x *harness) check(t testing.TB, fn func(l *logiface.Logger[logiface.Event])) {
+	t.Helper()
+
+	if _, err := x.decode(); err != nil {
+		t.Fatalf("eventtest: decode error: %v", err)
+	}
+
+	x.want = x.want[:0]
+	fn(x.reference)
+
+	fn(x.logger)
+	events, err := x.decode()
+	if err != nil {
+		t.Fatalf("eventtest: decode error: %v", err)
+	}
+	if len(events) != len(x.want) {
+		t.Fatalf("eventtest: expected %d event(s), got %d", len(x.want), len(events))
+	}
+
+	for i := range events {
+		if diff := diffEvent(&events[i], x.want[i]); diff != `` {
+			t.Errorf("eventtest: unexpected event %d:\n%s", i, diff)
+		}
+	}
+}
+
+// diffEvent returns a description of the differences, or an empty string
+func diffEvent(got *Event, want *refEvent) string {
+	var diff []string
+
+	if got.Level != want.lvl {
+		diff = append(diff, fmt.Sprintf("level: got %s, want %s", got.Level, want.lvl))
+	}
+
+	keys := make(map[string]struct{}, len(want.fields))
+	for k := range want.fields {
+		keys[k] = struct{}{}
+	}
+	for k := range got.Fields {
+		keys[k] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, k := range sorted {
+		g, hasGot := got.Fields[k]
+		w, hasWant := want.fields[k]
+		switch {
+		case !hasGot:
+			diff = append(diff, fmt.Sprintf("%q: missing, want %s", k, formatValue(w)))
+		case !hasWant:
+			diff = append(diff, fmt.Sprintf("%q: unexpected, got %s", k, formatValue(g)))
+		default:
+			if g = logifacetest.Normalize(g); !equivalent(g, w) {
+				diff = append(diff, fmt.Sprintf("%q: got %s, want %s", k, formatValue(g), formatValue(w)))
+			}
+		}
+	}
+
+	return strings.Join(diff, "\n")
+}
+
+func formatValue(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// JSONDecoder returns a function, for use as [Config.Decode], that decodes
+// all data written to buf, as a stream of JSON objects (e.g. JSON lines),
+// resetting buf. The level, message, and error will be read from the given
+// keys, where the level may be either a string, parsed using
+// [logiface.ParseLevel], or an integer. Any ignored keys (e.g. a time field)
+// will be removed.
+func JSONDecoder(buf *bytes.Buffer, levelKey, messageKey, errorKey string, ignoredKeys ...string) func() ([]Event, error) {
+	return func() ([]Event, error) {
+		defer buf.Reset()
+		var events []Event
+		d := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+		d.UseNumber()
+		for {
+			var fields map[string]any
+			if err := d.Decode(&fields); err == io.EOF {
+				return events, nil
+			} else if err != nil {
+				return events, err
+			}
+
+			event := Event{Fields: make(map[string]any, len(fields))}
+
+			switch level := fields[levelKey].(type) {
+			case string:
+				v, err := logiface.ParseLevel(level)
+				if err != nil {
+					return events, err
+				}
+				event.Level = v
+			case json.Number:
+				v, err := level.Int64()
+				if err != nil {
+					return events, err
+				}
+				event.Level = logiface.Level(v)
+			default:
+				return events, fmt.Errorf("eventtest: invalid level: %v", level)
+			}
+			delete(fields, levelKey)
+
+			for _, k := range ignoredKeys {
+				delete(fields, k)
+			}
+
+			for k, v := range fields {
+				switch k {
+				case messageKey:
+					k = `msg`
+				case errorKey:
+					k = `err`
+				}
+				event.Fields[k] = v
+			}
+
+			events = append(events, event)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package eventtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/logifacetest"
+	"testing"
+)
+
+func TestRun_logifacetest(t *testing.T) {
+	observer := logifacetest.NewObserver()
+	Run(t, Config[*logifacetest.Event]{
+		EventFactory: observer,
+		Writer:       observer,
+		Decode: func() ([]Event, error) {
+			var events []Event
+			for _, e := range observer.TakeAll() {
+				fields := e.Map()
+				if e.Message != `` {
+					fields[`msg`] = e.Message
+				}
+				if e.Err != nil {
+					fields[`err`] = e.Err.Error()
+				}
+				events = append(events, Event{Level: e.Lvl, Fields: fields})
+			}
+			return events, nil
+		},
+	})
+}
+
+func TestJSONDecoder(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("{\"level\":\"warn\",\"message\":\"a\",\"error\":\"b\",\"time\":1,\"n\":1.5}\n{\"level\":6}\n")
+	decode := JSONDecoder(&buf, `level`, `message`, `error`, `time`)
+	events, err := decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("unexpected events: %v", events)
+	}
+	if events[0].Level != logiface.LevelWarning || len(events[0].Fields) != 3 ||
+		events[0].Fields[`msg`] != `a` || events[0].Fields[`err`] != `b` || events[0].Fields[`n`] != json.Number(`1.5`) {
+		t.Errorf("unexpected event: %v", events[0])
+	}
+	if events[1].Level != logiface.LevelInformational || len(events[1].Fields) != 0 {
+		t.Errorf("unexpected event: %v", events[1])
+	}
+	if buf.Len() != 0 {
+		t.Error(`expected buffer to be reset`)
+	}
+	if events, err := decode(); err != nil || len(events) != 0 {
+		t.Errorf("unexpected result: %v %v", events, err)
+	}
+
+	buf.WriteString(`{"level":"nope"}`)
+	if _, err := decode(); err == nil {
+		t.Error(`expected error`)
+	}
+	buf.WriteString(`{}`)
+	if _, err := decode(); err == nil {
+		t.Error(`expected error`)
+	}
+}
+
+func TestDiffEvent(t *testing.T) {
+	want := newRefEvent(logiface.LevelError)
+	want.AddMessage(`msg`)
+	want.AddInt64(`int64`, 5)
+	want.AddField(`err`, errors.New(`e`))
+	want.AddString(`str`, `val`)
+
+	if diff := diffEvent(&Event{Level: logiface.LevelError, Fields: map[string]any{
+		`msg`:   `msg`,
+		`int64`: `5`,
+		`err`:   map[string]any{},
+		`str`:   `val`,
+	}}, want); diff != `` {
+		t.Errorf("unexpected diff:\n%s", diff)
+	}
+
+	if diff := diffEvent(&Event{Level: logiface.LevelWarning, Fields: map[string]any{
+		`msg`:   `msg`,
+		`int64`: json.Number(`6`),
+		`err`:   `e`,
+		`extra`: true,
+	}}, want); diff != `level: got warning, want err
+"extra": unexpected, got true
+"int64": got 6, want 5
+"str": missing, want "val"` {
+		t.Errorf("unexpected diff:\n%s", diff)
+	}
+}
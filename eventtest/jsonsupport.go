@@ -0,0 +1,402 @@
+package eventtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/fieldtest"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type (
+	// programFrame is an open object or array, see runProgram
+	programFrame struct {
+		obj logiface.BuilderObject
+		arr logiface.BuilderArray
+	}
+)
+
+var (
+	// jsonTestCases are run in addition to testCases, by RunJSONSupport
+	jsonTestCases = [...]testCase{
+		{`Chain`, func(l *logiface.Logger[logiface.Event]) {
+			l.Notice().
+				Object().
+				Field(`a`, 1).
+				Field(`b`, true).
+				Array().
+				Field(2).
+				Object().
+				Field(`c`, false).
+				Add().
+				As(`d`).
+				CurObject().
+				Field(`D`, 3).
+				Object().
+				Field(`aa1`, 1).
+				Array().
+				Array().Add().
+				Array().Add().
+				CurArray().
+				Field(`aaa1`).
+				As(`aaa`).
+				CurObject().
+				Field(`aa2`, 2).
+				As(`aa`).
+				As(`e`).
+				Array().
+				Field(5).
+				Object().
+				Field(`f`, 4).
+				Add().
+				Object().
+				Field(`g`, 6).
+				Add().
+				As(`h`).
+				End().
+				Field(`j`, `J`).
+				Log(`Chain`)
+		}},
+		{`Context Chain`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				Object().
+				Str(`a`, `b`).
+				Array().
+				Object().
+				Str(`c`, `d`).
+				Add().
+				As(`e`).
+				As(`f`).
+				Array().
+				Array().
+				Add().
+				As(`g`).
+				End().
+				Logger().
+				Info().
+				Str(`h`, `i`).
+				Log(`Context Chain`)
+		}},
+		{`ObjectFunc and ArrayFunc`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				ObjectFunc(`object`, func(b logiface.BuilderObject) {
+					b.Str(`a`, `b`).
+						ObjectFunc(`object`, fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+						ArrayFunc(`array`, func(b logiface.BuilderArray) {
+							b.ObjectFunc(fieldtest.FluentObjectTemplate[logiface.BuilderObject]).
+								ArrayFunc(fieldtest.FluentArrayTemplate[logiface.BuilderArray]).
+								ArrayFunc(nil).
+								ObjectFunc(nil)
+						}).
+						ObjectFunc(`empty object`, nil).
+						ArrayFunc(`empty array`, nil)
+				}).
+				ArrayFunc(`array`, func(b logiface.BuilderArray) {
+					b.ArrayFunc(func(b logiface.BuilderArray) {
+						b.ArrayFunc(func(b logiface.BuilderArray) {
+							b.Str(`deep`)
+						})
+					})
+				}).
+				Log(`ObjectFunc and ArrayFunc`)
+		}},
+		{`Context ObjectFunc and ArrayFunc`, func(l *logiface.Logger[logiface.Event]) {
+			l.Clone().
+				ObjectFunc(`object`, func(b logiface.ContextObject) {
+					b.ArrayFunc(`array`, fieldtest.FluentArrayTemplate[logiface.ContextArray])
+				}).
+				ArrayFunc(`array`, func(b logiface.ContextArray) {
+					b.ObjectFunc(fieldtest.FluentObjectTemplate[logiface.ContextObject])
+				}).
+				Logger().
+				Info().
+				Log(`Context ObjectFunc and ArrayFunc`)
+		}},
+		{`empty`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				As(`object`).
+				End().
+				Array().
+				As(`array`).
+				End().
+				Object().
+				Array().
+				Add().
+				Object().
+				Add().
+				As(`nested array`).
+				End().
+				Log(`empty`)
+		}},
+		{`deep`, func(l *logiface.Logger[logiface.Event]) {
+			program := make([]byte, 0, 256)
+			for i := 0; i < 64; i++ {
+				program = append(program, byte(i%2), opField+byte(i))
+			}
+			runProgram(l, program)
+		}},
+		{`Add in object`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				Str(`a`, `b`).
+				Object().
+				Str(`c`, `d`).
+				Add().
+				Add().
+				End().
+				Log(`Add in object`)
+		}},
+		{`As after End`, func(l *logiface.Logger[logiface.Event]) {
+			l.Info().
+				Object().
+				As(`a`).
+				As(`b`).
+				Add().
+				End().
+				Log(`As after End`)
+		}},
+	}
+)
+
+// RunJSONSupport runs the conformance test suite for a
+// [logiface.JSONSupport] implementation, using the event implementation
+// configured by cfg, which may be tested using [Run], and must not be
+// configured with any other JSONSupport. The output is compared against that
+// of the default JSON support (which uses map[string]any and []any).
+//
+// Every test case is run with every CanX method enabled (i.e. as
+// implemented), then with each disabled, then with all but each disabled,
+// then with all disabled. The CanX methods that start an object or array
+// (e.g. CanAddStartObject) are never disabled, as implementations may not
+// support the alternative (e.g. NewObject). Test cases include those of [Run], deep nesting,
+// using [logiface.Chain], and misuse of the Add and As methods. Ending a
+// chain prior to writing each nested object or array is not tested, as the
+// behavior will differ, for implementations that support starting an object
+// or array, prior to it being written (e.g. AddStartObject).
+//
+// See also [FuzzJSONSupport].
+func RunJSONSupport[E logiface.Event, O any, A any](t *testing.T, cfg Config[E], impl logiface.JSONSupport[E, O, A]) {
+	t.Helper()
+
+	cfg.validate(t)
+
+	names, values := capabilities()
+	for i := range names {
+		c := values[i]
+		t.Run(`capabilities=`+names[i], func(t *testing.T) {
+			h := newHarness(cfg.newLogger(logiface.WithJSONSupport[E, O, A](&maskedJSONSupport[E, O, A]{JSONSupport: impl, mask: c})), cfg.Decode, maskNone)
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) { h.check(t, tc.fn) })
+			}
+			for _, tc := range jsonTestCases {
+				t.Run(tc.name, func(t *testing.T) { h.check(t, tc.fn) })
+			}
+		})
+	}
+}
+
+// FuzzJSONSupport is a fuzz target, for use within a Go fuzz test, that
+// builds a single event, per the fuzzed input, with a random combination of
+// nested objects, arrays, and fields, using a random combination of CanX
+// methods, comparing it per [RunJSONSupport].
+//
+// The fuzz arguments are the capabilities (a uint64 bit mask, disabling CanX
+// methods) and the program (a []byte), and a seed corpus is added.
+func FuzzJSONSupport[E logiface.Event, O any, A any](f *testing.F, cfg Config[E], impl logiface.JSONSupport[E, O, A]) {
+	f.Helper()
+
+	cfg.validate(f)
+
+	f.Add(uint64(capabilityNone), []byte{opObject, opField, opArray, opField + 1, opClose, opField + 2})
+	f.Add(uint64(capabilityAll), []byte{opArray, opObject, opArray, opField + 3, opClose, opClose, opField + 4})
+	f.Add(uint64(capSetString|capAppendArray), []byte{opObject, opObject, opArray, opObject, opField + 5})
+	f.Add(uint64(capAppendObject|capSetObject), []byte{opArray, opArray, opField + 6, opClose, opObject, opClose, opCloseAdd})
+
+	f.Fuzz(func(t *testing.T, capabilities uint64, program []byte) {
+		c := capability(capabilities) & capabilityAll
+		h := newHarness(cfg.newLogger(logiface.WithJSONSupport[E, O, A](&maskedJSONSupport[E, O, A]{JSONSupport: impl, mask: c})), cfg.Decode, maskNone)
+		h.check(t, func(l *logiface.Logger[logiface.Event]) { runProgram(l, program) })
+	})
+}
+
+// program opcodes, see runProgram
+const (
+	opObject   byte = iota // start an object
+	opArray                // start an array
+	opClose                // write the current object or array, to it's parent
+	opCloseAdd             // like opClose, but use Add (i.e. an empty key)
+	opField                // add a field (opField+n selects the field type)
+)
+
+// runProgram logs a single event, as described by program, and is used to
+// exercise arbitrary nesting, see also FuzzJSONSupport
+func runProgram(l *logiface.Logger[logiface.Event], program []byte) {
+	var (
+		b     = l.Info()
+		stack []programFrame
+		keys  int
+	)
+
+	nextKey := func() string {
+		keys++
+		return `k` + strconv.Itoa(keys)
+	}
+
+	closeFrame := func(add bool) {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		var parentIsArray bool
+		if len(stack) != 0 {
+			parentIsArray = stack[len(stack)-1].arr != nil
+		}
+
+		key := ``
+		if !add && !parentIsArray {
+			key = nextKey()
+		}
+
+		var c *logiface.Chain[logiface.Event, *logiface.Builder[logiface.Event]]
+		if top.obj != nil {
+			c = top.obj.As(key)
+		} else {
+			c = top.arr.As(key)
+		}
+
+		if len(stack) != 0 {
+			// the chain's current value is the parent
+			if parent := &stack[len(stack)-1]; parent.obj != nil {
+				parent.obj = c.CurObject()
+			} else {
+				parent.arr = c.CurArray()
+			}
+		}
+
+		c.End()
+	}
+
+	for _, op := range program {
+		switch {
+		case op == opObject || op == opArray:
+			if len(stack) >= 128 {
+				continue
+			}
+			var frame programFrame
+			switch {
+			case len(stack) == 0 && op == opObject:
+				frame.obj = b.Object()
+			case len(stack) == 0:
+				frame.arr = b.Array()
+			case stack[len(stack)-1].obj != nil && op == opObject:
+				frame.obj = stack[len(stack)-1].obj.Object()
+			case stack[len(stack)-1].obj != nil:
+				frame.arr = stack[len(stack)-1].obj.Array()
+			case op == opObject:
+				frame.obj = stack[len(stack)-1].arr.Object()
+			default:
+				frame.arr = stack[len(stack)-1].arr.Array()
+			}
+			stack = append(stack, frame)
+
+		case op == opClose || op == opCloseAdd:
+			if len(stack) != 0 {
+				closeFrame(op == opCloseAdd)
+			}
+
+		default:
+			switch {
+			case len(stack) == 0:
+				objectField(b, nextKey(), op-opField)
+			case stack[len(stack)-1].obj != nil:
+				objectField(stack[len(stack)-1].obj, nextKey(), op-opField)
+			default:
+				arrayField(stack[len(stack)-1].arr, op-opField)
+			}
+		}
+	}
+
+	for len(stack) != 0 {
+		closeFrame(false)
+	}
+
+	b.Log(`program`)
+}
+
+func objectField[T fieldtest.ObjectMethods[T]](x T, key string, n byte) {
+	switch n % 16 {
+	case 0:
+		x.Str(key, key)
+	case 1:
+		x.Int(key, -int(n))
+	case 2:
+		x.Bool(key, n%32 < 16)
+	case 3:
+		x.Float32(key, float32(n)/3)
+	case 4:
+		x.Float64(key, math.Pi*float64(n))
+	case 5:
+		x.Int64(key, math.MinInt64+int64(n))
+	case 6:
+		x.Uint64(key, math.MaxUint64-uint64(n))
+	case 7:
+		x.Time(key, time.Unix(1700000000, int64(n)*1000003))
+	case 8:
+		x.Dur(key, time.Duration(n)*time.Millisecond+1)
+	case 9:
+		x.Base64(key, []byte{n, n + 1}, base64.RawURLEncoding)
+	case 10:
+		x.Err(errors.New(key))
+	case 11:
+		x.RawJSON(key, json.RawMessage(`{"n":`+strconv.Itoa(int(n))+`}`))
+	case 12:
+		x.Field(key, []byte{n})
+	case 13:
+		x.Interface(key, map[string]any{`n`: int(n)})
+	case 14:
+		x.Stringer(key, fieldtest.ByteStringer(key))
+	default:
+		x.Any(key, nil)
+	}
+}
+
+func arrayField[T fieldtest.ArrayMethods[T]](x T, n byte) {
+	switch n % 16 {
+	case 0:
+		x.Str(`s` + strconv.Itoa(int(n)))
+	case 1:
+		x.Int(-int(n))
+	case 2:
+		x.Bool(n%32 < 16)
+	case 3:
+		x.Float32(float32(n) / 3)
+	case 4:
+		x.Float64(math.Pi * float64(n))
+	case 5:
+		x.Int64(math.MinInt64 + int64(n))
+	case 6:
+		x.Uint64(math.MaxUint64 - uint64(n))
+	case 7:
+		x.Time(time.Unix(1700000000, int64(n)*1000003))
+	case 8:
+		x.Dur(time.Duration(n)*time.Millisecond + 1)
+	case 9:
+		x.Base64([]byte{n, n + 1}, base64.RawURLEncoding)
+	case 10:
+		x.Err(errors.New(`e` + strconv.Itoa(int(n))))
+	case 11:
+		x.RawJSON(json.RawMessage(`[` + strconv.Itoa(int(n)) + `]`))
+	case 12:
+		x.Field([]byte{n})
+	case 13:
+		x.Interface([]any{int(n)})
+	case 14:
+		x.Stringer(fieldtest.ByteStringer(`b` + strconv.Itoa(int(n))))
+	default:
+		x.Any(nil)
+	}
+}
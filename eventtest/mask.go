@@ -0,0 +1,228 @@
+package eventtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+// mask bits correspond to the optional methods of logiface.Event
+const (
+	maskAddMessage mask = 1 << iota
+	maskAddError
+	maskAddString
+	maskAddInt
+	maskAddFloat32
+	maskAddTime
+	maskAddDuration
+	maskAddBase64Bytes
+	maskAddBool
+	maskAddFloat64
+	maskAddInt64
+	maskAddUint64
+	maskAddRawJSON
+	maskAddStrings
+	maskAddInts
+	maskAddInt64s
+	maskAddFloat64s
+	maskAddBools
+	maskAddDurations
+	maskAddTimes
+	maskAddErrors
+	maskAddIPAddr
+	maskAddIPPrefix
+	maskAddURL
+	maskAddHexBytes
+	maskAddTimestamp
+
+	maskNone mask = 0
+	maskAll       = maskAddTimestamp<<1 - 1
+)
+
+type (
+	// mask models a set of optional methods, which will return false
+	mask uint32
+
+	// maskedEvent wraps an event, masking the optional methods per mask
+	maskedEvent[E logiface.Event] struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		event E
+		mask  mask
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*maskedEvent[logiface.Event])(nil)
+)
+
+var (
+	// maskNames are the names of each (single bit) mask, in order
+	maskNames = [...]string{
+		`AddMessage`,
+		`AddError`,
+		`AddString`,
+		`AddInt`,
+		`AddFloat32`,
+		`AddTime`,
+		`AddDuration`,
+		`AddBase64Bytes`,
+		`AddBool`,
+		`AddFloat64`,
+		`AddInt64`,
+		`AddUint64`,
+		`AddRawJSON`,
+		`AddStrings`,
+		`AddInts`,
+		`AddInt64s`,
+		`AddFloat64s`,
+		`AddBools`,
+		`AddDurations`,
+		`AddTimes`,
+		`AddErrors`,
+		`AddIPAddr`,
+		`AddIPPrefix`,
+		`AddURL`,
+		`AddHexBytes`,
+		`AddTimestamp`,
+	}
+)
+
+// masks returns each mask to test, with a name for each
+func masks() (names []string, values []mask) {
+	names = append(names, `none`)
+	values = append(values, maskNone)
+	for i, name := range maskNames {
+		names = append(names, name)
+		values = append(values, 1<<i)
+	}
+	names = append(names, `all`)
+	values = append(values, maskAll)
+	return
+}
+
+func (x mask) has(m mask) bool { return x&m != 0 }
+
+func (x *maskedEvent[E]) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.event.Level()
+}
+
+func (x *maskedEvent[E]) AddField(key string, val any) {
+	x.event.AddField(key, val)
+}
+
+func (x *maskedEvent[E]) AddMessage(msg string) bool {
+	return !x.mask.has(maskAddMessage) && x.event.AddMessage(msg)
+}
+
+func (x *maskedEvent[E]) AddError(err error) bool {
+	return !x.mask.has(maskAddError) && x.event.AddError(err)
+}
+
+func (x *maskedEvent[E]) AddString(key string, val string) bool {
+	return !x.mask.has(maskAddString) && x.event.AddString(key, val)
+}
+
+func (x *maskedEvent[E]) AddInt(key string, val int) bool {
+	return !x.mask.has(maskAddInt) && x.event.AddInt(key, val)
+}
+
+func (x *maskedEvent[E]) AddFloat32(key string, val float32) bool {
+	return !x.mask.has(maskAddFloat32) && x.event.AddFloat32(key, val)
+}
+
+func (x *maskedEvent[E]) AddTime(key string, val time.Time) bool {
+	return !x.mask.has(maskAddTime) && x.event.AddTime(key, val)
+}
+
+func (x *maskedEvent[E]) AddDuration(key string, val time.Duration) bool {
+	return !x.mask.has(maskAddDuration) && x.event.AddDuration(key, val)
+}
+
+func (x *maskedEvent[E]) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	return !x.mask.has(maskAddBase64Bytes) && x.event.AddBase64Bytes(key, val, enc)
+}
+
+func (x *maskedEvent[E]) AddBool(key string, val bool) bool {
+	return !x.mask.has(maskAddBool) && x.event.AddBool(key, val)
+}
+
+func (x *maskedEvent[E]) AddFloat64(key string, val float64) bool {
+	return !x.mask.has(maskAddFloat64) && x.event.AddFloat64(key, val)
+}
+
+func (x *maskedEvent[E]) AddInt64(key string, val int64) bool {
+	return !x.mask.has(maskAddInt64) && x.event.AddInt64(key, val)
+}
+
+func (x *maskedEvent[E]) AddUint64(key string, val uint64) bool {
+	return !x.mask.has(maskAddUint64) && x.event.AddUint64(key, val)
+}
+
+func (x *maskedEvent[E]) AddRawJSON(key string, val json.RawMessage) bool {
+	return !x.mask.has(maskAddRawJSON) && x.event.AddRawJSON(key, val)
+}
+
+func (x *maskedEvent[E]) AddStrings(key string, val []string) bool {
+	return !x.mask.has(maskAddStrings) && x.event.AddStrings(key, val)
+}
+
+func (x *maskedEvent[E]) AddInts(key string, val []int) bool {
+	return !x.mask.has(maskAddInts) && x.event.AddInts(key, val)
+}
+
+func (x *maskedEvent[E]) AddInt64s(key string, val []int64) bool {
+	return !x.mask.has(maskAddInt64s) && x.event.AddInt64s(key, val)
+}
+
+func (x *maskedEvent[E]) AddFloat64s(key string, val []float64) bool {
+	return !x.mask.has(maskAddFloat64s) && x.event.AddFloat64s(key, val)
+}
+
+func (x *maskedEvent[E]) AddBools(key string, val []bool) bool {
+	return !x.mask.has(maskAddBools) && x.event.AddBools(key, val)
+}
+
+func (x *maskedEvent[E]) AddDurations(key string, val []time.Duration) bool {
+	return !x.mask.has(maskAddDurations) && x.event.AddDurations(key, val)
+}
+
+func (x *maskedEvent[E]) AddTimes(key string, val []time.Time) bool {
+	return !x.mask.has(maskAddTimes) && x.event.AddTimes(key, val)
+}
+
+func (x *maskedEvent[E]) AddErrors(key string, val []error) bool {
+	return !x.mask.has(maskAddErrors) && x.event.AddErrors(key, val)
+}
+
+func (x *maskedEvent[E]) AddIPAddr(key string, val netip.Addr) bool {
+	return !x.mask.has(maskAddIPAddr) && x.event.AddIPAddr(key, val)
+}
+
+func (x *maskedEvent[E]) AddIPPrefix(key string, val netip.Prefix) bool {
+	return !x.mask.has(maskAddIPPrefix) && x.event.AddIPPrefix(key, val)
+}
+
+func (x *maskedEvent[E]) AddURL(key string, val *url.URL) bool {
+	return !x.mask.has(maskAddURL) && x.event.AddURL(key, val)
+}
+
+func (x *maskedEvent[E]) AddHexBytes(key string, val []byte) bool {
+	return !x.mask.has(maskAddHexBytes) && x.event.AddHexBytes(key, val)
+}
+
+func (x *maskedEvent[E]) AddTimestamp(key string, val time.Time) bool {
+	return !x.mask.has(maskAddTimestamp) && x.event.AddTimestamp(key, val)
+}
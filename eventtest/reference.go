@@ -0,0 +1,135 @@
+package eventtest
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/logifacetest"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+type (
+	// refEvent is the reference implementation, which records the
+	// acceptable (normalized) values for each field
+	refEvent struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		fields map[string]any
+		lvl    logiface.Level
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*refEvent)(nil)
+)
+
+func newRefEvent(level logiface.Level) *refEvent {
+	return &refEvent{lvl: level, fields: make(map[string]any)}
+}
+
+func (x *refEvent) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+// AddField accepts either the normalized value, or the value as encoded by
+// encoding/json, which will differ for values such as errors, and may be
+// mixed, within nested objects and arrays
+func (x *refEvent) AddField(key string, val any) {
+	want := logifacetest.Normalize(val)
+	if b, err := json.Marshal(val); err == nil {
+		want = merge(want, logifacetest.Normalize(json.RawMessage(b)))
+	}
+	x.fields[key] = want
+}
+
+func (x *refEvent) set(key string, val any) bool {
+	x.fields[key] = logifacetest.Normalize(val)
+	return true
+}
+
+func (x *refEvent) AddMessage(msg string) bool { return x.set(`msg`, msg) }
+
+func (x *refEvent) AddError(err error) bool {
+	if err != nil {
+		x.set(`err`, err)
+	}
+	return true
+}
+
+func (x *refEvent) AddString(key string, val string) bool { return x.set(key, val) }
+
+func (x *refEvent) AddInt(key string, val int) bool { return x.set(key, val) }
+
+func (x *refEvent) AddFloat32(key string, val float32) bool { return x.set(key, val) }
+
+func (x *refEvent) AddTime(key string, val time.Time) bool { return x.set(key, val) }
+
+func (x *refEvent) AddDuration(key string, val time.Duration) bool { return x.set(key, val) }
+
+func (x *refEvent) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	return x.set(key, enc.EncodeToString(val))
+}
+
+func (x *refEvent) AddBool(key string, val bool) bool { return x.set(key, val) }
+
+func (x *refEvent) AddFloat64(key string, val float64) bool { return x.set(key, val) }
+
+func (x *refEvent) AddInt64(key string, val int64) bool { return x.set(key, val) }
+
+func (x *refEvent) AddUint64(key string, val uint64) bool { return x.set(key, val) }
+
+func (x *refEvent) AddRawJSON(key string, val json.RawMessage) bool { return x.set(key, val) }
+
+func (x *refEvent) AddStrings(key string, val []string) bool { return x.set(key, sliceValues(val)) }
+
+func (x *refEvent) AddInts(key string, val []int) bool { return x.set(key, sliceValues(val)) }
+
+func (x *refEvent) AddInt64s(key string, val []int64) bool { return x.set(key, sliceValues(val)) }
+
+func (x *refEvent) AddFloat64s(key string, val []float64) bool { return x.set(key, sliceValues(val)) }
+
+func (x *refEvent) AddBools(key string, val []bool) bool { return x.set(key, sliceValues(val)) }
+
+func (x *refEvent) AddDurations(key string, val []time.Duration) bool {
+	return x.set(key, sliceValues(val))
+}
+
+func (x *refEvent) AddTimes(key string, val []time.Time) bool { return x.set(key, sliceValues(val)) }
+
+// AddErrors accepts the values per AddField, as the fallback behavior will be
+// to add each error per AddError, which may fall back to AddField
+func (x *refEvent) AddErrors(key string, val []error) bool {
+	x.AddField(key, sliceValues(val))
+	return true
+}
+
+func (x *refEvent) AddIPAddr(key string, val netip.Addr) bool { return x.set(key, val) }
+
+func (x *refEvent) AddIPPrefix(key string, val netip.Prefix) bool { return x.set(key, val) }
+
+func (x *refEvent) AddURL(key string, val *url.URL) bool { return x.set(key, val.Redacted()) }
+
+func (x *refEvent) AddHexBytes(key string, val []byte) bool {
+	return x.set(key, hex.EncodeToString(val))
+}
+
+func (x *refEvent) AddTimestamp(key string, val time.Time) bool { return x.set(key, val) }
+
+// sliceValues converts val to []any, such that each element is normalized
+// individually, e.g. time.Duration values will be strings, not numbers
+func sliceValues[V any](val []V) []any {
+	s := make([]any, len(val))
+	for i, v := range val {
+		s[i] = v
+	}
+	return s
+}
@@ -0,0 +1,18 @@
+// Package flightrec implements an in-memory "flight recorder", retaining the
+// most recent events, written to a [logiface.Logger], in a ring buffer, for
+// use with any logiface implementation.
+//
+// The intended use case is to configure the logger to build events at a more
+// verbose level (e.g. [logiface.LevelTrace]) than is normally written,
+// recording every event, but forwarding only those at the "real" level, see
+// [Recorder.Writer]. The retained events may then be dumped, on demand (see
+// [Recorder.Dump]), on receipt of a signal (see [WithDumpSignal]), or
+// automatically, on events at or above a given level, which, by default,
+// includes those logged by [logiface.Logger.Fatal] and
+// [logiface.Logger.Panic] (see [WithDumpLevel]), prior to the process
+// exiting.
+//
+// Since events must not be retained by a [logiface.Writer], each event is
+// snapshot, using a [SnapshotFunc], e.g. the AppendEvent method of
+// jsonl.Logger.
+package flightrec
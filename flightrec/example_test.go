@@ -0,0 +1,44 @@
+package flightrec_test
+
+import (
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/flightrec"
+	"github.com/joeycumines/logiface/jsonl"
+	"os"
+)
+
+// Demonstrates retaining debug events, which are only written (to stdout)
+// if an error occurs.
+func Example() {
+	w := jsonl.NewLogger(jsonl.WithWriter(os.Stdout))
+
+	recorder := flightrec.NewRecorder(
+		w.AppendEvent,
+		flightrec.WithSize(2),
+		flightrec.WithOutput(os.Stdout),
+		flightrec.WithDumpLevel(logiface.LevelError),
+	)
+	defer recorder.Close()
+
+	logger := jsonl.L.New(
+		jsonl.L.WithEventFactory(w),
+		jsonl.L.WithEventReleaser(w),
+		// the recorder receives every event, w receives only info and above
+		jsonl.L.WithWriter(recorder.Writer(w, logiface.LevelInformational)),
+		jsonl.L.WithLevel(logiface.LevelTrace),
+	)
+
+	logger.Debug().Int(`attempt`, 1).Log(`connecting`)
+	logger.Info().Log(`starting`)
+	logger.Debug().Int(`attempt`, 2).Log(`connecting`)
+	fmt.Println(`--- error (dump follows) ---`)
+	logger.Err().Log(`connection failed`)
+
+	//output:
+	//{"lvl":"info","msg":"starting"}
+	//--- error (dump follows) ---
+	//{"lvl":"debug","attempt":2,"msg":"connecting"}
+	//{"lvl":"err","msg":"connection failed"}
+	//{"lvl":"err","msg":"connection failed"}
+}
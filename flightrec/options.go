@@ -0,0 +1,94 @@
+package flightrec
+
+import (
+	"github.com/joeycumines/logiface"
+	"io"
+	"os"
+)
+
+type (
+	// Option models a configuration option for [NewRecorder].
+	Option interface {
+		apply(c *recorderConfig)
+	}
+
+	optionFunc func(c *recorderConfig)
+
+	recorderConfig struct {
+		size         int
+		output       io.Writer
+		dumpLevel    logiface.Level
+		dumpSignals  []os.Signal
+		errorHandler func(err error)
+	}
+)
+
+const (
+	// DefaultSize is the default number of events retained.
+	DefaultSize = 1024
+
+	// DefaultDumpLevel is the default level, at or above which, events will
+	// trigger an automatic dump. It includes the levels used by
+	// [logiface.Logger.Fatal], and [logiface.Logger.Panic].
+	DefaultDumpLevel = logiface.LevelAlert
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+func (x optionFunc) apply(c *recorderConfig) { x(c) }
+
+// WithSize configures the number of events retained, which defaults to
+// [DefaultSize]. Values less than 1 will be treated as 1.
+func WithSize(size int) Option {
+	return optionFunc(func(c *recorderConfig) {
+		c.size = size
+	})
+}
+
+// WithOutput configures the writer, used by automatic dumps, i.e. those
+// triggered by [WithDumpLevel], or [WithDumpSignal]. Defaults to os.Stderr.
+func WithOutput(w io.Writer) Option {
+	return optionFunc(func(c *recorderConfig) {
+		c.output = w
+	})
+}
+
+// WithDumpLevel configures the level, at or above which (i.e. more severe),
+// recording an event will cause all retained events to be dumped, to the
+// configured output, see also [WithOutput]. Defaults to [DefaultDumpLevel].
+// Custom levels never trigger a dump. Use [logiface.LevelDisabled] to
+// disable this behavior.
+//
+// The dump occurs within the call to the writer, meaning it completes prior
+// to [logiface.Logger.Fatal] exiting, or [logiface.Logger.Panic] panicking.
+func WithDumpLevel(level logiface.Level) Option {
+	return optionFunc(func(c *recorderConfig) {
+		c.dumpLevel = level
+	})
+}
+
+// WithDumpSignal configures the recorder to dump all retained events, to the
+// configured output, on receipt of any of the given signals. If no signals
+// are provided, SIGUSR1 will be used, on platforms that support it. See also
+// [WithOutput], and [Recorder.Close].
+func WithDumpSignal(signals ...os.Signal) Option {
+	return optionFunc(func(c *recorderConfig) {
+		if len(signals) == 0 {
+			signals = defaultDumpSignals
+		}
+		c.dumpSignals = append(c.dumpSignals, signals...)
+	})
+}
+
+// WithErrorHandler configures a function to be called with any errors that
+// occur while performing automatic dumps. Such errors are otherwise
+// discarded.
+func WithErrorHandler(handler func(err error)) Option {
+	return optionFunc(func(c *recorderConfig) {
+		c.errorHandler = handler
+	})
+}
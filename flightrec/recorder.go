@@ -0,0 +1,226 @@
+package flightrec
+
+import (
+	"github.com/joeycumines/logiface"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+type (
+	// Recorder retains snapshots of the most recent events, in a ring
+	// buffer. It implements [logiface.Writer], recording (but not forwarding)
+	// every event, see also [Recorder.Writer]. Recorder must be initialized
+	// using [NewRecorder], and is safe for concurrent use.
+	Recorder[E logiface.Event] struct {
+		snapshot  SnapshotFunc[E]
+		output    io.Writer
+		dumpLevel logiface.Level
+		onError   func(err error)
+
+		done      chan struct{}
+		closeOnce sync.Once
+		wg        sync.WaitGroup
+
+		mu      sync.Mutex
+		records []Record
+		next    int // index of the next record to be overwritten
+		count   int // number of valid records
+	}
+
+	// Record is a snapshot of an event, see also [Recorder.Records].
+	Record struct {
+		// Time is when the event was recorded.
+		Time time.Time
+		// Level is the level of the event.
+		Level logiface.Level
+		// Data is the encoded event, as returned by the [SnapshotFunc].
+		Data []byte
+	}
+
+	// SnapshotFunc appends an encoded copy of event to dst, returning the
+	// extended buffer. It must not retain or modify the event.
+	//
+	// For example, the AppendEvent method of jsonl.Logger.
+	SnapshotFunc[E logiface.Event] func(dst []byte, event E) []byte
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Writer[logiface.Event] = (*Recorder[logiface.Event])(nil)
+	_ io.Closer                       = (*Recorder[logiface.Event])(nil)
+)
+
+var (
+	timeNow = time.Now
+)
+
+// NewRecorder initializes a new [Recorder], using snapshot to encode each
+// event, as it is recorded. The snapshot function must be non-nil.
+//
+// By default, [DefaultSize] events will be retained, and events at or above
+// [DefaultDumpLevel] will trigger a dump, to os.Stderr.
+func NewRecorder[E logiface.Event](snapshot SnapshotFunc[E], options ...Option) *Recorder[E] {
+	if snapshot == nil {
+		panic(`flightrec: nil snapshot func`)
+	}
+
+	c := recorderConfig{
+		size:      DefaultSize,
+		output:    os.Stderr,
+		dumpLevel: DefaultDumpLevel,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	r := Recorder[E]{
+		snapshot:  snapshot,
+		output:    c.output,
+		dumpLevel: c.dumpLevel,
+		onError:   c.errorHandler,
+		done:      make(chan struct{}),
+		records:   make([]Record, max(c.size, 1)),
+	}
+
+	if len(c.dumpSignals) != 0 {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, c.dumpSignals...)
+		r.wg.Add(1)
+		go r.runSignals(ch)
+	}
+
+	return &r
+}
+
+// Write records a snapshot of the event, overwriting the oldest, if the
+// buffer is full, then dumps all retained events, if the event's level is
+// at or above the configured dump level, see also [WithDumpLevel].
+// It always returns nil.
+func (x *Recorder[E]) Write(event E) error {
+	level := event.Level()
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	r := &x.records[x.next]
+	r.Time = timeNow()
+	r.Level = level
+	r.Data = x.snapshot(r.Data[:0], event)
+	x.next = (x.next + 1) % len(x.records)
+	if x.count < len(x.records) {
+		x.count++
+	}
+
+	if level.Enabled() && !level.Custom() && level <= x.dumpLevel {
+		if err := x.dumpLocked(x.output); err != nil {
+			x.handleError(err)
+		}
+	}
+
+	return nil
+}
+
+// Writer returns a [logiface.Writer] that records every event, see
+// [Recorder.Write], then forwards it to next, if it would be logged at the
+// given level, i.e. if it is at or above the given level, or is a custom
+// level. Events that are not forwarded are considered written.
+//
+// This allows the logger to be configured to build every event (e.g. using
+// [logiface.LevelTrace]), while next receives only the events it would have,
+// had the logger been configured with the given level.
+func (x *Recorder[E]) Writer(next logiface.Writer[E], level logiface.Level) logiface.Writer[E] {
+	return logiface.NewWriterFunc(func(event E) error {
+		_ = x.Write(event)
+		if l := event.Level(); !l.Enabled() || (l > level && !l.Custom()) {
+			return nil
+		}
+		return next.Write(event)
+	})
+}
+
+// Records returns a copy of the retained events, oldest first.
+func (x *Recorder[E]) Records() []Record {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	records := make([]Record, 0, x.count)
+	x.each(func(r *Record) error {
+		records = append(records, Record{
+			Time:  r.Time,
+			Level: r.Level,
+			Data:  append([]byte(nil), r.Data...),
+		})
+		return nil
+	})
+	return records
+}
+
+// Dump writes the data of each retained event to w, oldest first, without
+// any framing, i.e. as returned by the [SnapshotFunc]. Logging (via the
+// recorder) will block until the dump completes. Retained events are not
+// cleared, see also [Recorder.Reset].
+func (x *Recorder[E]) Dump(w io.Writer) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.dumpLocked(w)
+}
+
+// Reset discards all retained events.
+func (x *Recorder[E]) Reset() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.next = 0
+	x.count = 0
+}
+
+// Close stops handling signals, see also [WithDumpSignal]. The recorder
+// remains usable. It always returns nil.
+func (x *Recorder[E]) Close() error {
+	x.closeOnce.Do(func() { close(x.done) })
+	x.wg.Wait()
+	return nil
+}
+
+func (x *Recorder[E]) dumpLocked(w io.Writer) error {
+	return x.each(func(r *Record) error {
+		_, err := w.Write(r.Data)
+		return err
+	})
+}
+
+func (x *Recorder[E]) each(f func(r *Record) error) error {
+	start := x.next - x.count
+	if start < 0 {
+		start += len(x.records)
+	}
+	for i := 0; i < x.count; i++ {
+		if err := f(&x.records[(start+i)%len(x.records)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *Recorder[E]) runSignals(ch chan os.Signal) {
+	defer x.wg.Done()
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-x.done:
+			return
+		case <-ch:
+			if err := x.Dump(x.output); err != nil {
+				x.handleError(err)
+			}
+		}
+	}
+}
+
+func (x *Recorder[E]) handleError(err error) {
+	if x.onError != nil {
+		x.onError(err)
+	}
+}
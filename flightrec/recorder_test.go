@@ -0,0 +1,225 @@
+package flightrec
+
+import (
+	"bytes"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/jsonl"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLogger(t *testing.T, output *bytes.Buffer, options ...Option) (*Recorder[*jsonl.Event], *logiface.Logger[*jsonl.Event]) {
+	t.Helper()
+	l := jsonl.NewLogger(jsonl.WithWriter(output))
+	r := NewRecorder(l.AppendEvent, options...)
+	t.Cleanup(func() { _ = r.Close() })
+	return r, jsonl.L.New(
+		jsonl.L.WithEventFactory(l),
+		jsonl.L.WithEventReleaser(l),
+		jsonl.L.WithWriter(r.Writer(l, logiface.LevelInformational)),
+		jsonl.L.WithLevel(logiface.LevelTrace),
+	)
+}
+
+func TestRecorder_Writer(t *testing.T) {
+	var output, dump bytes.Buffer
+	r, logger := newTestLogger(t, &output, WithSize(3), WithOutput(&dump))
+
+	logger.Trace().Log(`1`)
+	logger.Info().Log(`2`)
+	logger.Debug().Log(`3`)
+	logger.Build(9).Log(`4`)
+	logger.Warning().Log(`5`)
+
+	if s := output.String(); s != `{"lvl":"info","msg":"2"}
+{"lvl":"9","msg":"4"}
+{"lvl":"warning","msg":"5"}
+` {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+
+	if err := r.Dump(&dump); err != nil {
+		t.Fatal(err)
+	}
+	if s := dump.String(); s != `{"lvl":"debug","msg":"3"}
+{"lvl":"9","msg":"4"}
+{"lvl":"warning","msg":"5"}
+` {
+		t.Errorf("unexpected dump: %q\n%s", s, s)
+	}
+
+	records := r.Records()
+	if len(records) != 3 {
+		t.Fatal(len(records))
+	}
+	for i, level := range []logiface.Level{logiface.LevelDebug, 9, logiface.LevelWarning} {
+		if records[i].Level != level {
+			t.Errorf("unexpected level at %d: %s", i, records[i].Level)
+		}
+		if records[i].Time.IsZero() {
+			t.Errorf("unexpected zero time at %d", i)
+		}
+	}
+
+	// the records must be copies
+	records[0].Data[0] = 'x'
+	logger.Info().Log(`6`)
+	if s := string(records[2].Data); s != `{"lvl":"warning","msg":"5"}`+"\n" {
+		t.Errorf("unexpected record: %q", s)
+	}
+
+	r.Reset()
+	if records := r.Records(); len(records) != 0 {
+		t.Errorf("unexpected records: %d", len(records))
+	}
+	dump.Reset()
+	logger.Trace().Log(`7`)
+	if err := r.Dump(&dump); err != nil {
+		t.Fatal(err)
+	}
+	if s := dump.String(); s != `{"lvl":"trace","msg":"7"}`+"\n" {
+		t.Errorf("unexpected dump: %q\n%s", s, s)
+	}
+}
+
+func TestRecorder_Write_snapshot(t *testing.T) {
+	l := jsonl.NewLogger(jsonl.WithWriter(new(bytes.Buffer)))
+	r := NewRecorder(l.AppendEvent, WithSize(0))
+	logger := jsonl.L.New(
+		jsonl.L.WithEventFactory(l),
+		jsonl.L.WithEventReleaser(l),
+		jsonl.L.WithWriter(r),
+	)
+	// the event is released (and pooled) after each write
+	logger.Info().Str(`a`, strings.Repeat(`b`, 100)).Log(``)
+	logger.Info().Str(`c`, `d`).Log(``)
+	var b bytes.Buffer
+	if err := r.Dump(&b); err != nil {
+		t.Fatal(err)
+	}
+	if s := b.String(); s != `{"lvl":"info","c":"d"}`+"\n" {
+		t.Errorf("unexpected dump: %q", s)
+	}
+}
+
+func TestRecorder_fatal(t *testing.T) {
+	old := logiface.OsExit
+	defer func() { logiface.OsExit = old }()
+
+	var output, dump bytes.Buffer
+	_, logger := newTestLogger(t, &output, WithOutput(&dump))
+
+	var exited bool
+	logiface.OsExit = func(code int) {
+		if code != 1 {
+			t.Errorf("unexpected code: %d", code)
+		}
+		if s := dump.String(); s != `{"lvl":"debug","msg":"a"}
+{"lvl":"alert","msg":"b"}
+` {
+			t.Errorf("unexpected dump: %q\n%s", s, s)
+		}
+		exited = true
+	}
+
+	logger.Debug().Log(`a`)
+	if dump.Len() != 0 {
+		t.Fatal(dump.String())
+	}
+	logger.Fatal().Log(`b`)
+	if !exited {
+		t.Fatal(`expected exit`)
+	}
+	if s := output.String(); s != `{"lvl":"alert","msg":"b"}`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestRecorder_panic(t *testing.T) {
+	var output, dump bytes.Buffer
+	_, logger := newTestLogger(t, &output, WithOutput(&dump))
+
+	logger.Trace().Log(`a`)
+	func() {
+		defer func() {
+			if r := recover(); r != `b` {
+				t.Errorf("unexpected recover: %v", r)
+			}
+		}()
+		logger.Panic().Log(`b`)
+	}()
+	if s := dump.String(); s != `{"lvl":"trace","msg":"a"}
+{"lvl":"emerg","msg":"b"}
+` {
+		t.Errorf("unexpected dump: %q\n%s", s, s)
+	}
+}
+
+func TestWithDumpLevel(t *testing.T) {
+	var output, dump bytes.Buffer
+	_, logger := newTestLogger(t, &output, WithOutput(&dump), WithDumpLevel(logiface.LevelError))
+	logger.Trace().Log(`a`)
+	logger.Build(9).Log(`b`)
+	logger.Warning().Log(`c`)
+	if dump.Len() != 0 {
+		t.Fatal(dump.String())
+	}
+	logger.Err().Log(`d`)
+	if s := dump.String(); s != `{"lvl":"trace","msg":"a"}
+{"lvl":"9","msg":"b"}
+{"lvl":"warning","msg":"c"}
+{"lvl":"err","msg":"d"}
+` {
+		t.Errorf("unexpected dump: %q\n%s", s, s)
+	}
+
+	dump.Reset()
+	_, logger = newTestLogger(t, &output, WithOutput(&dump), WithDumpLevel(logiface.LevelDisabled))
+	logger.Emerg().Log(`a`)
+	if dump.Len() != 0 {
+		t.Fatal(dump.String())
+	}
+}
+
+func TestWithErrorHandler(t *testing.T) {
+	e := errors.New(`some error`)
+	var errs []error
+	var output bytes.Buffer
+	_, logger := newTestLogger(t, &output,
+		WithOutput(writerFunc(func(p []byte) (int, error) { return 0, e })),
+		WithErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+	logger.Info().Log(`a`)
+	logger.Alert().Log(`b`)
+	logger.Emerg().Log(`c`)
+	if len(errs) != 2 || errs[0] != e || errs[1] != e {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (x writerFunc) Write(p []byte) (int, error) { return x(p) }
+
+// syncBuffer is used where a dump occurs in the background
+type syncBuffer struct {
+	ch chan string
+}
+
+func (x *syncBuffer) Write(p []byte) (int, error) {
+	x.ch <- string(p)
+	return len(p), nil
+}
+
+func (x *syncBuffer) next(t *testing.T) string {
+	t.Helper()
+	select {
+	case s := <-x.ch:
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal(`timed out`)
+		panic(`unreachable`)
+	}
+}
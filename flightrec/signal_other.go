@@ -0,0 +1,9 @@
+//go:build !unix
+
+package flightrec
+
+import (
+	"os"
+)
+
+var defaultDumpSignals []os.Signal
@@ -0,0 +1,32 @@
+//go:build unix
+
+package flightrec
+
+import (
+	"bytes"
+	"syscall"
+	"testing"
+)
+
+func TestWithDumpSignal(t *testing.T) {
+	dump := syncBuffer{ch: make(chan string)}
+	var output bytes.Buffer
+	r, logger := newTestLogger(t, &output, WithOutput(&dump), WithDumpSignal(syscall.SIGUSR2))
+
+	logger.Debug().Log(`a`)
+	logger.Info().Log(`b`)
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR2); err != nil {
+		t.Fatal(err)
+	}
+	if s := dump.next(t); s != `{"lvl":"debug","msg":"a"}`+"\n" {
+		t.Errorf("unexpected dump: %q", s)
+	}
+	if s := dump.next(t); s != `{"lvl":"info","msg":"b"}`+"\n" {
+		t.Errorf("unexpected dump: %q", s)
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
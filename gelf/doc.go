@@ -0,0 +1,24 @@
+// Package gelf implements a logiface logger, which writes events to Graylog
+// (or any compatible collector), per the GELF 1.1 specification, without any
+// dependencies outside this module.
+//
+// The level is mapped to the GELF level (the syslog severity, with levels
+// above debug mapped to debug), and the message to short_message, unless it
+// spans multiple lines, in which case short_message is the first line, and
+// full_message is the entire message. If there is no message, short_message
+// will be "-", as it is required.
+//
+// Fields are written as additional fields, i.e. prefixed by `_`, with any
+// characters other than letters, digits, underscores, periods, and hyphens
+// replaced by underscores, and _id renamed to __id. As GELF only supports
+// string and number values, nested objects are flattened, joining keys with
+// `_`, while array elements are keyed by their (zero-based) index, e.g. the
+// object {"a":{"b":[1,{"c":2}]}} is written as "_a_b_0":1,"_a_b_1_c":2. Empty
+// objects and arrays, and nil errors, are omitted. Booleans, times,
+// durations, bytes (base64), raw JSON, and any values that are not strings or
+// numbers, are written as strings.
+//
+// Supported transports are UDP, with optional gzip or zlib compression, and
+// chunking of large messages, and TCP, with each message terminated by a null
+// byte, see [WithDial].
+package gelf
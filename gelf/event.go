@@ -0,0 +1,265 @@
+package gelf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		buf []byte
+		// group is the (encoded) key prefix, see AddGroup
+		group []byte
+		msg   string
+		lvl   logiface.Level
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Bytes returns the current buffer, for the event.
+// It may be used, for example, to customise the writer.
+// Always missing the message, and the final `}`.
+func (x *Event) Bytes() []byte {
+	return x.buf
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	if val == nil {
+		return
+	}
+	x.appendKey(key)
+	x.buf = appendInterface(x.buf, val)
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	x.msg = msg
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.appendKey(`err`)
+		x.buf = appendError(x.buf, err)
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendKey(key)
+	x.buf = jsonenc.AppendString(x.buf, val)
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendInt(x.buf, int64(val), 10)
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendKey(key)
+	x.buf = jsonenc.AppendFloat32(x.buf, val)
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendKey(key)
+	x.buf = appendTime(x.buf, val)
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendKey(key)
+	x.buf = appendDuration(x.buf, val)
+	return true
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendKey(key)
+	x.buf = appendBase64Bytes(x.buf, val, enc)
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendKey(key)
+	x.buf = appendBool(x.buf, val)
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendKey(key)
+	x.buf = jsonenc.AppendFloat64(x.buf, val)
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendInt(x.buf, val, 10)
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendUint(x.buf, val, 10)
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.appendKey(key)
+	x.buf = appendRawJSON(x.buf, val)
+	return true
+}
+
+// AddGroup prefixes the keys of all subsequent fields with the group name,
+// and an underscore.
+func (x *Event) AddGroup(name string) bool {
+	x.group = appendKeyContent(x.group, name)
+	x.group = append(x.group, '_')
+	return true
+}
+
+func (x *Event) addFields(key string, val *Fields) {
+	for _, f := range val.fields {
+		x.buf = append(x.buf, `,"_`...)
+		x.buf = append(x.buf, x.group...)
+		x.buf = appendKeyContent(x.buf, key)
+		x.buf = append(x.buf, '_')
+		x.buf = appendKeyContent(x.buf, f.key)
+		x.buf = append(x.buf, '"', ':')
+		x.buf = append(x.buf, val.buf[f.start:f.end]...)
+	}
+}
+
+func (x *Event) appendKey(key string) {
+	x.buf = append(x.buf, `,"_`...)
+	if len(x.group) == 0 && key == `id` {
+		// _id is reserved
+		x.buf = append(x.buf, '_')
+	}
+	x.buf = append(x.buf, x.group...)
+	x.buf = appendKeyContent(x.buf, key)
+	x.buf = append(x.buf, '"', ':')
+}
+
+// appendMessage appends the short_message, and (if necessary) full_message
+// fields.
+func (x *Event) appendMessage() {
+	x.buf = append(x.buf, `,"short_message":`...)
+	switch i := strings.IndexByte(x.msg, '\n'); {
+	case x.msg == ``:
+		x.buf = append(x.buf, `"-"`...)
+	case i == -1:
+		x.buf = jsonenc.AppendString(x.buf, x.msg)
+	default:
+		x.buf = jsonenc.AppendString(x.buf, x.msg[:i])
+		x.buf = append(x.buf, `,"full_message":`...)
+		x.buf = jsonenc.AppendString(x.buf, x.msg)
+	}
+}
+
+// appendKeyContent appends key, replacing invalid characters with `_`.
+func appendKeyContent(dst []byte, key string) []byte {
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == '-':
+			dst = append(dst, c)
+		default:
+			dst = append(dst, '_')
+		}
+	}
+	return dst
+}
+
+func appendError(dst []byte, err error) []byte {
+	// this seems sensible, even if it's inefficient
+	return jsonenc.AppendString(dst, fmt.Sprint(err))
+}
+
+func appendBool(dst []byte, val bool) []byte {
+	if val {
+		return append(dst, `"true"`...)
+	}
+	return append(dst, `"false"`...)
+}
+
+func appendTime(dst []byte, val time.Time) []byte {
+	dst = append(dst, '"')
+	dst = pbtime.AppendTimestamp(dst, val)
+	return append(dst, '"')
+}
+
+func appendDuration(dst []byte, val time.Duration) []byte {
+	dst = append(dst, '"')
+	dst = pbtime.AppendDuration(dst, val)
+	return append(dst, '"')
+}
+
+func appendBase64Bytes(dst []byte, val []byte, enc *base64.Encoding) []byte {
+	dst = append(dst, '"')
+	dst = enc.AppendEncode(dst, val)
+	return append(dst, '"')
+}
+
+func appendRawJSON(dst []byte, val json.RawMessage) []byte {
+	if len(val) == 0 {
+		return append(dst, `"null"`...)
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, val); err != nil {
+		return jsonenc.AppendString(dst, string(val))
+	}
+	return jsonenc.AppendString(dst, buf.String())
+}
+
+func appendInterface(dst []byte, val any) []byte {
+	switch val := val.(type) {
+	case string:
+		return jsonenc.AppendString(dst, val)
+	case error:
+		return appendError(dst, val)
+	case bool:
+		return appendBool(dst, val)
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return jsonenc.AppendString(dst, fmt.Sprintf("marshaling error: %v", err))
+	}
+	if len(b) != 0 && (b[0] == '"' || b[0] == '-' || (b[0] >= '0' && b[0] <= '9')) {
+		// strings and numbers are supported
+		return append(dst, b...)
+	}
+	return jsonenc.AppendString(dst, string(b))
+}
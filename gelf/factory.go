@@ -0,0 +1,176 @@
+package gelf
+
+import (
+	"github.com/joeycumines/logiface"
+	"os"
+	"time"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		network     string
+		address     string
+		host        *string
+		compression Compression
+		chunkSize   int
+		timeout     time.Duration
+	}
+
+	// Compression is the compression algorithm used for UDP, see
+	// [WithCompression].
+	Compression int
+)
+
+const (
+	// CompressionNone disables compression, and is the default.
+	CompressionNone Compression = iota
+	// CompressionGzip compresses each message using gzip.
+	CompressionGzip
+	// CompressionZlib compresses each message using zlib.
+	CompressionZlib
+)
+
+const (
+	// DefaultAddress is the default address, of the GELF input.
+	DefaultAddress = `127.0.0.1:12201`
+
+	// DefaultChunkSize is the default maximum size of each UDP datagram, and
+	// is suitable for most networks.
+	DefaultChunkSize = 1420
+
+	// DefaultTimeout is the default timeout for dialing, and writing.
+	DefaultTimeout = 10 * time.Second
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithGELF configures a logiface logger to write GELF, using the
+// implementations provided by this package.
+//
+// By default, events are sent, uncompressed, via UDP, to [DefaultAddress].
+func WithGELF(options ...Option) logiface.Option[*Event] {
+	l := NewLogger(options...)
+	return L.WithOptions(
+		L.WithWriter(l),
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+		logiface.WithJSONSupport[*Event, *Fields, *Fields](l),
+	)
+}
+
+// WithGELF is an alias of the package function of the same name.
+func (LoggerFactory) WithGELF(options ...Option) logiface.Option[*Event] {
+	return WithGELF(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.EventReleaser], [logiface.Writer], and
+// [logiface.JSONSupport] interfaces. Most users should prefer [WithGELF].
+//
+// The connection will be established on first write, see also [Logger.Close].
+func NewLogger(options ...Option) *Logger {
+	c := loggerConfig{
+		network:   `udp`,
+		address:   DefaultAddress,
+		chunkSize: DefaultChunkSize,
+		timeout:   DefaultTimeout,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{
+		network:     c.network,
+		address:     c.address,
+		compression: c.compression,
+		chunkSize:   max(c.chunkSize, chunkHeaderSize+1),
+		timeout:     c.timeout,
+	}
+
+	if c.host != nil {
+		l.host = *c.host
+	} else {
+		l.host, _ = os.Hostname()
+	}
+
+	switch l.network {
+	case `tcp`, `tcp4`, `tcp6`:
+		l.stream = true
+	}
+
+	return &l
+}
+
+// WithDial configures the network and address of the GELF input, as
+// accepted by [net.Dial]. Supported networks are "udp", "udp4", "udp6",
+// "tcp", "tcp4", and "tcp6". Defaults to "udp", and [DefaultAddress].
+func WithDial(network, address string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.network = network
+		c.address = address
+	})
+}
+
+// WithHost configures the host field, which defaults to [os.Hostname].
+func WithHost(host string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.host = &host
+	})
+}
+
+// WithCompression configures the compression, which defaults to
+// [CompressionNone]. Ignored for TCP, which doesn't support compression.
+func WithCompression(compression Compression) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.compression = compression
+	})
+}
+
+// WithChunkSize configures the maximum size of each UDP datagram, which
+// defaults to [DefaultChunkSize]. Messages larger than this will be split
+// into (at most 128) chunks.
+func WithChunkSize(size int) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.chunkSize = size
+	})
+}
+
+// WithTimeout configures the timeout for dialing, and each write, which
+// defaults to [DefaultTimeout]. A value <= 0 disables the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.timeout = timeout
+	})
+}
@@ -0,0 +1,412 @@
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser],
+	// [logiface.Writer], and [logiface.JSONSupport], for [Event].
+	// See also [NewLogger], and [WithGELF].
+	Logger struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedJSONSupport
+
+		network     string
+		address     string
+		host        string
+		compression Compression
+		chunkSize   int
+		timeout     time.Duration
+		stream      bool
+
+		mu    sync.Mutex
+		conn  net.Conn
+		buf   bytes.Buffer
+		gzip  *gzip.Writer
+		zlib  *zlib.Writer
+		chunk []byte
+	}
+
+	// Fields is the [logiface.JSONSupport] object and array type, for
+	// [Event], and holds the flattened fields of a nested object or array.
+	// See also the package documentation.
+	Fields struct {
+		buf    []byte
+		fields []field
+		// n is the number of elements, for arrays
+		n int
+	}
+
+	// field is a flattened field, with the value stored in Fields.buf
+	field struct {
+		key        string
+		start, end int
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedJSONSupport = logiface.UnimplementedJSONSupport[*Event, *Fields, *Fields]
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]                  = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event]                 = (*Logger)(nil)
+	_ logiface.Writer[*Event]                        = (*Logger)(nil)
+	_ logiface.JSONSupport[*Event, *Fields, *Fields] = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{
+			buf: make([]byte, 0, 1<<10),
+		}
+	}}
+	timeNow = time.Now
+)
+
+func (x *Logger) NewEvent(level logiface.Level) *Event {
+	e := eventPool.Get().(*Event)
+	e.lvl = level
+	e.msg = ``
+	// note: buf and group aren't reset when added back to the pool
+	e.group = e.group[:0]
+	e.buf = append(e.buf[:0], `{"version":"1.1","host":`...)
+	e.buf = jsonenc.AppendString(e.buf, x.host)
+	e.buf = append(e.buf, `,"timestamp":`...)
+	e.buf = appendTimestamp(e.buf, timeNow())
+	e.buf = append(e.buf, `,"level":`...)
+	e.buf = strconv.AppendInt(e.buf, int64(severity(level)), 10)
+	return e
+}
+
+// Write sends the event, establishing the connection, if necessary. If the
+// write fails, the connection will be re-established, and the write retried,
+// once. Note that, as with any network logger, a successful write does not
+// guarantee delivery.
+func (x *Logger) Write(event *Event) error {
+	n := len(event.buf)
+	event.appendMessage()
+	event.buf = append(event.buf, '}')
+	defer func() { event.buf = event.buf[:n] }()
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	err := x.write(event.buf)
+	if err != nil && err != ErrTooLarge {
+		err = x.write(event.buf)
+	}
+	return err
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.buf) <= 1<<16 && cap(e.group) <= 1<<10 {
+		// clear references that might need to be garbage collected
+		e.msg = ``
+
+		eventPool.Put(e)
+	}
+}
+
+// Close closes the current connection, if any. The logger may still be
+// used, and will reconnect, on the next write.
+func (x *Logger) Close() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.conn == nil {
+		return nil
+	}
+	err := x.conn.Close()
+	x.conn = nil
+	return err
+}
+
+func (x *Logger) NewObject() *Fields {
+	return new(Fields)
+}
+
+func (x *Logger) AddObject(evt *Event, key string, obj *Fields) {
+	evt.addFields(key, obj)
+}
+
+func (x *Logger) CanSetObject() bool { return true }
+
+func (x *Logger) SetObject(obj *Fields, key string, val *Fields) *Fields {
+	obj.setFields(key, val)
+	return obj
+}
+
+func (x *Logger) CanSetArray() bool { return true }
+
+func (x *Logger) SetArray(obj *Fields, key string, val *Fields) *Fields {
+	obj.setFields(key, val)
+	return obj
+}
+
+func (x *Logger) NewArray() *Fields {
+	return new(Fields)
+}
+
+func (x *Logger) AddArray(evt *Event, key string, arr *Fields) {
+	evt.addFields(key, arr)
+}
+
+func (x *Logger) CanAppendObject() bool { return true }
+
+func (x *Logger) AppendObject(arr *Fields, val *Fields) *Fields {
+	arr.setFields(arr.nextIndex(), val)
+	return arr
+}
+
+func (x *Logger) CanAppendArray() bool { return true }
+
+func (x *Logger) AppendArray(arr *Fields, val *Fields) *Fields {
+	arr.setFields(arr.nextIndex(), val)
+	return arr
+}
+
+func (x *Logger) SetField(obj *Fields, key string, val any) *Fields {
+	if val != nil {
+		obj.set(key, appendInterface(obj.buf, val))
+	}
+	return obj
+}
+
+func (x *Logger) AppendField(arr *Fields, val any) *Fields {
+	key := arr.nextIndex()
+	if val != nil {
+		arr.set(key, appendInterface(arr.buf, val))
+	}
+	return arr
+}
+
+func (x *Logger) CanSetError() bool { return true }
+
+func (x *Logger) SetError(obj *Fields, err error) *Fields {
+	if err != nil {
+		obj.set(`err`, appendError(obj.buf, err))
+	}
+	return obj
+}
+
+func (x *Logger) CanAppendError() bool { return true }
+
+func (x *Logger) AppendError(arr *Fields, err error) *Fields {
+	key := arr.nextIndex()
+	if err != nil {
+		arr.set(key, appendError(arr.buf, err))
+	}
+	return arr
+}
+
+func (x *Logger) CanSetString() bool { return true }
+
+func (x *Logger) SetString(obj *Fields, key string, val string) *Fields {
+	obj.set(key, jsonenc.AppendString(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendString() bool { return true }
+
+func (x *Logger) AppendString(arr *Fields, val string) *Fields {
+	arr.set(arr.nextIndex(), jsonenc.AppendString(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetInt() bool { return true }
+
+func (x *Logger) SetInt(obj *Fields, key string, val int) *Fields {
+	obj.set(key, strconv.AppendInt(obj.buf, int64(val), 10))
+	return obj
+}
+
+func (x *Logger) CanAppendInt() bool { return true }
+
+func (x *Logger) AppendInt(arr *Fields, val int) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendInt(arr.buf, int64(val), 10))
+	return arr
+}
+
+func (x *Logger) CanSetFloat32() bool { return true }
+
+func (x *Logger) SetFloat32(obj *Fields, key string, val float32) *Fields {
+	obj.set(key, jsonenc.AppendFloat32(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendFloat32() bool { return true }
+
+func (x *Logger) AppendFloat32(arr *Fields, val float32) *Fields {
+	arr.set(arr.nextIndex(), jsonenc.AppendFloat32(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetTime() bool { return true }
+
+func (x *Logger) SetTime(obj *Fields, key string, t time.Time) *Fields {
+	obj.set(key, appendTime(obj.buf, t))
+	return obj
+}
+
+func (x *Logger) CanAppendTime() bool { return true }
+
+func (x *Logger) AppendTime(arr *Fields, t time.Time) *Fields {
+	arr.set(arr.nextIndex(), appendTime(arr.buf, t))
+	return arr
+}
+
+func (x *Logger) CanSetDuration() bool { return true }
+
+func (x *Logger) SetDuration(obj *Fields, key string, d time.Duration) *Fields {
+	obj.set(key, appendDuration(obj.buf, d))
+	return obj
+}
+
+func (x *Logger) CanAppendDuration() bool { return true }
+
+func (x *Logger) AppendDuration(arr *Fields, d time.Duration) *Fields {
+	arr.set(arr.nextIndex(), appendDuration(arr.buf, d))
+	return arr
+}
+
+func (x *Logger) CanSetBase64Bytes() bool { return true }
+
+func (x *Logger) SetBase64Bytes(obj *Fields, key string, b []byte, enc *base64.Encoding) *Fields {
+	obj.set(key, appendBase64Bytes(obj.buf, b, enc))
+	return obj
+}
+
+func (x *Logger) CanAppendBase64Bytes() bool { return true }
+
+func (x *Logger) AppendBase64Bytes(arr *Fields, b []byte, enc *base64.Encoding) *Fields {
+	arr.set(arr.nextIndex(), appendBase64Bytes(arr.buf, b, enc))
+	return arr
+}
+
+func (x *Logger) CanSetBool() bool { return true }
+
+func (x *Logger) SetBool(obj *Fields, key string, val bool) *Fields {
+	obj.set(key, appendBool(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendBool() bool { return true }
+
+func (x *Logger) AppendBool(arr *Fields, val bool) *Fields {
+	arr.set(arr.nextIndex(), appendBool(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetFloat64() bool { return true }
+
+func (x *Logger) SetFloat64(obj *Fields, key string, val float64) *Fields {
+	obj.set(key, jsonenc.AppendFloat64(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendFloat64() bool { return true }
+
+func (x *Logger) AppendFloat64(arr *Fields, val float64) *Fields {
+	arr.set(arr.nextIndex(), jsonenc.AppendFloat64(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetInt64() bool { return true }
+
+func (x *Logger) SetInt64(obj *Fields, key string, val int64) *Fields {
+	obj.set(key, strconv.AppendInt(obj.buf, val, 10))
+	return obj
+}
+
+func (x *Logger) CanAppendInt64() bool { return true }
+
+func (x *Logger) AppendInt64(arr *Fields, val int64) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendInt(arr.buf, val, 10))
+	return arr
+}
+
+func (x *Logger) CanSetUint64() bool { return true }
+
+func (x *Logger) SetUint64(obj *Fields, key string, val uint64) *Fields {
+	obj.set(key, strconv.AppendUint(obj.buf, val, 10))
+	return obj
+}
+
+func (x *Logger) CanAppendUint64() bool { return true }
+
+func (x *Logger) AppendUint64(arr *Fields, val uint64) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendUint(arr.buf, val, 10))
+	return arr
+}
+
+func (x *Logger) CanSetRawJSON() bool { return true }
+
+func (x *Logger) SetRawJSON(obj *Fields, key string, b json.RawMessage) *Fields {
+	obj.set(key, appendRawJSON(obj.buf, b))
+	return obj
+}
+
+func (x *Logger) CanAppendRawJSON() bool { return true }
+
+func (x *Logger) AppendRawJSON(arr *Fields, b json.RawMessage) *Fields {
+	arr.set(arr.nextIndex(), appendRawJSON(arr.buf, b))
+	return arr
+}
+
+// set adds a field, the value of which must have been appended to x.buf.
+func (x *Fields) set(key string, buf []byte) {
+	x.fields = append(x.fields, field{key: key, start: len(x.buf), end: len(buf)})
+	x.buf = buf
+}
+
+// setFields flattens val into x, prefixing each key.
+func (x *Fields) setFields(key string, val *Fields) {
+	for _, f := range val.fields {
+		x.set(key+`_`+f.key, append(x.buf, val.buf[f.start:f.end]...))
+	}
+}
+
+func (x *Fields) nextIndex() string {
+	key := strconv.Itoa(x.n)
+	x.n++
+	return key
+}
+
+// appendTimestamp appends the time as seconds since the UNIX epoch, with
+// microsecond precision.
+func appendTimestamp(dst []byte, t time.Time) []byte {
+	us := t.UnixMicro()
+	if us < 0 {
+		dst = append(dst, '-')
+		us = -us
+	}
+	dst = strconv.AppendInt(dst, us/1e6, 10)
+	dst = append(dst, '.')
+	frac := us % 1e6
+	for d := int64(1e5); d > 1 && frac < d; d /= 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, frac, 10)
+}
+
+// severity maps the logiface level to the syslog severity, which is the
+// same value, except for levels above debug.
+func severity(level logiface.Level) int {
+	if level > logiface.LevelDebug {
+		return int(logiface.LevelDebug)
+	}
+	return int(level)
+}
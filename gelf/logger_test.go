@@ -0,0 +1,272 @@
+package gelf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"io"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fixedTime(t *testing.T) {
+	old := timeNow
+	t.Cleanup(func() { timeNow = old })
+	timeNow = func() time.Time { return time.Unix(1680693679, 496235772) }
+}
+
+func listenUDP(t *testing.T) (net.PacketConn, func() []byte) {
+	t.Helper()
+	conn, err := net.ListenPacket(`udp`, `127.0.0.1:0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn, func() []byte {
+		t.Helper()
+		buf := make([]byte, 1<<16)
+		if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf[:n]
+	}
+}
+
+func TestLogger_fieldTypes(t *testing.T) {
+	fixedTime(t)
+	conn, read := listenUDP(t)
+
+	logger := L.New(
+		L.WithGELF(WithDial(`udp`, conn.LocalAddr().String()), WithHost(`my-host`)),
+		L.WithLevel(logiface.LevelTrace),
+	)
+
+	for _, tc := range [...]struct {
+		Name   string
+		Log    func(l *logiface.Logger[*Event])
+		Output string
+	}{
+		{
+			Name:   `message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Log(`some message`) },
+			Output: `{"version":"1.1","host":"my-host","timestamp":1680693679.496235,"level":6,"short_message":"some message"}`,
+		},
+		{
+			Name:   `multi-line message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Trace().Log("line 1\nline 2") },
+			Output: `{"version":"1.1","host":"my-host","timestamp":1680693679.496235,"level":7,"short_message":"line 1","full_message":"line 1\nline 2"}`,
+		},
+		{
+			Name:   `no message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Emerg().Log(``) },
+			Output: `{"version":"1.1","host":"my-host","timestamp":1680693679.496235,"level":0,"short_message":"-"}`,
+		},
+		{
+			Name: `scalars`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Warning().
+					Str(`str`, `value`).
+					Int(`int`, -1).
+					Int64(`int64`, math.MinInt64).
+					Uint64(`uint64`, math.MaxUint64).
+					Float32(`float32`, 1.5).
+					Float64(`float64`, math.NaN()).
+					Bool(`bool`, true).
+					Dur(`dur`, time.Second*3/2).
+					Time(`time`, time.Unix(0, 0)).
+					Base64(`b64`, []byte(`val 7`), nil).
+					RawJSON(`raw`, json.RawMessage(`{"a": 1}`)).
+					Any(`any`, map[string]int{`k`: 1}).
+					Any(`num`, 1.25).
+					Any(`nil`, nil).
+					Err(errors.New(`some error`)).
+					Str(`id`, `reserved`).
+					Str(`bad key!`, `x`).
+					Log(`msg`)
+			},
+			Output: `{"version":"1.1","host":"my-host","timestamp":1680693679.496235,"level":4,"_str":"value","_int":-1,` +
+				`"_int64":-9223372036854775808,"_uint64":18446744073709551615,"_float32":1.5,"_float64":"NaN","_bool":"true",` +
+				`"_dur":"1.500s","_time":"1970-01-01T00:00:00Z","_b64":"dmFsIDc=","_raw":"{\"a\":1}","_any":"{\"k\":1}",` +
+				`"_num":1.25,"_err":"some error","__id":"reserved","_bad_key_":"x","short_message":"msg"}`,
+		},
+		{
+			Name: `flattening`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Err().
+					Object().
+					Array().Str(`a`).Object().Int(`b`, 1).Add().Array().Add().CurArray().Err(nil).Array().Bool(true).Add().As(`c`).
+					Object().As(`d`).
+					Object().Dur(`f`, time.Second).Err(errors.New(`e`)).As(`e`).CurObject().
+					As(`obj`).End().
+					Group(`grp`).
+					Str(`id`, `not reserved`).
+					Log(`msg`)
+			},
+			Output: `{"version":"1.1","host":"my-host","timestamp":1680693679.496235,"level":3,"_obj_c_0":"a","_obj_c_1_b":1,` +
+				`"_obj_c_4_0":"true","_obj_e_f":"1s","_obj_e_err":"e","_grp_id":"not reserved","short_message":"msg"}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Log(logger)
+			b := read()
+			if s := string(b); s != tc.Output {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+			var v map[string]any
+			if err := json.Unmarshal(b, &v); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLogger_compression(t *testing.T) {
+	fixedTime(t)
+	for _, tc := range [...]struct {
+		Name        string
+		Compression Compression
+		Reader      func(r io.Reader) (io.Reader, error)
+	}{
+		{`gzip`, CompressionGzip, func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) }},
+		{`zlib`, CompressionZlib, func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) }},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			conn, read := listenUDP(t)
+			logger := L.New(L.WithGELF(
+				WithDial(`udp`, conn.LocalAddr().String()),
+				WithHost(`h`),
+				WithCompression(tc.Compression),
+			))
+			for range 2 {
+				logger.Info().Str(`k`, `v`).Log(`msg`)
+				r, err := tc.Reader(bytes.NewReader(read()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := io.ReadAll(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if s := string(b); s != `{"version":"1.1","host":"h","timestamp":1680693679.496235,"level":6,"_k":"v","short_message":"msg"}` {
+					t.Errorf("unexpected output: %q", s)
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_chunking(t *testing.T) {
+	fixedTime(t)
+	conn, read := listenUDP(t)
+	logger := L.New(L.WithGELF(
+		WithDial(`udp`, conn.LocalAddr().String()),
+		WithHost(`h`),
+		WithChunkSize(100),
+	))
+
+	msg := strings.Repeat(`x`, 1000)
+	logger.Info().Log(msg)
+	expected := `{"version":"1.1","host":"h","timestamp":1680693679.496235,"level":6,"short_message":"` + msg + `"}`
+
+	count := (len(expected) + 87) / 88
+	chunks := make([][]byte, count)
+	var id uint64
+	for i := range count {
+		b := read()
+		if len(b) > 100 || b[0] != 0x1e || b[1] != 0x0f {
+			t.Fatalf(`invalid chunk: %q`, b)
+		}
+		if i == 0 {
+			id = binary.BigEndian.Uint64(b[2:])
+		} else if v := binary.BigEndian.Uint64(b[2:]); v != id {
+			t.Fatal(`unexpected message id`, v, id)
+		}
+		if int(b[11]) != count {
+			t.Fatal(`unexpected count`, b[11])
+		}
+		chunks[b[10]] = b[12:]
+	}
+	if s := string(bytes.Join(chunks, nil)); s != expected {
+		t.Errorf("unexpected output: %q", s)
+	}
+
+	l := NewLogger(WithDial(`udp`, conn.LocalAddr().String()), WithChunkSize(13))
+	e := l.NewEvent(logiface.LevelInformational)
+	e.AddMessage(msg)
+	if err := l.Write(e); err != ErrTooLarge {
+		t.Error(err)
+	}
+}
+
+func TestLogger_tcp(t *testing.T) {
+	fixedTime(t)
+
+	ln, err := net.Listen(`tcp`, `127.0.0.1:0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	logger := L.New(L.WithGELF(
+		WithDial(`tcp`, ln.Addr().String()),
+		WithHost(`h`),
+		WithCompression(CompressionGzip), // ignored
+	))
+	logger.Info().Log(`one`)
+	logger.Info().Log(`two`)
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for _, msg := range [...]string{`one`, `two`} {
+		b, err := r.ReadBytes(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := string(b); s != `{"version":"1.1","host":"h","timestamp":1680693679.496235,"level":6,"short_message":"`+msg+`"}`+"\x00" {
+			t.Errorf("unexpected output: %q", s)
+		}
+	}
+}
+
+func TestLogger_Write_dialError(t *testing.T) {
+	l := NewLogger(WithDial(`tcp`, `127.0.0.1:0`), WithTimeout(0))
+	logger := L.New(L.WithWriter(l), L.WithEventFactory(l), L.WithEventReleaser(l))
+	if err := logger.Log(logiface.LevelError, nil); err == nil {
+		t.Error(`expected error`)
+	}
+	if err := l.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAppendTimestamp(t *testing.T) {
+	for _, tc := range [...]struct {
+		Time   time.Time
+		Output string
+	}{
+		{time.Unix(0, 0), `0.000000`},
+		{time.Unix(1, 1000), `1.000001`},
+		{time.Unix(1, 999999999), `1.999999`},
+		{time.Unix(-2, 500000000), `-1.500000`},
+	} {
+		if s := string(appendTimestamp(nil, tc.Time)); s != tc.Output {
+			t.Errorf(`unexpected output for %v: %s`, tc.Time, s)
+		}
+	}
+}
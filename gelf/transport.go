@@ -0,0 +1,120 @@
+package gelf
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"time"
+)
+
+const (
+	// chunkHeaderSize is the size of the header of each UDP chunk, consisting
+	// of the magic bytes, message ID, sequence number, and sequence count
+	chunkHeaderSize = 12
+
+	// maxChunks is the maximum number of chunks per message
+	maxChunks = 128
+)
+
+// ErrTooLarge indicates a message would require more than 128 chunks, and
+// was therefore dropped.
+var ErrTooLarge = errors.New(`gelf: message too large`)
+
+func (x *Logger) write(b []byte) (err error) {
+	if x.conn == nil {
+		if x.conn, err = x.dial(); err != nil {
+			return
+		}
+	}
+
+	if x.timeout > 0 {
+		if err = x.conn.SetWriteDeadline(time.Now().Add(x.timeout)); err != nil {
+			return
+		}
+	}
+
+	if x.stream {
+		// null byte delimited, note b is event.buf (which is restored)
+		_, err = x.conn.Write(append(b, 0))
+	} else {
+		if b, err = x.compress(b); err != nil {
+			return
+		}
+		if len(b) <= x.chunkSize {
+			_, err = x.conn.Write(b)
+		} else {
+			err = x.writeChunks(b)
+		}
+	}
+
+	if err != nil && err != ErrTooLarge {
+		_ = x.conn.Close()
+		x.conn = nil
+	}
+
+	return
+}
+
+func (x *Logger) dial() (net.Conn, error) {
+	if x.timeout > 0 {
+		return net.DialTimeout(x.network, x.address, x.timeout)
+	}
+	return net.Dial(x.network, x.address)
+}
+
+func (x *Logger) compress(b []byte) ([]byte, error) {
+	switch x.compression {
+	case CompressionGzip:
+		x.buf.Reset()
+		if x.gzip == nil {
+			x.gzip = gzip.NewWriter(&x.buf)
+		} else {
+			x.gzip.Reset(&x.buf)
+		}
+		if _, err := x.gzip.Write(b); err != nil {
+			return nil, err
+		}
+		if err := x.gzip.Close(); err != nil {
+			return nil, err
+		}
+		return x.buf.Bytes(), nil
+	case CompressionZlib:
+		x.buf.Reset()
+		if x.zlib == nil {
+			x.zlib = zlib.NewWriter(&x.buf)
+		} else {
+			x.zlib.Reset(&x.buf)
+		}
+		if _, err := x.zlib.Write(b); err != nil {
+			return nil, err
+		}
+		if err := x.zlib.Close(); err != nil {
+			return nil, err
+		}
+		return x.buf.Bytes(), nil
+	default:
+		return b, nil
+	}
+}
+
+func (x *Logger) writeChunks(b []byte) error {
+	size := x.chunkSize - chunkHeaderSize
+	count := (len(b) + size - 1) / size
+	if count > maxChunks {
+		return ErrTooLarge
+	}
+	id := rand.Uint64()
+	for i := range count {
+		x.chunk = append(x.chunk[:0], 0x1e, 0x0f)
+		x.chunk = binary.BigEndian.AppendUint64(x.chunk, id)
+		x.chunk = append(x.chunk, byte(i), byte(count))
+		x.chunk = append(x.chunk, b[i*size:min((i+1)*size, len(b))]...)
+		if _, err := x.conn.Write(x.chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,12 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
 type (
 	IntDataType int
 
+	Uint16DataType uint16
+
 	ObjectMethods[T any] interface {
 		Field(key string, val any) T
 		Any(key string, val any) T
@@ -29,6 +34,25 @@ type (
 		Uint64(key string, val uint64) T
 		Stringer(key string, val fmt.Stringer) T
 		RawJSON(key string, val json.RawMessage) T
+		Strs(key string, val []string) T
+		Ints(key string, val []int) T
+		Int64s(key string, val []int64) T
+		Float64s(key string, val []float64) T
+		Bools(key string, val []bool) T
+		Durs(key string, val []time.Duration) T
+		Times(key string, val []time.Time) T
+		Errs(key string, val []error) T
+		Int8(key string, val int8) T
+		Int16(key string, val int16) T
+		Int32(key string, val int32) T
+		Uint(key string, val uint) T
+		Uint8(key string, val uint8) T
+		Uint16(key string, val uint16) T
+		Uint32(key string, val uint32) T
+		IPAddr(key string, val netip.Addr) T
+		IPPrefix(key string, val netip.Prefix) T
+		URL(key string, val *url.URL) T
+		Hex(key string, val []byte) T
 	}
 
 	ArrayMethods[T any] interface {
@@ -48,13 +72,34 @@ type (
 		Uint64(val uint64) T
 		Stringer(val fmt.Stringer) T
 		RawJSON(val json.RawMessage) T
+		Int8(val int8) T
+		Int16(val int16) T
+		Int32(val int32) T
+		Uint(val uint) T
+		Uint8(val uint8) T
+		Uint16(val uint16) T
+		Uint32(val uint32) T
+		IPAddr(val netip.Addr) T
+		IPPrefix(val netip.Prefix) T
+		URL(val *url.URL) T
+		Hex(val []byte) T
 	}
 
 	ByteStringer []byte
+
+	// TextDataType implements both encoding.TextMarshaler and fmt.Stringer.
+	TextDataType string
+
+	// JSONDataType implements json.Marshaler, encoding.TextMarshaler, and
+	// fmt.Stringer.
+	JSONDataType string
+
+	BytesDataType []byte
 )
 
 // FluentObjectTemplate exercises every fluent method that's common between Builder and Context
 func FluentObjectTemplate[T ObjectMethods[T]](x T) {
+	int32Val, float64Val, strVal := int32(math.MinInt32), float64(-0.25), `val 9`
 	x.Err(errors.New(`err called`)).
 		Field(`field called with string`, `val 2`).
 		Field(`field called with bytes`, []byte(`val 3`)).
@@ -63,7 +108,7 @@ func FluentObjectTemplate[T ObjectMethods[T]](x T) {
 		Field(`field called with duration`, time.Duration(3116139280723392)).
 		Field(`field called with int`, -51245).
 		Field(`field called with float32`, float32(math.SmallestNonzeroFloat32)).
-		Field(`field called with unhandled type`, IntDataType(-421)).
+		Field(`field called with named type`, IntDataType(-421)).
 		Float32(`float32 called`, float32(math.MaxFloat32)).
 		Int(`int called`, math.MaxInt).
 		Interface(`interface called with string`, `val 4`).
@@ -90,10 +135,50 @@ func FluentObjectTemplate[T ObjectMethods[T]](x T) {
 		Stringer(`stringer called`, ByteStringer(`byte stringer 1`)).
 		Stringer(`stringer called with nil value`, nil).
 		RawJSON(`raw json called`, json.RawMessage(`{"key":"val"}`)).
-		Field(`field called with raw json`, json.RawMessage(`[ false, null ]`))
+		Field(`field called with raw json`, json.RawMessage(`[ false, null ]`)).
+		Strs(`strs called`, []string{`val 8`, ``}).
+		Strs(`strs called with nil`, nil).
+		Ints(`ints called`, []int{math.MinInt, 0, math.MaxInt}).
+		Int64s(`int64s called`, []int64{math.MinInt64, math.MaxInt64}).
+		Float64s(`float64s called`, []float64{-1.5, math.MaxFloat64}).
+		Bools(`bools called`, []bool{true, false}).
+		Durs(`durs called`, []time.Duration{time.Duration(51238123523458989), 0}).
+		Times(`times called`, []time.Time{time.Unix(0, 1616592449876543213).UTC()}).
+		Errs(`errs called`, []error{errors.New(`errs called 1`), errors.New(`errs called 2`)}).
+		Int8(`int8 called`, math.MinInt8).
+		Int16(`int16 called`, math.MinInt16).
+		Int32(`int32 called`, math.MinInt32).
+		Uint(`uint called`, math.MaxUint).
+		Uint8(`uint8 called`, math.MaxUint8).
+		Uint16(`uint16 called`, math.MaxUint16).
+		Uint32(`uint32 called`, math.MaxUint32).
+		Field(`field called with int32`, int32(math.MaxInt32)).
+		Field(`field called with uint32`, uint32(math.MaxUint32)).
+		Field(`field called with uintptr`, uintptr(0xdeadbeef)).
+		Field(`field called with int32 pointer`, &int32Val).
+		Field(`field called with float64 pointer`, &float64Val).
+		Field(`field called with nil pointer`, (*int32)(nil)).
+		Field(`field called with named uint16`, Uint16DataType(8080)).
+		Field(`field called with error`, errors.New(`field error`)).
+		Field(`field called with stringer`, ByteStringer(`byte stringer 2`)).
+		Field(`field called with text marshaler`, TextDataType(`val 10`)).
+		Field(`field called with json marshaler`, JSONDataType(`val 11`)).
+		Field(`field called with named bytes`, BytesDataType(`val 12`)).
+		Field(`field called with string pointer`, &strVal).
+		Field(`field called with nil error`, error(nil)).
+		IPAddr(`ip addr called`, netip.MustParseAddr(`2001:db8::1`)).
+		IPAddr(`ip addr called with zero`, netip.Addr{}).
+		IPPrefix(`ip prefix called`, netip.MustParsePrefix(`10.0.0.0/8`)).
+		URL(`url called`, &url.URL{Scheme: `https`, User: url.UserPassword(`user`, `pass`), Host: `example.com`, Path: `/a b`, RawQuery: `q=1`}).
+		URL(`url called with nil`, nil).
+		Hex(`hex called`, []byte{0xde, 0xad, 0xbe, 0xef}).
+		Field(`field called with netip.Addr`, netip.MustParseAddr(`192.0.2.1`)).
+		Field(`field called with net.IP`, net.IPv4(192, 0, 2, 2)).
+		Field(`field called with url.URL`, url.URL{Scheme: `http`, Host: `example.com`})
 }
 
 func FluentArrayTemplate[T ArrayMethods[T]](x T) {
+	int32Val, float64Val, strVal := int32(math.MinInt32), float64(-0.25), `val 9`
 	x.Err(errors.New(`err called`)).
 		Field(`val 2`).
 		Field([]byte(`val 3`)).
@@ -129,9 +214,63 @@ func FluentArrayTemplate[T ArrayMethods[T]](x T) {
 		Stringer(ByteStringer(`byte stringer 1`)).
 		Stringer(nil).
 		RawJSON(json.RawMessage(`{"key":"val"}`)).
-		Field(json.RawMessage(`[ false, null ]`))
+		Field(json.RawMessage(`[ false, null ]`)).
+		Int8(math.MinInt8).
+		Int16(math.MinInt16).
+		Int32(math.MinInt32).
+		Uint(math.MaxUint).
+		Uint8(math.MaxUint8).
+		Uint16(math.MaxUint16).
+		Uint32(math.MaxUint32).
+		Field(int32(math.MaxInt32)).
+		Field(uint32(math.MaxUint32)).
+		Field(uintptr(0xdeadbeef)).
+		Field(&int32Val).
+		Field(&float64Val).
+		Field((*int32)(nil)).
+		Field(Uint16DataType(8080)).
+		Field(errors.New(`field error`)).
+		Field(ByteStringer(`byte stringer 2`)).
+		Field(TextDataType(`val 10`)).
+		Field(JSONDataType(`val 11`)).
+		Field(BytesDataType(`val 12`)).
+		Field(&strVal).
+		Field(error(nil)).
+		IPAddr(netip.MustParseAddr(`2001:db8::1`)).
+		IPAddr(netip.Addr{}).
+		IPPrefix(netip.MustParsePrefix(`10.0.0.0/8`)).
+		URL(&url.URL{Scheme: `https`, User: url.UserPassword(`user`, `pass`), Host: `example.com`, Path: `/a b`, RawQuery: `q=1`}).
+		URL(nil).
+		Hex([]byte{0xde, 0xad, 0xbe, 0xef}).
+		Field(netip.MustParseAddr(`192.0.2.1`)).
+		Field(net.IPv4(192, 0, 2, 2)).
+		Field(url.URL{Scheme: `http`, Host: `example.com`})
 }
 
 func (x ByteStringer) String() string {
 	return string(x)
 }
+
+func (x TextDataType) MarshalText() ([]byte, error) {
+	return []byte(`text ` + x), nil
+}
+
+func (x TextDataType) String() string {
+	return `string ` + string(x)
+}
+
+func (x JSONDataType) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(string(x))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(`{"json":` + string(b) + `}`), nil
+}
+
+func (x JSONDataType) MarshalText() ([]byte, error) {
+	return []byte(`text ` + x), nil
+}
+
+func (x JSONDataType) String() string {
+	return `string ` + string(x)
+}
@@ -0,0 +1,126 @@
+// Package jsonenc implements allocation-free JSON encoding primitives, for
+// the append-buffer based implementations within this module.
+package jsonenc
+
+import (
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+const hex = "0123456789abcdef"
+
+// AppendString appends val to dst, as a quoted JSON string.
+func AppendString(dst []byte, val string) []byte {
+	dst = append(dst, '"')
+	dst = AppendStringContent(dst, val)
+	return append(dst, '"')
+}
+
+// AppendStringContent appends val to dst, as the escaped content of a JSON
+// string (i.e. without the surrounding quotes).
+//
+// The escaping behavior is the same as encoding/json, without HTML escaping.
+// Invalid UTF-8 is replaced with U+FFFD.
+func AppendStringContent(dst []byte, val string) []byte {
+	start := 0
+	for i := 0; i < len(val); {
+		if c := val[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+			dst = append(dst, val[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(val[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, val[start:i]...)
+			dst = append(dst, string(utf8.RuneError)...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid JSON, but not valid JavaScript
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, val[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	return append(dst, val[start:]...)
+}
+
+// InsertStringContent inserts the escaped content of val into dst, at index,
+// shifting the remainder of dst to the right. See also AppendStringContent.
+func InsertStringContent(dst []byte, index int, val string) []byte {
+	n := len(dst)
+	dst = AppendStringContent(dst, val)
+	// rotate the appended content into position, without allocating
+	reverse(dst[index:n])
+	reverse(dst[n:])
+	reverse(dst[index:])
+	return dst
+}
+
+// AppendFloat64 appends val to dst, using the same format as encoding/json,
+// except that NaN and infinite values are encoded as the (quoted) strings
+// "NaN", "+Inf", and "-Inf".
+func AppendFloat64(dst []byte, val float64) []byte {
+	return appendFloat(dst, val, 64)
+}
+
+// AppendFloat32 is the float32 equivalent of AppendFloat64.
+func AppendFloat32(dst []byte, val float32) []byte {
+	return appendFloat(dst, float64(val), 32)
+}
+
+func appendFloat(dst []byte, val float64, bits int) []byte {
+	switch {
+	case math.IsNaN(val):
+		return append(dst, `"NaN"`...)
+	case math.IsInf(val, 1):
+		return append(dst, `"+Inf"`...)
+	case math.IsInf(val, -1):
+		return append(dst, `"-Inf"`...)
+	}
+	format := byte('f')
+	if abs := math.Abs(val); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	dst = strconv.AppendFloat(dst, val, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(dst); n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
@@ -0,0 +1,61 @@
+package jsonenc
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func encodingJSON(t *testing.T, v any) string {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+func TestAppendString(t *testing.T) {
+	for _, val := range [...]string{
+		``,
+		`simple`,
+		"\"\\\n\r\t\x00\x1f\x7f<>&",
+		"  é\U0001F600",
+		"invalid \xff utf8 \xc3",
+	} {
+		if s, expected := string(AppendString(nil, val)), encodingJSON(t, val); s != expected {
+			t.Errorf("unexpected output for %q: %s != %s", val, s, expected)
+		}
+	}
+}
+
+func TestInsertStringContent(t *testing.T) {
+	if s := string(InsertStringContent([]byte(`{"":1}`), 2, "a\"b")); s != `{"a\"b":1}` {
+		t.Error(s)
+	}
+}
+
+func TestAppendFloat64(t *testing.T) {
+	for _, val := range [...]float64{0, 1, -1.5, 1e-6, 1e-7, 1e20, 1e21, 123456789.123, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		if s, expected := string(AppendFloat64(nil, val)), encodingJSON(t, val); s != expected {
+			t.Errorf("unexpected output for %v: %s != %s", val, s, expected)
+		}
+		if math.IsInf(float64(float32(val)), 0) {
+			continue
+		}
+		if s, expected := string(AppendFloat32(nil, float32(val))), encodingJSON(t, float32(val)); s != expected {
+			t.Errorf("unexpected output for float32 %v: %s != %s", val, s, expected)
+		}
+	}
+	for val, expected := range map[float64]string{math.Inf(1): `"+Inf"`, math.Inf(-1): `"-Inf"`} {
+		if s := string(AppendFloat64(nil, val)); s != expected {
+			t.Error(s)
+		}
+	}
+	if s := string(AppendFloat64(nil, math.NaN())); s != `"NaN"` {
+		t.Error(s)
+	}
+}
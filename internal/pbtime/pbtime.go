@@ -0,0 +1,71 @@
+// Package pbtime implements the string formats of protobuf's "well known
+// types", google.protobuf.Timestamp and google.protobuf.Duration, as used by
+// their JSON encoding.
+package pbtime
+
+import (
+	"strconv"
+	"time"
+)
+
+// FormatTimestamp uses the same behavior as protobuf's timestamp.
+// "1972-01-01T10:00:20.021Z"	Uses RFC 3339, where generated output will always be Z-normalized and uses 0, 3, 6 or 9 fractional digits. Offsets other than "Z" are also accepted.
+func FormatTimestamp(t time.Time) string {
+	var b [40]byte
+	return string(AppendTimestamp(b[:0], t))
+}
+
+// AppendTimestamp is the append-style equivalent of FormatTimestamp.
+func AppendTimestamp(dst []byte, t time.Time) []byte {
+	n := len(dst)
+	dst = t.UTC().AppendFormat(dst, "2006-01-02T15:04:05.000000000") // RFC 3339
+	return append(trimFraction(dst, n), 'Z')
+}
+
+// FormatDuration uses the same behavior as protobuf's duration.
+// "1.000340012s", "1s"	Generated output always contains 0, 3, 6, or 9 fractional digits, depending on required precision, followed by the suffix "s". Accepted are any fractional digits (also none) as long as they fit into nano-seconds precision and the suffix "s" is required.
+func FormatDuration(d time.Duration) string {
+	var b [32]byte
+	return string(AppendDuration(b[:0], d))
+}
+
+// AppendDuration is the append-style equivalent of FormatDuration.
+func AppendDuration(dst []byte, d time.Duration) []byte {
+	nanos := d.Nanoseconds()
+	secs := nanos / 1e9
+	nanos -= secs * 1e9
+	n := len(dst)
+	if secs < 0 || nanos < 0 {
+		// note: uint64 handles math.MinInt64
+		dst = append(dst, '-')
+		dst = strconv.AppendUint(dst, uint64(-secs), 10)
+		nanos = -nanos
+	} else {
+		dst = strconv.AppendInt(dst, secs, 10)
+	}
+	dst = append(dst, '.')
+	for div := int64(1e8); div > 0; div /= 10 {
+		dst = append(dst, byte('0'+nanos/div%10))
+	}
+	return append(trimFraction(dst, n), 's')
+}
+
+// trimFraction removes trailing groups of three zeros, from the nine digit
+// fraction at the end of dst[n:], including the decimal point if empty.
+func trimFraction(dst []byte, n int) []byte {
+	for range 2 {
+		if !hasZeros(dst[n:]) {
+			return dst
+		}
+		dst = dst[:len(dst)-3]
+	}
+	if hasZeros(dst[n:]) && len(dst)-n > 3 && dst[len(dst)-4] == '.' {
+		dst = dst[:len(dst)-4]
+	}
+	return dst
+}
+
+func hasZeros(b []byte) bool {
+	n := len(b)
+	return n >= 3 && b[n-1] == '0' && b[n-2] == '0' && b[n-3] == '0'
+}
@@ -0,0 +1,21 @@
+// Package journald implements a logiface logger, which writes events to
+// systemd-journald, using the native protocol, without any dependencies
+// outside this module.
+//
+// Each event is sent as a single datagram, to the journal socket, with the
+// level mapped to PRIORITY (the syslog severity, with levels above debug
+// mapped to debug), and the message to MESSAGE. Fields are written as
+// journal fields, the names of which are converted to uppercase, with any
+// characters other than A-Z, 0-9, and underscore replaced by underscores, and
+// any leading underscores (reserved for trusted fields) removed. Names that
+// would otherwise begin with a digit are prefixed by "F". Names that would
+// collide with the fields written by this package (MESSAGE, PRIORITY, and
+// SYSLOG_IDENTIFIER) are prefixed by "F_", and all names are truncated to 64
+// characters, the maximum accepted by journald.
+//
+// Payloads too large for a single datagram are written to a sealed memfd
+// (falling back to an unlinked temporary file), the file descriptor of which
+// is passed to journald, as described by the protocol documentation.
+//
+// See also https://systemd.io/JOURNAL_NATIVE_PROTOCOL/.
+package journald
@@ -0,0 +1,278 @@
+package journald
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"strconv"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		// buf is the encoded datagram
+		buf []byte
+		// group is the (encoded) field name prefix, see AddGroup
+		group []byte
+		lvl   logiface.Level
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Bytes returns the current buffer, for the event, which is the payload, in
+// the journal native protocol format.
+func (x *Event) Bytes() []byte {
+	return x.buf
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	switch val := val.(type) {
+	case string:
+		x.AddString(key, val)
+	case error:
+		x.AddString(key, fmt.Sprint(val))
+	default:
+		if b, err := json.Marshal(val); err != nil {
+			x.AddString(key, fmt.Sprintf("marshaling error: %v", err))
+		} else {
+			x.appendField(key, b)
+		}
+	}
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	x.buf = appendField(x.buf, `MESSAGE`, msg)
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.AddString(`err`, fmt.Sprint(err))
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendName(key)
+	x.buf = appendValue(x.buf, val)
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendInt(x.buf, int64(val), 10)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendFloat(x.buf, float64(val), 'g', -1, 32)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = pbtime.AppendTimestamp(x.buf, val)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = pbtime.AppendDuration(x.buf, val)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = enc.AppendEncode(x.buf, val)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendBool(x.buf, val)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendFloat(x.buf, val, 'g', -1, 64)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendInt(x.buf, val, 10)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendName(key)
+	x.buf = append(x.buf, '=')
+	x.buf = strconv.AppendUint(x.buf, val, 10)
+	x.buf = append(x.buf, '\n')
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	if len(val) == 0 {
+		x.appendField(key, []byte(`null`))
+		return true
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, val); err != nil {
+		x.appendField(key, val)
+	} else {
+		x.appendField(key, buf.Bytes())
+	}
+	return true
+}
+
+// AddGroup prefixes the names of all subsequent fields with the group name,
+// and an underscore.
+func (x *Event) AddGroup(name string) bool {
+	x.group = appendFieldName(x.group, name)
+	x.group = append(x.group, '_')
+	return true
+}
+
+func (x *Event) appendName(key string) {
+	start := len(x.buf)
+	if len(x.group) == 0 {
+		x.buf = appendFieldName(x.buf, key)
+	} else {
+		x.buf = append(x.buf, x.group...)
+		x.buf = appendFieldNameContent(x.buf, key)
+	}
+	if isReservedFieldName(x.buf[start:]) {
+		x.buf = append(x.buf, reservedPrefix...)
+		copy(x.buf[start+len(reservedPrefix):], x.buf[start:])
+		copy(x.buf[start:], reservedPrefix)
+	}
+	if len(x.buf)-start > maxFieldName {
+		x.buf = x.buf[:start+maxFieldName]
+	}
+}
+
+func (x *Event) appendField(key string, val []byte) {
+	x.appendName(key)
+	x.buf = appendValue(x.buf, val)
+}
+
+// maxFieldName is the maximum length of a journal field name
+const maxFieldName = 64
+
+// reservedPrefix is prepended to field names that would otherwise collide
+// with the fields written by this package
+const reservedPrefix = `F_`
+
+// isReservedFieldName returns true if name is one of the fields written by
+// this package, i.e. MESSAGE, PRIORITY, or SYSLOG_IDENTIFIER
+func isReservedFieldName(name []byte) bool {
+	switch string(name) {
+	case `MESSAGE`, `PRIORITY`, `SYSLOG_IDENTIFIER`:
+		return true
+	default:
+		return false
+	}
+}
+
+// appendField appends a field, the name of which must already be valid.
+func appendField[T string | []byte](dst []byte, name string, val T) []byte {
+	dst = append(dst, name...)
+	return appendValue(dst, val)
+}
+
+// appendValue appends the value, and the preceding separator, using the
+// binary-safe serialization, if val contains a newline.
+func appendValue[T string | []byte](dst []byte, val T) []byte {
+	if !hasNewline(val) {
+		dst = append(dst, '=')
+		dst = append(dst, val...)
+		return append(dst, '\n')
+	}
+	dst = append(dst, '\n')
+	dst = binary.LittleEndian.AppendUint64(dst, uint64(len(val)))
+	dst = append(dst, val...)
+	return append(dst, '\n')
+}
+
+// appendFieldName appends a valid field name, derived from name, see the
+// package documentation.
+func appendFieldName(dst []byte, name string) []byte {
+	for len(name) != 0 && name[0] == '_' {
+		name = name[1:]
+	}
+	if name == `` || (name[0] >= '0' && name[0] <= '9') {
+		dst = append(dst, 'F')
+	}
+	return appendFieldNameContent(dst, name)
+}
+
+func appendFieldNameContent(dst []byte, name string) []byte {
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_':
+			dst = append(dst, c)
+		case c >= 'a' && c <= 'z':
+			dst = append(dst, c-('a'-'A'))
+		default:
+			dst = append(dst, '_')
+		}
+	}
+	return dst
+}
+
+func hasNewline[T string | []byte](val T) bool {
+	for i := 0; i < len(val); i++ {
+		if val[i] == '\n' {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package journald
+
+import (
+	"github.com/joeycumines/logiface"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		socket     string
+		identifier *string
+	}
+)
+
+const (
+	// DefaultSocket is the default path to the journal socket.
+	DefaultSocket = `/run/systemd/journal/socket`
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithJournald configures a logiface logger to write to journald, using the
+// implementations provided by this package.
+func WithJournald(options ...Option) logiface.Option[*Event] {
+	l := NewLogger(options...)
+	return L.WithOptions(
+		L.WithWriter(l),
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+	)
+}
+
+// WithJournald is an alias of the package function of the same name.
+func (LoggerFactory) WithJournald(options ...Option) logiface.Option[*Event] {
+	return WithJournald(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.EventReleaser], and [logiface.Writer]
+// interfaces. Most users should prefer [WithJournald].
+//
+// The connection will be established on first write, see also [Logger.Close].
+func NewLogger(options ...Option) *Logger {
+	c := loggerConfig{socket: DefaultSocket}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{socket: c.socket}
+
+	if c.identifier != nil {
+		l.identifier = *c.identifier
+	} else if len(os.Args) != 0 {
+		l.identifier = filepath.Base(os.Args[0])
+	}
+
+	return &l
+}
+
+// WithSocket configures the path to the journal socket, which defaults to
+// [DefaultSocket].
+func WithSocket(path string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.socket = path
+	})
+}
+
+// WithSyslogIdentifier configures the SYSLOG_IDENTIFIER field, which
+// defaults to the base name of the executable, per [os.Args]. An empty value
+// disables the field.
+func WithSyslogIdentifier(identifier string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.identifier = &identifier
+	})
+}
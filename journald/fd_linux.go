@@ -0,0 +1,105 @@
+package journald
+
+import (
+	"errors"
+	"net"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// see fcntl.h and memfd.h
+const (
+	mfdCloexec      = 0x1
+	mfdAllowSealing = 0x2
+	fAddSeals       = 1033
+	fSealSeal       = 0x1
+	fSealShrink     = 0x2
+	fSealGrow       = 0x4
+	fSealWrite      = 0x8
+)
+
+// sysMemfdCreate is the memfd_create syscall number, which is not provided by
+// the syscall package, for all architectures
+var sysMemfdCreate = map[string]uintptr{
+	`386`:      356,
+	`amd64`:    319,
+	`arm`:      385,
+	`arm64`:    279,
+	`loong64`:  279,
+	`mips`:     4354,
+	`mipsle`:   4354,
+	`mips64`:   5314,
+	`mips64le`: 5314,
+	`ppc64`:    360,
+	`ppc64le`:  360,
+	`riscv64`:  279,
+	`s390x`:    350,
+}[runtime.GOARCH]
+
+// payloadFile returns a file containing b, preferring a sealed memfd, and
+// falling back to an unlinked temporary file.
+func payloadFile(b []byte) (*os.File, error) {
+	if f, err := memfd(b); err == nil {
+		return f, nil
+	}
+	return tempFile(b)
+}
+
+func memfd(b []byte) (*os.File, error) {
+	if sysMemfdCreate == 0 {
+		return nil, errors.ErrUnsupported
+	}
+	name := [...]byte{'j', 'o', 'u', 'r', 'n', 'a', 'l', 0}
+	fd, _, errno := syscall.Syscall(sysMemfdCreate, uintptr(unsafe.Pointer(&name[0])), mfdCloexec|mfdAllowSealing, 0)
+	if errno != 0 {
+		return nil, errno
+	}
+	f := os.NewFile(fd, `journal`)
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if _, _, errno := syscall.Syscall(syscall.SYS_FCNTL, fd, fAddSeals, fSealSeal|fSealShrink|fSealGrow|fSealWrite); errno != 0 {
+		_ = f.Close()
+		return nil, errno
+	}
+	return f, nil
+}
+
+func tempFile(b []byte) (*os.File, error) {
+	dir := `/dev/shm`
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		dir = os.TempDir()
+	}
+	f, err := os.CreateTemp(dir, `journal.`)
+	if err != nil {
+		return nil, err
+	}
+	_ = os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// sendFile sends the file descriptor, as an ancillary message, with no data.
+// Note that [net.UnixConn.WriteMsgUnix] doesn't support connected datagram
+// sockets.
+func sendFile(conn *net.UnixConn, f *os.File) error {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	oob := syscall.UnixRights(int(f.Fd()))
+	var sendErr error
+	if err := raw.Write(func(fd uintptr) bool {
+		sendErr = syscall.Sendmsg(int(fd), nil, oob, nil, 0)
+		return sendErr != syscall.EAGAIN
+	}); err != nil {
+		return err
+	}
+	return sendErr
+}
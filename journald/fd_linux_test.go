@@ -0,0 +1,65 @@
+package journald
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestLogger_Write_largePayload(t *testing.T) {
+	path, read := listen(t)
+	logger := L.New(L.WithJournald(WithSocket(path), WithSyslogIdentifier(`app`)))
+
+	// larger than the default maximum datagram size
+	msg := strings.Repeat(`x`, 1<<22)
+	logger.Info().Log(msg)
+
+	b, oob := read()
+	if len(b) != 0 {
+		t.Errorf(`unexpected data: %d bytes`, len(b))
+	}
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil || len(msgs) != 1 {
+		t.Fatal(msgs, err)
+	}
+	fds, err := syscall.ParseUnixRights(&msgs[0])
+	if err != nil || len(fds) != 1 {
+		t.Fatal(fds, err)
+	}
+	f := os.NewFile(uintptr(fds[0]), `payload`)
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := make([]byte, info.Size())
+	if _, err := f.ReadAt(payload, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(payload, []byte("PRIORITY=6\nSYSLOG_IDENTIFIER=app\nMESSAGE="+msg+"\n")) {
+		t.Errorf(`unexpected payload: %d bytes`, len(payload))
+	}
+	if sysMemfdCreate != 0 {
+		// sealed memfd cannot be written to
+		if _, err := f.WriteAt([]byte(`y`), 0); err == nil {
+			t.Error(`expected sealed memfd`)
+		}
+	}
+}
+
+func TestTempFile(t *testing.T) {
+	f, err := tempFile([]byte(`payload`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := os.Stat(f.Name()); !os.IsNotExist(err) {
+		t.Error(`expected unlinked file`, err)
+	}
+	b := make([]byte, 7)
+	if _, err := f.ReadAt(b, 0); err != nil || string(b) != `payload` {
+		t.Error(string(b), err)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package journald
+
+import (
+	"net"
+	"os"
+)
+
+func payloadFile([]byte) (*os.File, error) {
+	return nil, errUnsupported
+}
+
+func sendFile(*net.UnixConn, *os.File) error {
+	return errUnsupported
+}
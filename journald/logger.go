@@ -0,0 +1,132 @@
+package journald
+
+import (
+	"errors"
+	"github.com/joeycumines/logiface"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser], and
+	// [logiface.Writer], for [Event].
+	// See also [NewLogger], and [WithJournald].
+	Logger struct {
+		socket     string
+		identifier string
+
+		mu   sync.Mutex
+		conn *net.UnixConn
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]  = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event] = (*Logger)(nil)
+	_ logiface.Writer[*Event]        = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{
+			buf: make([]byte, 0, 1<<10),
+		}
+	}}
+)
+
+func (x *Logger) NewEvent(level logiface.Level) *Event {
+	e := eventPool.Get().(*Event)
+	e.lvl = level
+	// note: buf and group aren't reset when added back to the pool
+	e.group = e.group[:0]
+	e.buf = append(e.buf[:0], `PRIORITY=`...)
+	e.buf = strconv.AppendInt(e.buf, int64(priority(level)), 10)
+	e.buf = append(e.buf, '\n')
+	if x.identifier != `` {
+		e.buf = appendField(e.buf, `SYSLOG_IDENTIFIER`, x.identifier)
+	}
+	return e
+}
+
+// Write sends the event to journald, establishing the connection, if
+// necessary. If the write fails, the connection will be re-established, and
+// the write retried, once. Payloads too large for a single datagram are
+// passed via a file descriptor, see the package documentation.
+func (x *Logger) Write(event *Event) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	err := x.write(event.buf)
+	if err != nil && !errors.Is(err, errUnsupported) {
+		err = x.write(event.buf)
+	}
+	return err
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.buf) <= 1<<16 && cap(e.group) <= 1<<10 {
+		eventPool.Put(e)
+	}
+}
+
+// Close closes the current connection, if any. The logger may still be
+// used, and will reconnect, on the next write.
+func (x *Logger) Close() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.conn == nil {
+		return nil
+	}
+	err := x.conn.Close()
+	x.conn = nil
+	return err
+}
+
+func (x *Logger) write(b []byte) error {
+	if x.conn == nil {
+		conn, err := net.DialUnix(`unixgram`, nil, &net.UnixAddr{Name: x.socket, Net: `unixgram`})
+		if err != nil {
+			return err
+		}
+		x.conn = conn
+	}
+
+	_, err := x.conn.Write(b)
+	if err != nil && (errors.Is(err, syscall.EMSGSIZE) || errors.Is(err, syscall.ENOBUFS)) {
+		err = x.writeFile(b)
+	}
+
+	if err != nil && !errors.Is(err, errUnsupported) {
+		_ = x.conn.Close()
+		x.conn = nil
+	}
+
+	return err
+}
+
+// writeFile passes the payload via a file descriptor.
+func (x *Logger) writeFile(b []byte) error {
+	f, err := payloadFile(b)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sendFile(x.conn, f)
+}
+
+// priority maps the logiface level to the syslog severity, which is the
+// same value, except for levels above debug.
+func priority(level logiface.Level) int {
+	if level > logiface.LevelDebug {
+		return int(logiface.LevelDebug)
+	}
+	return int(level)
+}
+
+// errUnsupported indicates that the payload was too large, and passing it
+// via a file descriptor is unsupported, on this platform
+var errUnsupported = errors.New(`journald: payload too large, and file descriptor passing is unsupported`)
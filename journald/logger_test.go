@@ -0,0 +1,209 @@
+package journald
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type journalField struct {
+	Name  string
+	Value string
+}
+
+// listen starts a fake journald, returning the socket path, and a function to
+// read the next datagram, and any file descriptors.
+func listen(t *testing.T) (string, func() ([]byte, []byte)) {
+	t.Helper()
+	// note: unix socket paths are length limited
+	dir, err := os.MkdirTemp(``, `journald`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, `socket`)
+	conn, err := net.ListenUnixgram(`unixgram`, &net.UnixAddr{Name: path, Net: `unixgram`})
+	if err != nil {
+		t.Skip(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return path, func() ([]byte, []byte) {
+		t.Helper()
+		if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		b := make([]byte, 1<<16)
+		oob := make([]byte, 1<<10)
+		n, oobn, _, _, err := conn.ReadMsgUnix(b, oob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b[:n], oob[:oobn]
+	}
+}
+
+// parse decodes the native protocol payload.
+func parse(t *testing.T, b []byte) (fields []journalField) {
+	t.Helper()
+	for len(b) != 0 {
+		i := bytes.IndexAny(b, "=\n")
+		if i == -1 {
+			t.Fatalf(`invalid payload: %q`, b)
+		}
+		name := string(b[:i])
+		if b[i] == '=' {
+			b = b[i+1:]
+			j := bytes.IndexByte(b, '\n')
+			if j == -1 {
+				t.Fatalf(`invalid payload: %q`, b)
+			}
+			fields = append(fields, journalField{name, string(b[:j])})
+			b = b[j+1:]
+		} else {
+			b = b[i+1:]
+			n := binary.LittleEndian.Uint64(b)
+			b = b[8:]
+			fields = append(fields, journalField{name, string(b[:n])})
+			if b[n] != '\n' {
+				t.Fatalf(`invalid payload: %q`, b)
+			}
+			b = b[n+1:]
+		}
+	}
+	return
+}
+
+func TestLogger_Write(t *testing.T) {
+	path, read := listen(t)
+
+	logger := L.New(
+		L.WithJournald(WithSocket(path), WithSyslogIdentifier(`my-app`)),
+		L.WithLevel(logiface.LevelTrace),
+	)
+
+	logger.Warning().
+		Str(`key`, `value`).
+		Str(`multi_line`, "line 1\nline 2").
+		Int(`_trusted`, 1).
+		Int64(`1st`, 2).
+		Uint64(`some-field.name`, 3).
+		Float64(`float`, 1.5).
+		Bool(`bool`, true).
+		Dur(`dur`, time.Second).
+		Time(`time`, time.Unix(0, 0)).
+		Base64(`b64`, []byte(`val 7`), nil).
+		RawJSON(`raw`, []byte(`{"a": 1}`)).
+		Any(`any`, map[string]int{`b`: 2}).
+		Err(errors.New(`some error`)).
+		Str(strings.Repeat(`n`, 70), `long`).
+		Group(`grp`).
+		Object().Int(`c`, 3).As(`obj`).End().
+		Log("the\nmessage")
+
+	b, oob := read()
+	if len(oob) != 0 {
+		t.Errorf(`unexpected oob: %q`, oob)
+	}
+	if fields := parse(t, b); !reflect.DeepEqual(fields, []journalField{
+		{`PRIORITY`, `4`},
+		{`SYSLOG_IDENTIFIER`, `my-app`},
+		{`KEY`, `value`},
+		{`MULTI_LINE`, "line 1\nline 2"},
+		{`TRUSTED`, `1`},
+		{`F1ST`, `2`},
+		{`SOME_FIELD_NAME`, `3`},
+		{`FLOAT`, `1.5`},
+		{`BOOL`, `true`},
+		{`DUR`, `1s`},
+		{`TIME`, `1970-01-01T00:00:00Z`},
+		{`B64`, `dmFsIDc=`},
+		{`RAW`, `{"a":1}`},
+		{`ANY`, `{"b":2}`},
+		{`ERR`, `some error`},
+		{strings.Repeat(`N`, 64), `long`},
+		{`GRP_OBJ`, `{"c":3}`},
+		{`MESSAGE`, "the\nmessage"},
+	}) {
+		t.Errorf(`unexpected fields: %q`, fields)
+	}
+
+	logger.Trace().Log(``)
+	b, _ = read()
+	if s := string(b); s != "PRIORITY=7\nSYSLOG_IDENTIFIER=my-app\n" {
+		t.Errorf(`unexpected payload: %q`, s)
+	}
+}
+
+func TestLogger_Write_fieldNames(t *testing.T) {
+	path, read := listen(t)
+
+	logger := L.New(L.WithJournald(WithSocket(path), WithSyslogIdentifier(`my-app`)))
+
+	logger.Info().
+		Str(`message`, `a`).
+		Str(`_PRIORITY`, `b`).
+		Str(`syslog-identifier`, `c`).
+		Str(`message_id`, `d`).
+		Str(strings.Repeat(`x`, 63), `e`).
+		Group(strings.Repeat(`g`, 40)).
+		Str(strings.Repeat(`y`, 40), `f`).
+		Log(`msg`)
+
+	b, _ := read()
+	if fields := parse(t, b); !reflect.DeepEqual(fields, []journalField{
+		{`PRIORITY`, `6`},
+		{`SYSLOG_IDENTIFIER`, `my-app`},
+		{`F_MESSAGE`, `a`},
+		{`F_PRIORITY`, `b`},
+		{`F_SYSLOG_IDENTIFIER`, `c`},
+		{`MESSAGE_ID`, `d`},
+		{strings.Repeat(`X`, 63), `e`},
+		{strings.Repeat(`G`, 40) + `_` + strings.Repeat(`Y`, 23), `f`},
+		{`MESSAGE`, `msg`},
+	}) {
+		t.Errorf(`unexpected fields: %q`, fields)
+	}
+	for _, field := range parse(t, b) {
+		if len(field.Name) > maxFieldName {
+			t.Errorf(`field name too long: %q`, field.Name)
+		}
+	}
+}
+
+func TestLogger_Write_noIdentifier(t *testing.T) {
+	path, read := listen(t)
+	logger := L.New(L.WithJournald(WithSocket(path), WithSyslogIdentifier(``)))
+	logger.Emerg().Log(`msg`)
+	if b, _ := read(); string(b) != "PRIORITY=0\nMESSAGE=msg\n" {
+		t.Errorf(`unexpected payload: %q`, b)
+	}
+}
+
+func TestLogger_Write_dialError(t *testing.T) {
+	l := NewLogger(WithSocket(filepath.Join(t.TempDir(), `missing`)))
+	logger := L.New(L.WithWriter(l), L.WithEventFactory(l), L.WithEventReleaser(l))
+	if err := logger.Log(logiface.LevelError, nil); err == nil {
+		t.Error(`expected error`)
+	}
+	if err := l.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewLogger_defaults(t *testing.T) {
+	l := NewLogger()
+	if l.socket != DefaultSocket {
+		t.Error(l.socket)
+	}
+	if l.identifier != filepath.Base(os.Args[0]) {
+		t.Error(l.identifier)
+	}
+}
@@ -3,6 +3,8 @@ package logiface
 import (
 	"encoding/base64"
 	"encoding/json"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -60,6 +62,28 @@ type (
 		SetUint64(obj O, key string, val uint64) O
 		CanSetRawJSON() bool
 		SetRawJSON(obj O, key string, b json.RawMessage) O
+		CanSetInt8() bool
+		SetInt8(obj O, key string, val int8) O
+		CanSetInt16() bool
+		SetInt16(obj O, key string, val int16) O
+		CanSetInt32() bool
+		SetInt32(obj O, key string, val int32) O
+		CanSetUint() bool
+		SetUint(obj O, key string, val uint) O
+		CanSetUint8() bool
+		SetUint8(obj O, key string, val uint8) O
+		CanSetUint16() bool
+		SetUint16(obj O, key string, val uint16) O
+		CanSetUint32() bool
+		SetUint32(obj O, key string, val uint32) O
+		CanSetIPAddr() bool
+		SetIPAddr(obj O, key string, val netip.Addr) O
+		CanSetIPPrefix() bool
+		SetIPPrefix(obj O, key string, val netip.Prefix) O
+		CanSetURL() bool
+		SetURL(obj O, key string, val *url.URL) O
+		CanSetHexBytes() bool
+		SetHexBytes(obj O, key string, b []byte) O
 
 		NewArray() A
 		AddArray(evt E, key string, arr A)
@@ -111,6 +135,28 @@ type (
 		AppendUint64(arr A, val uint64) A
 		CanAppendRawJSON() bool
 		AppendRawJSON(arr A, b json.RawMessage) A
+		CanAppendInt8() bool
+		AppendInt8(arr A, val int8) A
+		CanAppendInt16() bool
+		AppendInt16(arr A, val int16) A
+		CanAppendInt32() bool
+		AppendInt32(arr A, val int32) A
+		CanAppendUint() bool
+		AppendUint(arr A, val uint) A
+		CanAppendUint8() bool
+		AppendUint8(arr A, val uint8) A
+		CanAppendUint16() bool
+		AppendUint16(arr A, val uint16) A
+		CanAppendUint32() bool
+		AppendUint32(arr A, val uint32) A
+		CanAppendIPAddr() bool
+		AppendIPAddr(arr A, val netip.Addr) A
+		CanAppendIPPrefix() bool
+		AppendIPPrefix(arr A, val netip.Prefix) A
+		CanAppendURL() bool
+		AppendURL(arr A, val *url.URL) A
+		CanAppendHexBytes() bool
+		AppendHexBytes(arr A, b []byte) A
 
 		mustEmbedUnimplementedJSONSupport()
 	}
@@ -139,6 +185,17 @@ type (
 		setInt64          func(obj any, key string, val int64) any
 		setUint64         func(obj any, key string, val uint64) any
 		setRawJSON        func(obj any, key string, b json.RawMessage) any
+		setInt8           func(obj any, key string, val int8) any
+		setInt16          func(obj any, key string, val int16) any
+		setInt32          func(obj any, key string, val int32) any
+		setUint           func(obj any, key string, val uint) any
+		setUint8          func(obj any, key string, val uint8) any
+		setUint16         func(obj any, key string, val uint16) any
+		setUint32         func(obj any, key string, val uint32) any
+		setIPAddr         func(obj any, key string, val netip.Addr) any
+		setIPPrefix       func(obj any, key string, val netip.Prefix) any
+		setURL            func(obj any, key string, val *url.URL) any
+		setHexBytes       func(obj any, key string, b []byte) any
 		newArray          func() any
 		addArray          func(evt E, key string, arr any)
 		appendField       func(arr, val any) any
@@ -159,6 +216,17 @@ type (
 		appendInt64       func(arr any, val int64) any
 		appendUint64      func(arr any, val uint64) any
 		appendRawJSON     func(arr any, b json.RawMessage) any
+		appendInt8        func(arr any, val int8) any
+		appendInt16       func(arr any, val int16) any
+		appendInt32       func(arr any, val int32) any
+		appendUint        func(arr any, val uint) any
+		appendUint8       func(arr any, val uint8) any
+		appendUint16      func(arr any, val uint16) any
+		appendUint32      func(arr any, val uint32) any
+		appendIPAddr      func(arr any, val netip.Addr) any
+		appendIPPrefix    func(arr any, val netip.Prefix) any
+		appendURL         func(arr any, val *url.URL) any
+		appendHexBytes    func(arr any, b []byte) any
 	}
 
 	// iJSONSupport are the [JSONSupport] methods without type-specific behavior
@@ -181,6 +249,17 @@ type (
 		CanSetInt64() bool
 		CanSetUint64() bool
 		CanSetRawJSON() bool
+		CanSetInt8() bool
+		CanSetInt16() bool
+		CanSetInt32() bool
+		CanSetUint() bool
+		CanSetUint8() bool
+		CanSetUint16() bool
+		CanSetUint32() bool
+		CanSetIPAddr() bool
+		CanSetIPPrefix() bool
+		CanSetURL() bool
+		CanSetHexBytes() bool
 		CanAppendArray() bool
 		CanAppendObject() bool
 		CanAddStartArray() bool
@@ -198,6 +277,17 @@ type (
 		CanAppendInt64() bool
 		CanAppendUint64() bool
 		CanAppendRawJSON() bool
+		CanAppendInt8() bool
+		CanAppendInt16() bool
+		CanAppendInt32() bool
+		CanAppendUint() bool
+		CanAppendUint8() bool
+		CanAppendUint16() bool
+		CanAppendUint32() bool
+		CanAppendIPAddr() bool
+		CanAppendIPPrefix() bool
+		CanAppendURL() bool
+		CanAppendHexBytes() bool
 	}
 
 	UnimplementedJSONSupport[E Event, O any, A any] struct{}
@@ -295,6 +385,39 @@ func newJSONSupport[E Event, O any, A any](impl JSONSupport[E, O, A]) *jsonSuppo
 		setRawJSON: func(obj any, key string, b json.RawMessage) any {
 			return impl.SetRawJSON(obj.(O), key, b)
 		},
+		setInt8: func(obj any, key string, val int8) any {
+			return impl.SetInt8(obj.(O), key, val)
+		},
+		setInt16: func(obj any, key string, val int16) any {
+			return impl.SetInt16(obj.(O), key, val)
+		},
+		setInt32: func(obj any, key string, val int32) any {
+			return impl.SetInt32(obj.(O), key, val)
+		},
+		setUint: func(obj any, key string, val uint) any {
+			return impl.SetUint(obj.(O), key, val)
+		},
+		setUint8: func(obj any, key string, val uint8) any {
+			return impl.SetUint8(obj.(O), key, val)
+		},
+		setUint16: func(obj any, key string, val uint16) any {
+			return impl.SetUint16(obj.(O), key, val)
+		},
+		setUint32: func(obj any, key string, val uint32) any {
+			return impl.SetUint32(obj.(O), key, val)
+		},
+		setIPAddr: func(obj any, key string, val netip.Addr) any {
+			return impl.SetIPAddr(obj.(O), key, val)
+		},
+		setIPPrefix: func(obj any, key string, val netip.Prefix) any {
+			return impl.SetIPPrefix(obj.(O), key, val)
+		},
+		setURL: func(obj any, key string, val *url.URL) any {
+			return impl.SetURL(obj.(O), key, val)
+		},
+		setHexBytes: func(obj any, key string, b []byte) any {
+			return impl.SetHexBytes(obj.(O), key, b)
+		},
 		newArray: func() any {
 			return impl.NewArray()
 		},
@@ -355,6 +478,39 @@ func newJSONSupport[E Event, O any, A any](impl JSONSupport[E, O, A]) *jsonSuppo
 		appendRawJSON: func(arr any, b json.RawMessage) any {
 			return impl.AppendRawJSON(arr.(A), b)
 		},
+		appendInt8: func(arr any, val int8) any {
+			return impl.AppendInt8(arr.(A), val)
+		},
+		appendInt16: func(arr any, val int16) any {
+			return impl.AppendInt16(arr.(A), val)
+		},
+		appendInt32: func(arr any, val int32) any {
+			return impl.AppendInt32(arr.(A), val)
+		},
+		appendUint: func(arr any, val uint) any {
+			return impl.AppendUint(arr.(A), val)
+		},
+		appendUint8: func(arr any, val uint8) any {
+			return impl.AppendUint8(arr.(A), val)
+		},
+		appendUint16: func(arr any, val uint16) any {
+			return impl.AppendUint16(arr.(A), val)
+		},
+		appendUint32: func(arr any, val uint32) any {
+			return impl.AppendUint32(arr.(A), val)
+		},
+		appendIPAddr: func(arr any, val netip.Addr) any {
+			return impl.AppendIPAddr(arr.(A), val)
+		},
+		appendIPPrefix: func(arr any, val netip.Prefix) any {
+			return impl.AppendIPPrefix(arr.(A), val)
+		},
+		appendURL: func(arr any, val *url.URL) any {
+			return impl.AppendURL(arr.(A), val)
+		},
+		appendHexBytes: func(arr any, b []byte) any {
+			return impl.AppendHexBytes(arr.(A), b)
+		},
 	}
 }
 
@@ -381,6 +537,17 @@ func generifyJSONSupport[E Event](impl *jsonSupport[E]) *jsonSupport[Event] {
 		setInt64:          impl.setInt64,
 		setUint64:         impl.setUint64,
 		setRawJSON:        impl.setRawJSON,
+		setInt8:           impl.setInt8,
+		setInt16:          impl.setInt16,
+		setInt32:          impl.setInt32,
+		setUint:           impl.setUint,
+		setUint8:          impl.setUint8,
+		setUint16:         impl.setUint16,
+		setUint32:         impl.setUint32,
+		setIPAddr:         impl.setIPAddr,
+		setIPPrefix:       impl.setIPPrefix,
+		setURL:            impl.setURL,
+		setHexBytes:       impl.setHexBytes,
 		newArray:          impl.newArray,
 		addArray:          func(evt Event, key string, arr any) { impl.addArray(evt.(E), key, arr) },
 		appendField:       impl.appendField,
@@ -401,6 +568,17 @@ func generifyJSONSupport[E Event](impl *jsonSupport[E]) *jsonSupport[Event] {
 		appendInt64:       impl.appendInt64,
 		appendUint64:      impl.appendUint64,
 		appendRawJSON:     impl.appendRawJSON,
+		appendInt8:        impl.appendInt8,
+		appendInt16:       impl.appendInt16,
+		appendInt32:       impl.appendInt32,
+		appendUint:        impl.appendUint,
+		appendUint8:       impl.appendUint8,
+		appendUint16:      impl.appendUint16,
+		appendUint32:      impl.appendUint32,
+		appendIPAddr:      impl.appendIPAddr,
+		appendIPPrefix:    impl.appendIPPrefix,
+		appendURL:         impl.appendURL,
+		appendHexBytes:    impl.appendHexBytes,
 	}
 }
 
@@ -506,6 +684,72 @@ func (UnimplementedJSONSupport[E, O, A]) SetRawJSON(obj O, key string, val json.
 	panic("unimplemented")
 }
 
+func (UnimplementedJSONSupport[E, O, A]) CanSetInt8() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetInt8(obj O, key string, val int8) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetInt16() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetInt16(obj O, key string, val int16) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetInt32() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetInt32(obj O, key string, val int32) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetUint() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetUint(obj O, key string, val uint) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetUint8() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetUint8(obj O, key string, val uint8) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetUint16() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetUint16(obj O, key string, val uint16) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetUint32() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetUint32(obj O, key string, val uint32) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetIPAddr() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetIPAddr(obj O, key string, val netip.Addr) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetIPPrefix() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetIPPrefix(obj O, key string, val netip.Prefix) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetURL() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetURL(obj O, key string, val *url.URL) O {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanSetHexBytes() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) SetHexBytes(obj O, key string, val []byte) O {
+	panic("unimplemented")
+}
+
 func (UnimplementedJSONSupport[E, O, A]) CanAppendArray() bool { return false }
 
 func (UnimplementedJSONSupport[E, O, A]) AppendArray(arr A, val A) A {
@@ -608,6 +852,72 @@ func (UnimplementedJSONSupport[E, O, A]) AppendRawJSON(arr A, val json.RawMessag
 	panic("unimplemented")
 }
 
+func (UnimplementedJSONSupport[E, O, A]) CanAppendInt8() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendInt8(arr A, val int8) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendInt16() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendInt16(arr A, val int16) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendInt32() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendInt32(arr A, val int32) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendUint() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendUint(arr A, val uint) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendUint8() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendUint8(arr A, val uint8) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendUint16() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendUint16(arr A, val uint16) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendUint32() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendUint32(arr A, val uint32) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendIPAddr() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendIPAddr(arr A, val netip.Addr) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendIPPrefix() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendIPPrefix(arr A, val netip.Prefix) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendURL() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendURL(arr A, val *url.URL) A {
+	panic("unimplemented")
+}
+
+func (UnimplementedJSONSupport[E, O, A]) CanAppendHexBytes() bool { return false }
+
+func (UnimplementedJSONSupport[E, O, A]) AppendHexBytes(arr A, val []byte) A {
+	panic("unimplemented")
+}
+
 func (UnimplementedJSONSupport[E, O, A]) mustEmbedUnimplementedJSONSupport() {}
 
 func (x defaultJSONSupport[E]) NewObject() map[string]any { return make(map[string]any) }
@@ -725,6 +1035,72 @@ func (x defaultJSONSupport[E]) SetRawJSON(obj map[string]any, key string, val js
 	panic("unimplemented")
 }
 
+func (x defaultJSONSupport[E]) CanSetInt8() bool { return false }
+
+func (x defaultJSONSupport[E]) SetInt8(obj map[string]any, key string, val int8) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetInt16() bool { return false }
+
+func (x defaultJSONSupport[E]) SetInt16(obj map[string]any, key string, val int16) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetInt32() bool { return false }
+
+func (x defaultJSONSupport[E]) SetInt32(obj map[string]any, key string, val int32) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetUint() bool { return false }
+
+func (x defaultJSONSupport[E]) SetUint(obj map[string]any, key string, val uint) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetUint8() bool { return false }
+
+func (x defaultJSONSupport[E]) SetUint8(obj map[string]any, key string, val uint8) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetUint16() bool { return false }
+
+func (x defaultJSONSupport[E]) SetUint16(obj map[string]any, key string, val uint16) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetUint32() bool { return false }
+
+func (x defaultJSONSupport[E]) SetUint32(obj map[string]any, key string, val uint32) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetIPAddr() bool { return false }
+
+func (x defaultJSONSupport[E]) SetIPAddr(obj map[string]any, key string, val netip.Addr) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetIPPrefix() bool { return false }
+
+func (x defaultJSONSupport[E]) SetIPPrefix(obj map[string]any, key string, val netip.Prefix) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetURL() bool { return false }
+
+func (x defaultJSONSupport[E]) SetURL(obj map[string]any, key string, val *url.URL) map[string]any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanSetHexBytes() bool { return false }
+
+func (x defaultJSONSupport[E]) SetHexBytes(obj map[string]any, key string, val []byte) map[string]any {
+	panic("unimplemented")
+}
+
 func (x defaultJSONSupport[E]) NewArray() []any { return make([]any, 0) }
 
 func (x defaultJSONSupport[E]) AddArray(evt E, key string, arr []any) {
@@ -837,6 +1213,72 @@ func (x defaultJSONSupport[E]) AppendRawJSON(arr []any, val json.RawMessage) []a
 	panic("unimplemented")
 }
 
+func (x defaultJSONSupport[E]) CanAppendInt8() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendInt8(arr []any, val int8) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendInt16() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendInt16(arr []any, val int16) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendInt32() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendInt32(arr []any, val int32) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendUint() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendUint(arr []any, val uint) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendUint8() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendUint8(arr []any, val uint8) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendUint16() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendUint16(arr []any, val uint16) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendUint32() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendUint32(arr []any, val uint32) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendIPAddr() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendIPAddr(arr []any, val netip.Addr) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendIPPrefix() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendIPPrefix(arr []any, val netip.Prefix) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendURL() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendURL(arr []any, val *url.URL) []any {
+	panic("unimplemented")
+}
+
+func (x defaultJSONSupport[E]) CanAppendHexBytes() bool { return false }
+
+func (x defaultJSONSupport[E]) AppendHexBytes(arr []any, val []byte) []any {
+	panic("unimplemented")
+}
+
 func (x defaultJSONSupport[E]) mustEmbedUnimplementedJSONSupport() {}
 
 func (x *jsonSupport[E]) addStartOrNewObject(event E, key string) any {
@@ -2,6 +2,7 @@ package logiface
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -51,3 +52,157 @@ func TestUnimplementedJSONSupport_AppendArray(t *testing.T) {
 	(UnimplementedJSONSupport[Event, map[string]any, []any]{}).AppendArray(nil, nil)
 	t.Error("expected panic")
 }
+
+// mockNarrowJSONSupport opts in to the narrow integer types, retaining the
+// type of each value, unlike the default
+type mockNarrowJSONSupport struct {
+	defaultJSONSupport[*mockComplexEvent]
+}
+
+func (mockNarrowJSONSupport) CanSetInt8() bool { return true }
+
+func (mockNarrowJSONSupport) SetInt8(obj map[string]any, key string, val int8) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetInt16() bool { return true }
+
+func (mockNarrowJSONSupport) SetInt16(obj map[string]any, key string, val int16) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetInt32() bool { return true }
+
+func (mockNarrowJSONSupport) SetInt32(obj map[string]any, key string, val int32) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetUint() bool { return true }
+
+func (mockNarrowJSONSupport) SetUint(obj map[string]any, key string, val uint) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetUint8() bool { return true }
+
+func (mockNarrowJSONSupport) SetUint8(obj map[string]any, key string, val uint8) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetUint16() bool { return true }
+
+func (mockNarrowJSONSupport) SetUint16(obj map[string]any, key string, val uint16) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanSetUint32() bool { return true }
+
+func (mockNarrowJSONSupport) SetUint32(obj map[string]any, key string, val uint32) map[string]any {
+	obj[key] = val
+	return obj
+}
+
+func (mockNarrowJSONSupport) CanAppendInt8() bool { return true }
+
+func (mockNarrowJSONSupport) AppendInt8(arr []any, val int8) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendInt16() bool { return true }
+
+func (mockNarrowJSONSupport) AppendInt16(arr []any, val int16) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendInt32() bool { return true }
+
+func (mockNarrowJSONSupport) AppendInt32(arr []any, val int32) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendUint() bool { return true }
+
+func (mockNarrowJSONSupport) AppendUint(arr []any, val uint) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendUint8() bool { return true }
+
+func (mockNarrowJSONSupport) AppendUint8(arr []any, val uint8) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendUint16() bool { return true }
+
+func (mockNarrowJSONSupport) AppendUint16(arr []any, val uint16) []any {
+	return append(arr, val)
+}
+
+func (mockNarrowJSONSupport) CanAppendUint32() bool { return true }
+
+func (mockNarrowJSONSupport) AppendUint32(arr []any, val uint32) []any {
+	return append(arr, val)
+}
+
+func TestJSONSupport_narrowIntegers(t *testing.T) {
+	var w mockComplexWriter
+	logger := New[*mockComplexEvent](
+		WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+		WithWriter[*mockComplexEvent](&w),
+		WithJSONSupport[*mockComplexEvent, map[string]any, []any](mockNarrowJSONSupport{}),
+	)
+	logger = logger.Clone().
+		Object().
+		Int8(`a`, -1).
+		Uint32(`g`, 7).
+		As(`ctx`).
+		End().
+		Logger()
+	logger.Info().
+		Object().
+		Int8(`a`, -1).
+		Int16(`b`, -2).
+		Int32(`c`, -3).
+		Uint(`d`, 4).
+		Uint8(`e`, 5).
+		Uint16(`f`, 6).
+		Uint32(`g`, 7).
+		As(`obj`).
+		End().
+		Array().
+		Int8(-1).
+		Int16(-2).
+		Int32(-3).
+		Uint(4).
+		Uint8(5).
+		Uint16(6).
+		Uint32(7).
+		As(`arr`).
+		End().
+		Log(``)
+	if len(w.events) != 1 {
+		t.Fatal(len(w.events))
+	}
+	expected := []mockComplexEventField{
+		{Type: `AddField`, Key: `ctx`, Value: map[string]any{`a`: int8(-1), `g`: uint32(7)}},
+		{Type: `AddField`, Key: `obj`, Value: map[string]any{
+			`a`: int8(-1),
+			`b`: int16(-2),
+			`c`: int32(-3),
+			`d`: uint(4),
+			`e`: uint8(5),
+			`f`: uint16(6),
+			`g`: uint32(7),
+		}},
+		{Type: `AddField`, Key: `arr`, Value: []any{int8(-1), int16(-2), int32(-3), uint(4), uint8(5), uint16(6), uint32(7)}},
+	}
+	if v := w.events[0].FieldValues; !reflect.DeepEqual(v, expected) {
+		t.Errorf("got %v, want %v", v, expected)
+	}
+}
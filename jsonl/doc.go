@@ -0,0 +1,9 @@
+// Package jsonl implements full logiface support, as a JSON lines logger,
+// without any dependencies outside this module.
+//
+// Each event is appended to a pooled byte buffer, in a similar manner as
+// zerolog, and every optional [logiface.Event] method is implemented, as is
+// [logiface.JSONSupport] (writing nested objects and arrays directly to the
+// buffer). Writing an event is allocation-free, with the exception of
+// [logiface.Event.AddField], and [logiface.Event.AddError].
+package jsonl
@@ -0,0 +1,476 @@
+package jsonl
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"strconv"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		logger *Logger
+		buf    []byte
+		// off is a stack with the index to insert the key content for each nested json object
+		// negative values indicate already set keys
+		off []int
+		// msg is the message, if it was added while within a group, see AddGroup
+		msg string
+		// groups is the number of groups (objects) that need to be closed, on write
+		groups int
+		lvl    logiface.Level
+		hasMsg bool
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Bytes returns the current buffer, for the event.
+// It may be used, for example, to customise the writer.
+// Always missing the final `}` (and that of any groups), and without a
+// trailing newline.
+func (x *Event) Bytes() []byte {
+	return x.buf
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.appendKey(key)
+	x.appendInterface(val)
+}
+func (*Logger) SetField(obj *Event, key string, val any) *Event {
+	obj.appendKey(key)
+	obj.appendInterface(val)
+	return obj
+}
+func (*Logger) AppendField(arr *Event, val any) *Event {
+	arr.appendArraySeparator()
+	arr.appendInterface(val)
+	return arr
+}
+
+// AddMessage adds the message field. If called within a group (see
+// [Event.AddGroup]), the message will be written after the group is closed,
+// i.e. it will always be a top-level field.
+func (x *Event) AddMessage(msg string) bool {
+	if x.groups != 0 {
+		x.msg = msg
+		x.hasMsg = true
+	} else {
+		x.appendMessage(msg)
+	}
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.appendErrorKey()
+		// this seems sensible, even if it's inefficient
+		x.appendString(fmt.Sprint(err))
+	}
+	return true
+}
+func (*Logger) CanSetError() bool { return true }
+func (*Logger) SetError(obj *Event, err error) *Event {
+	obj.appendErrorKey()
+	// differs from AddError in that it will always set the error field, even if nil
+	obj.appendError(err)
+	return obj
+}
+func (*Logger) CanAppendError() bool { return true }
+func (*Logger) AppendError(arr *Event, err error) *Event {
+	arr.appendArraySeparator()
+	arr.appendError(err)
+	return arr
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendKey(key)
+	x.appendString(val)
+	return true
+}
+func (*Logger) CanSetString() bool { return true }
+func (*Logger) SetString(obj *Event, key string, val string) *Event {
+	obj.appendKey(key)
+	obj.appendString(val)
+	return obj
+}
+func (*Logger) CanAppendString() bool { return true }
+func (*Logger) AppendString(arr *Event, val string) *Event {
+	arr.appendArraySeparator()
+	arr.appendString(val)
+	return arr
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendKey(key)
+	x.appendInt(val)
+	return true
+}
+func (*Logger) CanSetInt() bool { return true }
+func (*Logger) SetInt(obj *Event, key string, val int) *Event {
+	obj.appendKey(key)
+	obj.appendInt(val)
+	return obj
+}
+func (*Logger) CanAppendInt() bool { return true }
+func (*Logger) AppendInt(arr *Event, val int) *Event {
+	arr.appendArraySeparator()
+	arr.appendInt(val)
+	return arr
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendKey(key)
+	x.appendFloat32(val)
+	return true
+}
+func (*Logger) CanSetFloat32() bool { return true }
+func (*Logger) SetFloat32(obj *Event, key string, val float32) *Event {
+	obj.appendKey(key)
+	obj.appendFloat32(val)
+	return obj
+}
+func (*Logger) CanAppendFloat32() bool { return true }
+func (*Logger) AppendFloat32(arr *Event, val float32) *Event {
+	arr.appendArraySeparator()
+	arr.appendFloat32(val)
+	return arr
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendKey(key)
+	x.appendTime(val)
+	return true
+}
+func (*Logger) CanSetTime() bool { return true }
+func (*Logger) SetTime(obj *Event, key string, val time.Time) *Event {
+	obj.appendKey(key)
+	obj.appendTime(val)
+	return obj
+}
+func (*Logger) CanAppendTime() bool { return true }
+func (*Logger) AppendTime(arr *Event, val time.Time) *Event {
+	arr.appendArraySeparator()
+	arr.appendTime(val)
+	return arr
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendKey(key)
+	x.appendDuration(val)
+	return true
+}
+func (*Logger) CanSetDuration() bool { return true }
+func (*Logger) SetDuration(obj *Event, key string, val time.Duration) *Event {
+	obj.appendKey(key)
+	obj.appendDuration(val)
+	return obj
+}
+func (*Logger) CanAppendDuration() bool { return true }
+func (*Logger) AppendDuration(arr *Event, val time.Duration) *Event {
+	arr.appendArraySeparator()
+	arr.appendDuration(val)
+	return arr
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendKey(key)
+	x.appendBase64Bytes(val, enc)
+	return true
+}
+func (*Logger) CanSetBase64Bytes() bool { return true }
+func (*Logger) SetBase64Bytes(obj *Event, key string, val []byte, enc *base64.Encoding) *Event {
+	obj.appendKey(key)
+	obj.appendBase64Bytes(val, enc)
+	return obj
+}
+func (*Logger) CanAppendBase64Bytes() bool { return true }
+func (*Logger) AppendBase64Bytes(arr *Event, val []byte, enc *base64.Encoding) *Event {
+	arr.appendArraySeparator()
+	arr.appendBase64Bytes(val, enc)
+	return arr
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendKey(key)
+	x.appendBool(val)
+	return true
+}
+func (*Logger) CanSetBool() bool { return true }
+func (*Logger) SetBool(obj *Event, key string, val bool) *Event {
+	obj.appendKey(key)
+	obj.appendBool(val)
+	return obj
+}
+func (*Logger) CanAppendBool() bool { return true }
+func (*Logger) AppendBool(arr *Event, val bool) *Event {
+	arr.appendArraySeparator()
+	arr.appendBool(val)
+	return arr
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendKey(key)
+	x.appendFloat64(val)
+	return true
+}
+func (*Logger) CanSetFloat64() bool { return true }
+func (*Logger) SetFloat64(obj *Event, key string, val float64) *Event {
+	obj.appendKey(key)
+	obj.appendFloat64(val)
+	return obj
+}
+func (*Logger) CanAppendFloat64() bool { return true }
+func (*Logger) AppendFloat64(arr *Event, val float64) *Event {
+	arr.appendArraySeparator()
+	arr.appendFloat64(val)
+	return arr
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendKey(key)
+	x.appendInt64(val)
+	return true
+}
+func (*Logger) CanSetInt64() bool { return true }
+func (*Logger) SetInt64(obj *Event, key string, val int64) *Event {
+	obj.appendKey(key)
+	obj.appendInt64(val)
+	return obj
+}
+func (*Logger) CanAppendInt64() bool { return true }
+func (*Logger) AppendInt64(arr *Event, val int64) *Event {
+	arr.appendArraySeparator()
+	arr.appendInt64(val)
+	return arr
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendKey(key)
+	x.appendUint64(val)
+	return true
+}
+func (*Logger) CanSetUint64() bool { return true }
+func (*Logger) SetUint64(obj *Event, key string, val uint64) *Event {
+	obj.appendKey(key)
+	obj.appendUint64(val)
+	return obj
+}
+func (*Logger) CanAppendUint64() bool { return true }
+func (*Logger) AppendUint64(arr *Event, val uint64) *Event {
+	arr.appendArraySeparator()
+	arr.appendUint64(val)
+	return arr
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.appendKey(key)
+	x.appendRawJSON(val)
+	return true
+}
+func (*Logger) CanSetRawJSON() bool { return true }
+func (*Logger) SetRawJSON(obj *Event, key string, val json.RawMessage) *Event {
+	obj.appendKey(key)
+	obj.appendRawJSON(val)
+	return obj
+}
+func (*Logger) CanAppendRawJSON() bool { return true }
+func (*Logger) AppendRawJSON(arr *Event, val json.RawMessage) *Event {
+	arr.appendArraySeparator()
+	arr.appendRawJSON(val)
+	return arr
+}
+
+// AddGroup nests all subsequent fields within an object, with the given key.
+// Groups are closed when the event is written.
+func (x *Event) AddGroup(name string) bool {
+	x.appendKey(name)
+	x.buf = append(x.buf, '{')
+	x.groups++
+	return true
+}
+
+// finish completes the line, returning the length to restore the buffer to
+func (x *Event) finish() int {
+	n := len(x.buf)
+	for range x.groups {
+		x.buf = append(x.buf, '}')
+	}
+	if x.hasMsg {
+		x.appendMessage(x.msg)
+	}
+	x.buf = append(x.buf, '}', '\n') // always missing from x.buf
+	return n
+}
+
+func (x *Event) appendFieldSeparator() {
+	if x.buf[len(x.buf)-1] != '{' {
+		x.buf = append(x.buf, ',')
+	}
+}
+
+func (x *Event) appendArraySeparator() {
+	if x.buf[len(x.buf)-1] != '[' {
+		x.buf = append(x.buf, ',')
+	}
+}
+
+func (x *Event) appendMessage(msg string) {
+	x.appendFieldSeparator()
+	x.buf = append(x.buf, x.logger.messageField...)
+	x.buf = append(x.buf, ':')
+	x.appendString(msg)
+}
+
+func (x *Event) appendError(err error) {
+	if err == nil {
+		x.buf = append(x.buf, `null`...)
+	} else {
+		x.appendString(fmt.Sprint(err))
+	}
+}
+
+func (x *Event) appendString(val string) {
+	x.buf = jsonenc.AppendString(x.buf, val)
+}
+
+func (x *Event) appendInt(val int) {
+	x.buf = strconv.AppendInt(x.buf, int64(val), 10)
+}
+
+func (x *Event) appendFloat64(val float64) {
+	x.buf = jsonenc.AppendFloat64(x.buf, val)
+}
+
+func (x *Event) appendFloat32(val float32) {
+	x.buf = jsonenc.AppendFloat32(x.buf, val)
+}
+
+func (x *Event) appendBool(val bool) {
+	x.buf = strconv.AppendBool(x.buf, val)
+}
+
+func (x *Event) appendTime(val time.Time) {
+	x.buf = append(x.buf, '"')
+	x.buf = pbtime.AppendTimestamp(x.buf, val)
+	x.buf = append(x.buf, '"')
+}
+
+func (x *Event) appendDuration(val time.Duration) {
+	x.buf = append(x.buf, '"')
+	x.buf = pbtime.AppendDuration(x.buf, val)
+	x.buf = append(x.buf, '"')
+}
+
+func (x *Event) appendBase64Bytes(val []byte, enc *base64.Encoding) {
+	x.buf = append(x.buf, '"')
+	x.buf = enc.AppendEncode(x.buf, val)
+	x.buf = append(x.buf, '"')
+}
+
+func (x *Event) appendInt64(val int64) {
+	x.buf = append(x.buf, '"')
+	x.buf = strconv.AppendInt(x.buf, val, 10)
+	x.buf = append(x.buf, '"')
+}
+
+func (x *Event) appendUint64(val uint64) {
+	x.buf = append(x.buf, '"')
+	x.buf = strconv.AppendUint(x.buf, val, 10)
+	x.buf = append(x.buf, '"')
+}
+
+func (x *Event) appendRawJSON(val json.RawMessage) {
+	if len(val) == 0 {
+		x.buf = append(x.buf, `null`...)
+	} else {
+		x.buf = append(x.buf, val...)
+	}
+}
+
+func (x *Event) insertStringContent(index int, value string) {
+	if value == `` {
+		return
+	}
+	n := len(x.buf)
+	x.buf = jsonenc.InsertStringContent(x.buf, index, value)
+	n = len(x.buf) - n
+	for i := len(x.off) - 1; i >= 0; i-- {
+		if x.off[i] < 0 {
+			continue
+		}
+		if x.off[i] <= index {
+			break
+		}
+		x.off[i] += n
+	}
+}
+
+func (x *Event) appendInterface(val any) {
+	if b, err := json.Marshal(val); err != nil {
+		x.appendString(fmt.Sprintf("marshaling error: %v", err))
+	} else {
+		x.buf = append(x.buf, b...)
+	}
+}
+
+func (x *Event) appendKey(key string) {
+	x.appendFieldSeparator()
+	x.appendString(key)
+	x.buf = append(x.buf, ':')
+}
+
+func (x *Event) appendErrorKey() {
+	x.appendFieldSeparator()
+	x.buf = append(x.buf, x.logger.errorField...)
+	x.buf = append(x.buf, ':')
+}
+
+func (x *Event) enterKey(key string) {
+	x.appendFieldSeparator()
+	if key == `` {
+		// off is the index where the key should be inserted
+		x.off = append(x.off, len(x.buf)+1)
+		x.buf = append(x.buf, '"', '"', ':')
+	} else {
+		// key already set, so off is -1
+		x.off = append(x.off, -1)
+		x.appendString(key)
+		x.buf = append(x.buf, ':')
+	}
+}
+
+func (x *Event) exitKey(key string) {
+	if index := x.off[len(x.off)-1]; index >= 0 {
+		x.insertStringContent(index, key)
+	}
+	x.off = x.off[:len(x.off)-1]
+}
@@ -0,0 +1,57 @@
+package jsonl_test
+
+import (
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/jsonl"
+	"os"
+)
+
+func ExampleWithJSONL() {
+	logger := jsonl.L.New(jsonl.L.WithJSONL(
+		jsonl.WithWriter(os.Stdout),
+		jsonl.WithLevelField(`level`),
+	))
+
+	logger.Info().
+		Str(`request_id`, `c7d5a8f1`).
+		Int(`status`, 200).
+		Object().
+		Str(`method`, `GET`).
+		Array().Str(`admin`).Str(`user`).As(`roles`).
+		As(`request`).End().
+		Log(`handled request`)
+
+	logger.Err().
+		Err(fmt.Errorf(`an error`)).
+		Log(`what happened`)
+
+	//output:
+	//{"level":"info","request_id":"c7d5a8f1","status":200,"request":{"method":"GET","roles":["admin","user"]},"msg":"handled request"}
+	//{"level":"err","err":"an error","msg":"what happened"}
+}
+
+func ExampleEvent_Bytes_customWriterImplementation() {
+	customWriter := logiface.WriterFunc[*jsonl.Event](func(e *jsonl.Event) error {
+		// do whatever you would like, but read the docs of jsonl.Event.Bytes
+		fmt.Printf(
+			"CUSTOM: level=%s: %s}\n",
+			e.Level(),
+			e.Bytes(),
+		)
+		return nil
+	})
+
+	logger := jsonl.L.New(
+		jsonl.L.WithJSONL(),
+		jsonl.L.WithWriter(customWriter), // replaces the default writer
+	)
+
+	logger.Info().
+		Int64(`some`, 1).
+		Str(`field2`, `hello`).
+		Log(`the message to log`)
+
+	//output:
+	//CUSTOM: level=info: {"lvl":"info","some":"1","field2":"hello","msg":"the message to log"}
+}
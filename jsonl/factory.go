@@ -0,0 +1,161 @@
+package jsonl
+
+import (
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"io"
+	"os"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		writer       io.Writer
+		timeField    *string
+		levelField   *string
+		messageField *string
+		errorField   *string
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithJSONL configures a logiface logger to write JSON lines, using the
+// implementations provided by this package.
+//
+// By default, events are written to [os.Stderr], with the level, message, and
+// error fields named "lvl", "msg", and "err", and without a time field.
+func WithJSONL(options ...Option) logiface.Option[*Event] {
+	l := NewLogger(options...)
+	return L.WithOptions(
+		L.WithWriter(l),
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+		logiface.WithJSONSupport[*Event, *Event, *Event](l),
+	)
+}
+
+// WithJSONL is an alias of the package function of the same name.
+func (LoggerFactory) WithJSONL(options ...Option) logiface.Option[*Event] {
+	return WithJSONL(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.EventReleaser], [logiface.Writer], and
+// [logiface.JSONSupport] interfaces. Most users should prefer [WithJSONL].
+func NewLogger(options ...Option) *Logger {
+	var c loggerConfig
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{
+		writer:       c.writer,
+		levelField:   encodeField(c.levelField, `lvl`),
+		messageField: encodeField(c.messageField, `msg`),
+		errorField:   encodeField(c.errorField, `err`),
+	}
+
+	if l.writer == nil {
+		l.writer = os.Stderr
+	}
+
+	if c.timeField != nil {
+		l.timeField = encodeField(c.timeField, ``)
+	}
+
+	// the message and error fields cannot be disabled
+	if l.messageField == `` {
+		l.messageField = `"msg"`
+	}
+	if l.errorField == `` {
+		l.errorField = `"err"`
+	}
+
+	return &l
+}
+
+// WithWriter configures the destination for the logger, which defaults to
+// [os.Stderr]. Each event is written using a single call to Write.
+func WithWriter(writer io.Writer) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.writer = writer
+	})
+}
+
+// WithTimeField enables a time field, using the given key, which will be set
+// to the current time, when the event is created. Disabled by default, or if
+// the key is empty.
+func WithTimeField(field string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.timeField = &field
+	})
+}
+
+// WithLevelField configures the key for the level field, which defaults to
+// "lvl". An empty key disables the level field.
+func WithLevelField(field string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.levelField = &field
+	})
+}
+
+// WithMessageField configures the key for the message field, which defaults
+// to "msg".
+func WithMessageField(field string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.messageField = &field
+	})
+}
+
+// WithErrorField configures the key for the error field, which defaults to
+// "err".
+func WithErrorField(field string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.errorField = &field
+	})
+}
+
+// encodeField pre-emptively encodes the field as a JSON string, returning an
+// empty string if the field is empty.
+func encodeField(field *string, defaultValue string) string {
+	v := defaultValue
+	if field != nil {
+		v = *field
+	}
+	if v == `` {
+		return ``
+	}
+	return string(jsonenc.AppendString(nil, v))
+}
@@ -0,0 +1,213 @@
+package jsonl
+
+import (
+	"github.com/joeycumines/logiface"
+	"io"
+	"sync"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser],
+	// [logiface.Writer], and [logiface.JSONSupport], for [Event].
+	// See also [NewLogger], and [WithJSONL].
+	Logger struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedJSONSupport
+
+		writer io.Writer
+
+		// these are pre-emptively json encoded
+
+		timeField    string
+		levelField   string
+		messageField string
+		errorField   string
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedJSONSupport = logiface.UnimplementedJSONSupport[*Event, *Event, *Event]
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]                = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event]               = (*Logger)(nil)
+	_ logiface.Writer[*Event]                      = (*Logger)(nil)
+	_ logiface.JSONSupport[*Event, *Event, *Event] = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{
+			buf: make([]byte, 0, 1<<10),
+			off: make([]int, 0, 8),
+		}
+	}}
+	timeNow = time.Now
+)
+
+func (x *Logger) NewEvent(level logiface.Level) (e *Event) {
+	e = eventPool.Get().(*Event)
+
+	e.logger = x
+	e.lvl = level
+	e.groups = 0
+	e.msg = ``
+	e.hasMsg = false
+
+	// note: off isn't reset when added back to the pool
+	e.off = e.off[:0]
+
+	// note: buf isn't reset when added back to the pool
+	e.buf = append(e.buf[:0], '{')
+
+	if x.timeField != `` {
+		e.buf = append(e.buf, x.timeField...)
+		e.buf = append(e.buf, ':')
+		e.appendTime(timeNow())
+	}
+
+	if x.levelField != `` {
+		e.appendFieldSeparator()
+		e.buf = append(e.buf, x.levelField...)
+		e.buf = append(e.buf, ':', '"')
+		e.buf = append(e.buf, level.String()...)
+		e.buf = append(e.buf, '"')
+	}
+
+	return
+}
+
+// Write writes the event to the underlying io.Writer, as a single line of
+// JSON, terminated by a newline.
+func (x *Logger) Write(event *Event) (err error) {
+	n := event.finish()
+	_, err = x.writer.Write(event.buf)
+	event.buf = event.buf[:n] // restore event.buf, to match the Bytes docs
+	return
+}
+
+// AppendEvent appends the event to dst, exactly as it would be written, i.e.
+// as a single line of JSON, terminated by a newline. It may be used to
+// snapshot events, which must not be retained, e.g. by a [logiface.Writer].
+func (x *Logger) AppendEvent(dst []byte, event *Event) []byte {
+	n := event.finish()
+	dst = append(dst, event.buf...)
+	event.buf = event.buf[:n]
+	return dst
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.buf) <= 1<<16 && cap(e.off) <= 1<<13 {
+		// clear references that might need to be garbage collected
+		e.logger = nil
+		e.msg = ``
+
+		eventPool.Put(e)
+	}
+}
+
+func (x *Logger) NewObject() *Event {
+	panic(`jsonl.Logger.NewObject() should never be called`)
+}
+
+func (x *Logger) CanAddStartObject() bool { return true }
+
+func (x *Logger) AddStartObject(evt *Event, key string) *Event {
+	return x.SetStartObject(evt, key)
+}
+
+func (x *Logger) CanSetStartObject() bool { return true }
+
+func (x *Logger) SetStartObject(obj *Event, key string) *Event {
+	obj.enterKey(key)
+	obj.buf = append(obj.buf, '{')
+	return obj
+}
+
+func (x *Logger) CanSetStartArray() bool { return true }
+
+func (x *Logger) SetStartArray(obj *Event, key string) *Event {
+	obj.enterKey(key)
+	obj.buf = append(obj.buf, '[')
+	return obj
+}
+
+func (x *Logger) CanSetObject() bool { return true }
+
+func (x *Logger) SetObject(obj *Event, key string, val *Event) *Event {
+	if obj != val {
+		panic(`jsonl.Logger.SetObject() should never be called with a different *Event`)
+	}
+	obj.exitKey(key)
+	obj.buf = append(obj.buf, '}')
+	return obj
+}
+
+func (x *Logger) CanSetArray() bool { return true }
+
+func (x *Logger) SetArray(obj *Event, key string, val *Event) *Event {
+	if obj != val {
+		panic(`jsonl.Logger.SetArray() should never be called with a different *Event`)
+	}
+	obj.exitKey(key)
+	obj.buf = append(obj.buf, ']')
+	return obj
+}
+
+func (x *Logger) AddObject(evt *Event, key string, obj *Event) {
+	x.SetObject(evt, key, obj)
+}
+
+func (x *Logger) NewArray() *Event {
+	panic(`jsonl.Logger.NewArray() should never be called`)
+}
+
+func (x *Logger) CanAddStartArray() bool { return true }
+
+func (x *Logger) AddStartArray(evt *Event, key string) *Event {
+	return x.SetStartArray(evt, key)
+}
+
+func (x *Logger) CanAppendStartObject() bool { return true }
+
+func (x *Logger) AppendStartObject(arr *Event) *Event {
+	arr.appendArraySeparator()
+	arr.buf = append(arr.buf, '{')
+	return arr
+}
+
+func (x *Logger) CanAppendStartArray() bool { return true }
+
+func (x *Logger) AppendStartArray(arr *Event) *Event {
+	arr.appendArraySeparator()
+	arr.buf = append(arr.buf, '[')
+	return arr
+}
+
+func (x *Logger) AddArray(evt *Event, key string, arr *Event) {
+	x.SetArray(evt, key, arr)
+}
+
+func (x *Logger) CanAppendObject() bool { return true }
+
+func (x *Logger) AppendObject(arr *Event, val *Event) *Event {
+	if arr != val {
+		panic(`jsonl.Logger.AppendObject() should never be called with a different *Event`)
+	}
+	arr.buf = append(arr.buf, '}')
+	return arr
+}
+
+func (x *Logger) CanAppendArray() bool { return true }
+
+func (x *Logger) AppendArray(arr *Event, val *Event) *Event {
+	if arr != val {
+		panic(`jsonl.Logger.AppendArray() should never be called with a different *Event`)
+	}
+	arr.buf = append(arr.buf, ']')
+	return arr
+}
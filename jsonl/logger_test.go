@@ -0,0 +1,190 @@
+package jsonl
+
+import (
+	"bytes"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/eventtest"
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+type errWriter struct{ err error }
+
+func (x errWriter) Write([]byte) (int, error) { return 0, x.err }
+
+func TestNewLogger_defaults(t *testing.T) {
+	l := NewLogger()
+	if l.writer != os.Stderr {
+		t.Error(l.writer)
+	}
+	if l.timeField != `` || l.levelField != `"lvl"` || l.messageField != `"msg"` || l.errorField != `"err"` {
+		t.Errorf(`unexpected fields: %+v`, l)
+	}
+}
+
+func TestNewLogger_fields(t *testing.T) {
+	l := NewLogger(
+		WithTimeField(`t"s`),
+		WithLevelField(`level`),
+		WithMessageField(``),
+		WithErrorField(`error`),
+	)
+	if l.timeField != `"t\"s"` || l.levelField != `"level"` || l.messageField != `"msg"` || l.errorField != `"error"` {
+		t.Errorf(`unexpected fields: %+v`, l)
+	}
+}
+
+func TestLogger_timeField(t *testing.T) {
+	old := timeNow
+	defer func() { timeNow = old }()
+	timeNow = func() time.Time { return time.Unix(1680693679, 496235772) }
+
+	var buf bytes.Buffer
+	logger := L.New(L.WithJSONL(WithWriter(&buf), WithTimeField(`ts`)), L.WithLevel(logiface.LevelTrace))
+	logger.Trace().Err(errors.New(`some error`)).Log(`hello`)
+	logger.Build(100).Log(``)
+	if s := buf.String(); s != `{"ts":"2023-04-05T11:21:19.496235772Z","lvl":"trace","err":"some error","msg":"hello"}`+"\n"+
+		`{"ts":"2023-04-05T11:21:19.496235772Z","lvl":"100"}`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_Write_error(t *testing.T) {
+	expected := errors.New(`some error`)
+	logger := L.New(L.WithJSONL(WithWriter(errWriter{expected})))
+	if err := logger.Log(logiface.LevelError, nil); err != expected {
+		t.Error(err)
+	}
+}
+
+func TestLogger_ReleaseEvent_oversized(t *testing.T) {
+	l := NewLogger(WithWriter(io.Discard))
+	e := l.NewEvent(logiface.LevelInformational)
+	e.buf = make([]byte, 0, 1<<17)
+	l.ReleaseEvent(e)
+	if e.logger != l {
+		t.Error(`expected oversized event to be discarded, without being reset`)
+	}
+}
+
+func TestLogger_allocs(t *testing.T) {
+	logger := L.New(L.WithJSONL(WithWriter(io.Discard), WithTimeField(`time`)))
+	b := []byte(`bytes`)
+	if n := testing.AllocsPerRun(100, func() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Time(`time`, time.Time{}).
+			Base64(`base64`, b, nil).
+			Object().
+			Str(`a`, `b`).
+			Array().Int(1).Int(2).As(`c`).
+			As(`obj`).End().
+			Log(`the message`)
+	}); n != 0 {
+		t.Errorf(`unexpected allocs: %v`, n)
+	}
+}
+
+func BenchmarkLogger_message(b *testing.B) {
+	logger := L.New(L.WithJSONL(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_fields(b *testing.B) {
+	logger := L.New(L.WithJSONL(WithWriter(io.Discard), WithTimeField(`time`)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_nested(b *testing.B) {
+	logger := L.New(L.WithJSONL(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Object().
+			Str(`a`, `b`).
+			Array().Int(1).Int(2).Object().Bool(`c`, true).Add().As(`d`).
+			As(`obj`).End().
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_parallel(b *testing.B) {
+	logger := L.New(L.WithJSONL(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			logger.Info().
+				Str(`str`, `some string`).
+				Int(`int`, 123).
+				Log(`The quick brown fox jumps over the lazy dog`)
+		}
+	})
+}
+
+func TestLogger_AppendEvent(t *testing.T) {
+	var buf bytes.Buffer
+	var snapshot []byte
+	l := NewLogger(WithWriter(&buf))
+	logger := L.New(
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+		L.WithWriter(L.NewWriterFunc(func(event *Event) error {
+			snapshot = l.AppendEvent([]byte(`prefix `), event)
+			return l.Write(event)
+		})),
+	)
+	logger.Info().Group(`g`).Str(`k`, `v`).Log(`m`)
+	if s := string(snapshot); s != `prefix {"lvl":"info","g":{"k":"v"},"msg":"m"}`+"\n" {
+		t.Errorf("unexpected snapshot: %q", s)
+	}
+	if s := buf.String(); s != `{"lvl":"info","g":{"k":"v"},"msg":"m"}`+"\n" {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
+
+func TestLogger_JSONSupport_conformance(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(WithWriter(&buf))
+	eventtest.RunJSONSupport[*Event, *Event, *Event](t, eventtest.Config[*Event]{
+		EventFactory:  l,
+		Writer:        l,
+		EventReleaser: l,
+		Decode:        eventtest.JSONDecoder(&buf, `lvl`, `msg`, `err`),
+	}, l)
+}
+
+func FuzzLogger_JSONSupport(f *testing.F) {
+	var buf bytes.Buffer
+	l := NewLogger(WithWriter(&buf))
+	eventtest.FuzzJSONSupport[*Event, *Event, *Event](f, eventtest.Config[*Event]{
+		EventFactory:  l,
+		Writer:        l,
+		EventReleaser: l,
+		Decode:        eventtest.JSONDecoder(&buf, `lvl`, `msg`, `err`),
+	}, l)
+}
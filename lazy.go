@@ -0,0 +1,182 @@
+package logiface
+
+import (
+	"time"
+)
+
+// Implementations of the lazy field methods (e.g. Func), which defer calling
+// the provided function until the event is about to be written, i.e. after
+// the level has been checked, and after any rate limiting (see
+// [Builder.Limit]), and all modifiers, have been applied.
+//
+// Lazy fields are added to the event after all other fields, but before the
+// message. Nil functions are ignored.
+
+// applyLazy is the Logger.lazy modifier of loggers derived from a Context
+func (x *Context[E]) applyLazy(event E) error {
+	return x.lazy.Modify(event)
+}
+
+func builderLazyField[E Event, V any](x *Builder[E], key string, fn func() V, add func(x modifierMethods[E], event E, key string, val V) error) *Builder[E] {
+	if x.Enabled() && fn != nil && x.Event.Level().Enabled() {
+		methods := x.methods
+		x.lazy = append(x.lazy, ModifierFunc[E](func(event E) error {
+			return add(methods, event, key, fn())
+		}))
+	}
+	return x
+}
+
+func contextLazyField[E Event, V any](x *Context[E], key string, fn func() V, add func(x modifierMethods[E], event E, key string, val V) error) *Context[E] {
+	if x.Enabled() && fn != nil {
+		x.lazy = append(x.lazy, ModifierFunc[E](func(event E) error {
+			return add(x.methods, event, key, fn())
+		}))
+		if x.logger.lazy == nil {
+			x.logger.lazy = ModifierFunc[E](x.applyLazy)
+		}
+	}
+	return x
+}
+
+// Func adds a structured log field, with the value returned by fn, per
+// [Context.Field]. The function is called for each event that is written,
+// see also [Builder.Func].
+func (x *Context[E]) Func(key string, fn func() any) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Field)
+}
+
+// Func adds a structured log field, with the value returned by fn, per
+// [Builder.Field]. The function is called only if the event is written, i.e.
+// after the level, and any rate limit, has been checked. This is useful for
+// fields that are expensive to compute.
+func (x *Builder[E]) Func(key string, fn func() any) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Field)
+}
+
+// Func adds a structured log field, with the value returned by fn, per
+// [ObjectBuilder.Field]. If the object belongs to a [Context], the function
+// is called for each event, as the object is added to it, otherwise it is
+// called immediately.
+//
+// Unlike [Builder.Func], the function may be called prior to any rate
+// limiting, as objects are added to the event as they are built (or, for a
+// Context, as the modifier is applied). Prefer [Builder.Func] or
+// [Context.Func] where possible.
+func (x *ObjectBuilder[E, P]) Func(key string, fn func() any) *ObjectBuilder[E, P] {
+	if x.Enabled() && fn != nil {
+		if o, ok := x.b.(*contextFieldData[E]); ok {
+			// deferred, using a temporary Builder as the parent, which
+			// supports setting fields on the (per event) object directly
+			o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+				b := shared.newBuilder(*new(E))
+				defer b.release(false)
+				v := (*ObjectBuilder[E, *Builder[E]])(refPoolGet())
+				defer refPoolPut((*refPoolItem)(v))
+				v.a = b
+				v.b = obj
+				v.schema = shared.schema
+				return v.Field(key, fn()).b
+			})
+		} else {
+			x.Field(key, fn())
+		}
+	}
+	return x
+}
+
+// StrFunc adds a string as a structured log field, with the value returned
+// by fn, per [Context.Str] and [Context.Func].
+func (x *Context[E]) StrFunc(key string, fn func() string) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Str)
+}
+
+// StrFunc adds a string as a structured log field, with the value returned
+// by fn, per [Builder.Str] and [Builder.Func].
+func (x *Builder[E]) StrFunc(key string, fn func() string) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Str)
+}
+
+// IntFunc adds an int as a structured log field, with the value returned by
+// fn, per [Context.Int] and [Context.Func].
+func (x *Context[E]) IntFunc(key string, fn func() int) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Int)
+}
+
+// IntFunc adds an int as a structured log field, with the value returned by
+// fn, per [Builder.Int] and [Builder.Func].
+func (x *Builder[E]) IntFunc(key string, fn func() int) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Int)
+}
+
+// Int64Func adds an int64 as a structured log field, with the value returned
+// by fn, per [Context.Int64] and [Context.Func].
+func (x *Context[E]) Int64Func(key string, fn func() int64) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Int64)
+}
+
+// Int64Func adds an int64 as a structured log field, with the value returned
+// by fn, per [Builder.Int64] and [Builder.Func].
+func (x *Builder[E]) Int64Func(key string, fn func() int64) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Int64)
+}
+
+// Uint64Func adds a uint64 as a structured log field, with the value
+// returned by fn, per [Context.Uint64] and [Context.Func].
+func (x *Context[E]) Uint64Func(key string, fn func() uint64) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Uint64)
+}
+
+// Uint64Func adds a uint64 as a structured log field, with the value
+// returned by fn, per [Builder.Uint64] and [Builder.Func].
+func (x *Builder[E]) Uint64Func(key string, fn func() uint64) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Uint64)
+}
+
+// Float64Func adds a float64 as a structured log field, with the value
+// returned by fn, per [Context.Float64] and [Context.Func].
+func (x *Context[E]) Float64Func(key string, fn func() float64) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Float64)
+}
+
+// Float64Func adds a float64 as a structured log field, with the value
+// returned by fn, per [Builder.Float64] and [Builder.Func].
+func (x *Builder[E]) Float64Func(key string, fn func() float64) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Float64)
+}
+
+// BoolFunc adds a bool as a structured log field, with the value returned
+// by fn, per [Context.Bool] and [Context.Func].
+func (x *Context[E]) BoolFunc(key string, fn func() bool) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Bool)
+}
+
+// BoolFunc adds a bool as a structured log field, with the value returned
+// by fn, per [Builder.Bool] and [Builder.Func].
+func (x *Builder[E]) BoolFunc(key string, fn func() bool) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Bool)
+}
+
+// DurFunc adds a time.Duration as a structured log field, with the value
+// returned by fn, per [Context.Dur] and [Context.Func].
+func (x *Context[E]) DurFunc(key string, fn func() time.Duration) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Dur)
+}
+
+// DurFunc adds a time.Duration as a structured log field, with the value
+// returned by fn, per [Builder.Dur] and [Builder.Func].
+func (x *Builder[E]) DurFunc(key string, fn func() time.Duration) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Dur)
+}
+
+// TimeFunc adds a time.Time as a structured log field, with the value
+// returned by fn, per [Context.Time] and [Context.Func].
+func (x *Context[E]) TimeFunc(key string, fn func() time.Time) *Context[E] {
+	return contextLazyField(x, key, fn, modifierMethods[E].Time)
+}
+
+// TimeFunc adds a time.Time as a structured log field, with the value
+// returned by fn, per [Builder.Time] and [Builder.Func].
+func (x *Builder[E]) TimeFunc(key string, fn func() time.Time) *Builder[E] {
+	return builderLazyField(x, key, fn, modifierMethods[E].Time)
+}
@@ -0,0 +1,147 @@
+package logiface
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuilder_Func(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+		mockL.WithCategoryRateLimits(map[time.Duration]int{time.Hour: 1}),
+	)
+	var calls int
+	fn := func() any {
+		calls++
+		return calls
+	}
+	l.Debug().Func(`disabled`, fn).Log(`disabled`)
+	l.Info().Func(`released`, fn).Release()
+	for range 2 {
+		l.Info().Str(`a`, `b`).Func(`calls`, fn).Limit().Log(`limited`)
+	}
+	// the rate limit warning is added prior to the lazy fields
+	if s := buf.String(); !strings.HasPrefix(s, `[info] a=b _limited=map[`) || !strings.HasSuffix(s, "] calls=1 msg=limited\n") {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	buf.Reset()
+	l.Info().
+		StrFunc(`str`, func() string { return `s` }).
+		IntFunc(`int`, func() int { return 1 }).
+		Int64Func(`int64`, func() int64 { return 2 }).
+		Uint64Func(`uint64`, func() uint64 { return 3 }).
+		Float64Func(`float64`, func() float64 { return 4.5 }).
+		BoolFunc(`bool`, func() bool { return true }).
+		DurFunc(`dur`, func() time.Duration { return time.Second }).
+		TimeFunc(`time`, func() time.Time { return time.Unix(0, 0) }).
+		Func(`nil`, nil).
+		Log(`typed`)
+	if calls != 1 {
+		t.Errorf(`unexpected calls: %d`, calls)
+	}
+	if s := buf.String(); s != "[info] str=s int=1 int64=2 uint64=3 float64=4.5 bool=true dur=1s time=1970-01-01T00:00:00Z msg=typed\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestContext_Func(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+		mockL.WithCategoryRateLimits(map[time.Duration]int{time.Hour: 1}),
+	)
+	var calls, objectCalls int
+	c := l.Clone().
+		Func(`calls`, func() any {
+			calls++
+			return calls
+		}).
+		Str(`a`, `b`).
+		ObjectFunc(`obj`, func(b *ObjectBuilder[*mockSimpleEvent, *Chain[*mockSimpleEvent, *Context[*mockSimpleEvent]]]) {
+			b.Func(`dur`, func() any {
+				objectCalls++
+				return time.Duration(objectCalls) * time.Second
+			})
+		})
+	if calls != 0 || objectCalls != 0 {
+		t.Fatal(calls, objectCalls)
+	}
+	sub := c.Logger().Clone().IntFunc(`sub`, func() int { return 3 }).Logger()
+	c.Logger().Debug().Log(`disabled`)
+	sub.Info().Log(`one`)
+	if err := c.Logger().Log(LevelNotice, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "[info] a=b obj=map[dur:1s] calls=1 sub=3 msg=one\n"+
+		"[notice] a=b obj=map[dur:2s] calls=2\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	buf.Reset()
+	for range 2 {
+		c.Logger().Info().Limit().Log(`limited`)
+	}
+	// note that the object is added by the (non-lazy) modifier
+	if calls != 3 || objectCalls != 4 {
+		t.Error(calls, objectCalls)
+	}
+	if s := buf.String(); !strings.HasPrefix(s, `[info] a=b obj=map[dur:3s] _limited=map[`) || !strings.HasSuffix(s, "] calls=3 msg=limited\n") {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestBuilder_Func_modifierError(t *testing.T) {
+	newLogger := func(buf *bytes.Buffer) *Logger[*mockSimpleEvent] {
+		return New(
+			mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+			mockL.WithWriter(&mockSimpleWriter{Writer: buf}),
+		)
+	}
+	failing := ModifierFunc[*mockSimpleEvent](func(event *mockSimpleEvent) error {
+		return errors.New(`some error`)
+	})
+
+	t.Run(`panic`, func(t *testing.T) {
+		var buf bytes.Buffer
+		b := newLogger(&buf).Panic().StrFunc(`k`, func() string { return `v` })
+		b.lazy = append(b.lazy, failing)
+		defer func() {
+			if r := recover(); r != `panic msg` {
+				t.Errorf(`unexpected recover: %v`, r)
+			}
+			// the event is dropped, but the error is reported
+			if s := buf.String(); s != "[crit] err=some error msg=modifier error\n" {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		}()
+		b.Log(`panic msg`)
+		t.Error(`expected panic`)
+	})
+
+	t.Run(`fatal`, func(t *testing.T) {
+		old := OsExit
+		defer func() { OsExit = old }()
+		var exited int
+		OsExit = func(code int) {
+			if code != 1 {
+				t.Errorf(`unexpected code: %d`, code)
+			}
+			exited++
+		}
+		var buf bytes.Buffer
+		b := newLogger(&buf).Fatal().StrFunc(`k`, func() string { return `v` })
+		b.lazy = append(b.lazy, failing)
+		b.Log(`fatal msg`)
+		if exited != 1 {
+			t.Errorf(`unexpected exits: %d`, exited)
+		}
+		if s := buf.String(); s != "[crit] err=some error msg=modifier error\n" {
+			t.Errorf("unexpected output: %q\n%s", s, s)
+		}
+	})
+}
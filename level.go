@@ -1,7 +1,10 @@
 package logiface
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+	"sync/atomic"
 )
 
 const (
@@ -108,6 +111,14 @@ type (
 	//   - LevelDebug => DEBUG
 	//   - LevelTrace => TRACE (or disabled)
 	Level int8
+
+	// LevelVar is a [Level] variable, allowing the level of a logger to be
+	// changed at runtime. It is safe for concurrent use. The zero value is
+	// [LevelInformational]. See also [WithLevelVar], and [Context.LevelVar].
+	LevelVar struct {
+		// offset from LevelInformational, so the zero value is useful
+		v atomic.Int32
+	}
 )
 
 // String implements fmt.Stringer, note that it uses the short keyword (for the actual syslog levels).
@@ -138,6 +149,39 @@ func (x Level) String() string {
 	}
 }
 
+// ParseLevel parses a level, case-insensitively, accepting the keywords
+// returned by [Level.String], their long forms (e.g. "emergency", "critical",
+// "error", "informational"), the common aliases "panic", "fatal", "warn", and
+// "off", and integers (e.g. custom levels).
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case `disabled`, `off`:
+		return LevelDisabled, nil
+	case `emerg`, `emergency`, `panic`:
+		return LevelEmergency, nil
+	case `alert`, `fatal`:
+		return LevelAlert, nil
+	case `crit`, `critical`:
+		return LevelCritical, nil
+	case `err`, `error`:
+		return LevelError, nil
+	case `warning`, `warn`:
+		return LevelWarning, nil
+	case `notice`:
+		return LevelNotice, nil
+	case `info`, `informational`:
+		return LevelInformational, nil
+	case `debug`:
+		return LevelDebug, nil
+	case `trace`:
+		return LevelTrace, nil
+	}
+	if v, err := strconv.ParseInt(s, 10, 8); err == nil {
+		return Level(v), nil
+	}
+	return LevelDisabled, fmt.Errorf(`logiface: invalid level: %q`, s)
+}
+
 // Enabled returns true if the Level is enabled (greater than or equal to 0).
 func (x Level) Enabled() bool { return x > LevelDisabled }
 
@@ -147,6 +191,28 @@ func (x Level) Custom() bool { return x > LevelTrace }
 // Syslog returns true if the Level is a syslog level.
 func (x Level) Syslog() bool { return x >= LevelEmergency && x <= LevelDebug }
 
+// NewLevelVar initializes a new [LevelVar], with the given level.
+func NewLevelVar(level Level) *LevelVar {
+	var v LevelVar
+	v.Set(level)
+	return &v
+}
+
+// Level returns the current level.
+func (x *LevelVar) Level() Level {
+	return Level(x.v.Load() + int32(LevelInformational))
+}
+
+// Set changes the level, affecting all loggers using this variable.
+func (x *LevelVar) Set(level Level) {
+	x.v.Store(int32(level) - int32(LevelInformational))
+}
+
+// String implements fmt.Stringer, see also [Level.String].
+func (x *LevelVar) String() string {
+	return x.Level().String()
+}
+
 // for convenience, expose the level enums as methods on LoggerFactory
 
 // LevelDisabled returns LevelDisabled, and is provided as a convenience for
@@ -170,3 +170,59 @@ func TestLoggerFactory_LevelTrace(t *testing.T) {
 		t.Errorf("unexpected value: %v", v)
 	}
 }
+
+func TestParseLevel(t *testing.T) {
+	for _, tc := range [...]struct {
+		S     string
+		Level Level
+		Err   bool
+	}{
+		{S: `disabled`, Level: LevelDisabled},
+		{S: `OFF`, Level: LevelDisabled},
+		{S: `emerg`, Level: LevelEmergency},
+		{S: `Panic`, Level: LevelEmergency},
+		{S: `fatal`, Level: LevelAlert},
+		{S: `critical`, Level: LevelCritical},
+		{S: `error`, Level: LevelError},
+		{S: `warn`, Level: LevelWarning},
+		{S: `notice`, Level: LevelNotice},
+		{S: `INFO`, Level: LevelInformational},
+		{S: `informational`, Level: LevelInformational},
+		{S: `debug`, Level: LevelDebug},
+		{S: `trace`, Level: LevelTrace},
+		{S: `9`, Level: 9},
+		{S: `-1`, Level: LevelDisabled},
+		{S: `128`, Level: LevelDisabled, Err: true},
+		{S: ``, Level: LevelDisabled, Err: true},
+		{S: `verbose`, Level: LevelDisabled, Err: true},
+	} {
+		level, err := ParseLevel(tc.S)
+		if level != tc.Level || (err != nil) != tc.Err {
+			t.Errorf("%q: unexpected result: %v, %v", tc.S, level, err)
+		}
+	}
+	for level := LevelDisabled; ; level++ {
+		if v, err := ParseLevel(level.String()); v != level || err != nil {
+			t.Errorf("%d: unexpected result: %v, %v", level, v, err)
+		}
+		if level == math.MaxInt8 {
+			break
+		}
+	}
+}
+
+func TestLevelVar(t *testing.T) {
+	var v LevelVar
+	if v.Level() != LevelInformational || v.String() != `info` {
+		t.Error(v.Level())
+	}
+	for _, level := range [...]Level{math.MinInt8, LevelDisabled, LevelEmergency, LevelTrace, math.MaxInt8} {
+		v.Set(level)
+		if v.Level() != level {
+			t.Error(level, v.Level())
+		}
+		if NewLevelVar(level).Level() != level {
+			t.Error(level)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	runtimeutil "github.com/joeycumines/logiface/internal/runtime"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,6 +20,35 @@ type (
 	limitConfig struct {
 	}
 
+	// CategoryRateLimitState models the observed state of a single category,
+	// for category-based rate limiting, see
+	// [Logger.CategoryRateLimitState].
+	CategoryRateLimitState struct {
+		// Next is when the category will next be allowed, as of the most
+		// recent event, or zero, if it was not limited.
+		Next     time.Time
+		Function string
+		File     string
+		Entry    uintptr
+		Line     int
+		// Allowed is the number of events that were allowed.
+		Allowed uint64
+		// Limited is the number of events that were dropped.
+		Limited uint64
+	}
+
+	// limitStats tracks the state of each category, since the rate limiter
+	// doesn't expose it
+	limitStats struct {
+		categories sync.Map // callerForRateLimiting -> *limitCategoryStats
+	}
+
+	limitCategoryStats struct {
+		next    atomic.Int64 // unix nanoseconds, or 0
+		allowed atomic.Uint64
+		limited atomic.Uint64
+	}
+
 	// WARNING: Omits PC because it may differ for the same code location in
 	// cases where inlining occurs, which is not desirable for rate limiting.
 	callerForRateLimiting struct {
@@ -101,21 +133,85 @@ func (x *loggerShared[E]) catrateAllowCaller(skip int) (caller runtimeutil.Calle
 		ok = true
 	} else {
 		next, ok = x.catrate.Allow(callerForRateLimiting(caller))
+		x.limits.record(callerForRateLimiting(caller), next, ok)
 	}
 	return
 }
 
+// CategoryRateLimitState returns the observed state of each category (i.e.
+// caller) that has been subject to category-based rate limiting, sorted by
+// file, line, then function. If the receiver is nil, or otherwise not
+// configured for category-based rate limiting, this method returns nil.
+//
+// See also [WithCategoryRateLimits].
+func (x *Logger[E]) CategoryRateLimitState() (categories []CategoryRateLimitState) {
+	if x == nil || x.shared == nil || x.shared.limits == nil {
+		return nil
+	}
+	x.shared.limits.categories.Range(func(key, value any) bool {
+		k := key.(callerForRateLimiting)
+		v := value.(*limitCategoryStats)
+		c := CategoryRateLimitState{
+			Function: k.Function,
+			File:     k.File,
+			Entry:    k.Entry,
+			Line:     k.Line,
+			Allowed:  v.allowed.Load(),
+			Limited:  v.limited.Load(),
+		}
+		if next := v.next.Load(); next != 0 {
+			c.Next = time.Unix(0, next)
+		}
+		categories = append(categories, c)
+		return true
+	})
+	sort.Slice(categories, func(i, j int) bool {
+		a, b := &categories[i], &categories[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Function < b.Function
+	})
+	return categories
+}
+
+func (x *limitStats) record(category callerForRateLimiting, next time.Time, ok bool) {
+	if x == nil {
+		return
+	}
+	v, _ := x.categories.Load(category)
+	if v == nil {
+		v, _ = x.categories.LoadOrStore(category, new(limitCategoryStats))
+	}
+	stats := v.(*limitCategoryStats)
+	if ok {
+		stats.allowed.Add(1)
+	} else {
+		stats.limited.Add(1)
+	}
+	if next == (time.Time{}) {
+		stats.next.Store(0)
+	} else {
+		stats.next.Store(next.UnixNano())
+	}
+}
+
 func (x *Builder[E]) attachCallerRateLimitWarning(caller runtimeutil.Caller, next time.Time) {
-	x.ObjectFunc(`_limited`, func(b *ObjectBuilder[E, *Chain[E, *Builder[E]]]) {
+	schema := x.shared.schema
+	x.ObjectFunc(schema.limitedKey(), func(b *ObjectBuilder[E, *Chain[E, *Builder[E]]]) {
 		b.
-			ObjectFunc(`category`, func(b *ObjectBuilder[E, *Chain[E, *Builder[E]]]) {
+			ObjectFunc(schema.limitedCategoryKey(), func(b *ObjectBuilder[E, *Chain[E, *Builder[E]]]) {
 				// TODO should have a nicer formatter for uintptr
 				b.Str(`function`, caller.Function).
 					Uint64(`entry`, uint64(caller.Entry)).
 					Str(`file`, caller.File).
 					Int(`line`, caller.Line)
 			}).
-			Time(`next`, next).
-			Dur(`until`, time.Until(next))
+			Time(schema.limitedNextKey(), next).
+			// note: next is from the rate limiter, which uses the system clock
+			Dur(schema.limitedUntilKey(), time.Until(next))
 	})
 }
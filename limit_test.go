@@ -5,6 +5,7 @@ import (
 	"io"
 	"math"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"sync/atomic"
 	"testing"
@@ -241,3 +242,88 @@ func TestLoggerShared_catrateAllowCaller_noCaller(t *testing.T) {
 		t.Error(ok)
 	}
 }
+
+func TestLogger_CategoryRateLimitState(t *testing.T) {
+	var buf bytes.Buffer
+	logger := categoryRateLimitTestFactory(&buf)
+
+	if v := logger.CategoryRateLimitState(); len(v) != 0 {
+		t.Fatal(v)
+	}
+
+	start := time.Now()
+	for range 12 {
+		logger.Info().Limit().Log(`a`)
+	}
+
+	// note: the caller skips this package, including the tests
+	state := logger.CategoryRateLimitState()
+	if len(state) != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	if v := state[0]; v.Function == `` || v.File == `` || v.Entry == 0 || v.Line == 0 ||
+		v.Allowed != 10 || v.Limited != 2 ||
+		v.Next.Before(start) || v.Next.After(time.Now().Add(time.Millisecond*300)) {
+		t.Errorf("unexpected category: %+v", v)
+	}
+
+	// the generified logger shares the rate limiter, and state
+	generic := logger.Logger()
+	if v := generic.CategoryRateLimitState(); !reflect.DeepEqual(v, state) {
+		t.Errorf("unexpected state: %+v", v)
+	}
+	generic.Info().Limit().Log(`a`)
+	if v := logger.CategoryRateLimitState(); len(v) != 1 || v[0].Limited != 3 {
+		t.Errorf("unexpected state: %+v", v)
+	}
+
+	if v := New[*mockSimpleEvent]().CategoryRateLimitState(); v != nil {
+		t.Error(v)
+	}
+	if v := (*Logger[*mockSimpleEvent])(nil).CategoryRateLimitState(); v != nil {
+		t.Error(v)
+	}
+}
+
+func TestBuilder_Limit_clock(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	w := mockComplexWriter{}
+	logger := New[*mockComplexEvent](
+		WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+		WithWriter[*mockComplexEvent](&w),
+		WithCategoryRateLimits[*mockComplexEvent](map[time.Duration]int{time.Hour: 1}),
+		WithClock[*mockComplexEvent](func() time.Time { return epoch }),
+	)
+	for range 2 {
+		logger.Info().Limit().Log(`test`)
+	}
+	// the last allowed event has the _limited field
+	if len(w.events) != 1 {
+		t.Fatal(len(w.events))
+	}
+	var limited map[string]any
+	for _, field := range w.events[0].FieldValues {
+		if field.Key == `_limited` {
+			limited, _ = field.Value.(map[string]any)
+		}
+	}
+	next, ok := limited[`next`].(string)
+	if !ok {
+		t.Fatalf(`unexpected next: %#v`, limited[`next`])
+	}
+	nextTime, err := time.Parse(time.RFC3339Nano, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the rate limiter uses the system clock, not the configured clock
+	if d := time.Until(nextTime); d <= 0 || d > time.Hour {
+		t.Errorf(`unexpected next: %s`, nextTime)
+	}
+	until, ok := limited[`until`].(string)
+	if !ok {
+		t.Fatalf(`unexpected until: %#v`, limited[`until`])
+	}
+	if d, err := time.ParseDuration(until); err != nil || d <= 0 || d > time.Hour {
+		t.Errorf(`unexpected until: %q`, until)
+	}
+}
@@ -0,0 +1,14 @@
+// Package logadmin implements an [http.Handler], for runtime control, and
+// inspection, of logiface loggers, intended to be mounted on a debug port.
+//
+// The handler may expose:
+//
+//   - The levels of a tree of named loggers, which may be changed, see
+//     [Levels], and [WithLevels]
+//   - The state of category-based rate limiting, see
+//     [logiface.WithCategoryRateLimits], and [WithRateLimits]
+//   - Recent events, retained by a ring buffer, see package flightrec, and
+//     [WithRecorder]
+//
+// See [NewHandler] for the supported routes.
+package logadmin
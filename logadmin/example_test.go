@@ -0,0 +1,52 @@
+package logadmin_test
+
+import (
+	"fmt"
+	"github.com/joeycumines/logiface/jsonl"
+	"github.com/joeycumines/logiface/logadmin"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+)
+
+// Demonstrates changing the level of a named sub-logger, at runtime.
+func Example() {
+	levels := logadmin.NewLevels(nil)
+
+	logger := jsonl.L.New(
+		jsonl.L.WithJSONL(jsonl.WithWriter(os.Stdout)),
+		jsonl.L.WithLevelVar(levels.Root()),
+	)
+	db := logger.Clone().
+		LevelVar(levels.Var(`db`)).
+		Str(`logger`, `db`).
+		Logger()
+
+	mux := http.NewServeMux()
+	mux.Handle(`/debug/log/`, http.StripPrefix(`/debug/log`, logadmin.NewHandler(
+		logadmin.WithLevels(levels),
+		logadmin.WithRateLimits(logger),
+	)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	db.Debug().Log(`not logged`)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+`/debug/log/levels/db`, strings.NewReader(`{"level":"debug"}`))
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	fmt.Print(string(body))
+
+	db.Debug().Log(`logged`)
+	logger.Debug().Log(`not logged`)
+
+	//output:
+	//[{"name":"","level":"info","explicit":true},{"name":"db","level":"debug","explicit":true}]
+	//{"lvl":"debug","logger":"db","msg":"logged"}
+}
@@ -0,0 +1,301 @@
+package logadmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/flightrec"
+	"io"
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+type (
+	// Handler implements [http.Handler], see [NewHandler].
+	Handler struct {
+		levels    *Levels
+		maxLevels int
+		limits    RateLimits
+		recorder  Recorder
+		mux       *http.ServeMux
+	}
+
+	// RateLimits models a source of category-based rate limiting state,
+	// which is implemented by [logiface.Logger], see [WithRateLimits].
+	RateLimits interface {
+		CategoryRateLimitState() []logiface.CategoryRateLimitState
+	}
+
+	// Recorder models a source of recent events, which is implemented by
+	// [flightrec.Recorder], see [WithRecorder].
+	Recorder interface {
+		Records() []flightrec.Record
+	}
+
+	// Option models a configuration option for [NewHandler].
+	Option interface {
+		apply(c *handlerConfig)
+	}
+
+	optionFunc func(c *handlerConfig)
+
+	handlerConfig struct {
+		levels    *Levels
+		maxLevels int
+		limits    RateLimits
+		recorder  Recorder
+	}
+
+	levelJSON struct {
+		Name     string `json:"name"`
+		Level    string `json:"level"`
+		Explicit bool   `json:"explicit"`
+	}
+
+	setLevelJSON struct {
+		Level string `json:"level"`
+	}
+
+	categoryJSON struct {
+		Function string     `json:"function"`
+		File     string     `json:"file"`
+		Line     int        `json:"line"`
+		Entry    uint64     `json:"entry"`
+		Allowed  uint64     `json:"allowed"`
+		Limited  uint64     `json:"limited"`
+		Next     *time.Time `json:"next,omitempty"`
+	}
+
+	recordJSON struct {
+		Time  time.Time `json:"time"`
+		Level string    `json:"level"`
+		// Event is the event data, as raw JSON, if valid, otherwise as a
+		// string, if valid UTF-8, or base64
+		Event any `json:"event"`
+	}
+
+	errorJSON struct {
+		Error string `json:"error"`
+	}
+)
+
+const (
+	// DefaultMaxLevels is the default maximum number of names, registered in
+	// the [Levels], beyond which the handler will not register new names,
+	// see [WithMaxLevels].
+	DefaultMaxLevels = 256
+)
+
+var (
+	// errTooManyLevels is reported by PUT /levels/{name}, see WithMaxLevels
+	errTooManyLevels = errors.New(`logadmin: too many levels`)
+)
+
+var (
+	// compile time assertions
+
+	_ http.Handler = (*Handler)(nil)
+	_ Option       = optionFunc(nil)
+	_ RateLimits   = (*logiface.Logger[logiface.Event])(nil)
+	_ Recorder     = (*flightrec.Recorder[logiface.Event])(nil)
+)
+
+func (x optionFunc) apply(c *handlerConfig) { x(c) }
+
+// WithLevels configures the handler to expose, and allow changes to, the
+// given levels.
+func WithLevels(levels *Levels) Option {
+	return optionFunc(func(c *handlerConfig) {
+		c.levels = levels
+	})
+}
+
+// WithMaxLevels configures the maximum number of names, registered in the
+// [Levels], beyond which PUT /levels/{name} will reject names that are not
+// already registered, which defaults to [DefaultMaxLevels]. This bounds the
+// size of the registry, as the names are arbitrary. Names registered by the
+// application, e.g. via [Levels.Var], are not affected.
+func WithMaxLevels(n int) Option {
+	return optionFunc(func(c *handlerConfig) {
+		c.maxLevels = n
+	})
+}
+
+// WithRateLimits configures the handler to expose the state of
+// category-based rate limiting, e.g. of a [logiface.Logger], configured
+// using [logiface.WithCategoryRateLimits].
+func WithRateLimits(limits RateLimits) Option {
+	return optionFunc(func(c *handlerConfig) {
+		c.limits = limits
+	})
+}
+
+// WithRecorder configures the handler to expose the events retained by the
+// recorder, e.g. a [flightrec.Recorder].
+func WithRecorder(recorder Recorder) Option {
+	return optionFunc(func(c *handlerConfig) {
+		c.recorder = recorder
+	})
+}
+
+// NewHandler initializes a new [Handler], which serves the following routes,
+// relative to where it is mounted (see also [http.StripPrefix]), each of
+// which will respond with 404, unless configured:
+//
+//   - GET /levels responds with a JSON array, of the state of each level,
+//     see [Levels.State]
+//   - PUT /levels sets the root level, from a JSON object like
+//     {"level":"debug"}, accepting any value supported by
+//     [logiface.ParseLevel], and responds like GET /levels
+//   - PUT /levels/{name} sets the level for the given name, like PUT /levels,
+//     registering it, if necessary, up to a limit, see [WithMaxLevels]
+//   - DELETE /levels/{name} unsets the level for the given name, see
+//     [Levels.Unset], and responds like GET /levels
+//   - GET /ratelimits responds with a JSON array, of the state of each
+//     category, see [logiface.Logger.CategoryRateLimitState]
+//   - GET /events streams the recorded events, oldest first, as JSON lines,
+//     each of which is an object, with the time, level, and event, which is
+//     embedded as-is, if it is valid JSON
+//
+// Errors are reported as a JSON object like {"error":"message"}.
+func NewHandler(options ...Option) *Handler {
+	c := handlerConfig{maxLevels: DefaultMaxLevels}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	h := Handler{
+		levels:    c.levels,
+		maxLevels: c.maxLevels,
+		limits:    c.limits,
+		recorder:  c.recorder,
+		mux:       http.NewServeMux(),
+	}
+
+	if h.levels != nil {
+		h.mux.HandleFunc(`GET /levels`, h.getLevels)
+		h.mux.HandleFunc(`PUT /levels`, h.putLevel)
+		h.mux.HandleFunc(`PUT /levels/{name...}`, h.putLevel)
+		h.mux.HandleFunc(`DELETE /levels/{name...}`, h.deleteLevel)
+	}
+	if h.limits != nil {
+		h.mux.HandleFunc(`GET /ratelimits`, h.getRateLimits)
+	}
+	if h.recorder != nil {
+		h.mux.HandleFunc(`GET /events`, h.getEvents)
+	}
+
+	return &h
+}
+
+func (x *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	x.mux.ServeHTTP(w, r)
+}
+
+func (x *Handler) getLevels(w http.ResponseWriter, r *http.Request) {
+	state := x.levels.State()
+	levels := make([]levelJSON, len(state))
+	for i, v := range state {
+		levels[i] = levelJSON{
+			Name:     v.Name,
+			Level:    v.Level.String(),
+			Explicit: v.Explicit,
+		}
+	}
+	writeJSON(w, http.StatusOK, levels)
+}
+
+func (x *Handler) putLevel(w http.ResponseWriter, r *http.Request) {
+	var body setLevelJSON
+	decoder := json.NewDecoder(io.LimitReader(r.Body, 1<<16))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	level, err := logiface.ParseLevel(body.Level)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if !x.levels.setLimited(r.PathValue(`name`), level, x.maxLevels) {
+		writeError(w, http.StatusBadRequest, errTooManyLevels)
+		return
+	}
+	x.getLevels(w, r)
+}
+
+func (x *Handler) deleteLevel(w http.ResponseWriter, r *http.Request) {
+	x.levels.Unset(r.PathValue(`name`))
+	x.getLevels(w, r)
+}
+
+func (x *Handler) getRateLimits(w http.ResponseWriter, r *http.Request) {
+	state := x.limits.CategoryRateLimitState()
+	categories := make([]categoryJSON, len(state))
+	for i, v := range state {
+		categories[i] = categoryJSON{
+			Function: v.Function,
+			File:     v.File,
+			Line:     v.Line,
+			Entry:    uint64(v.Entry),
+			Allowed:  v.Allowed,
+			Limited:  v.Limited,
+		}
+		if !v.Next.IsZero() {
+			next := v.Next
+			categories[i].Next = &next
+		}
+	}
+	writeJSON(w, http.StatusOK, categories)
+}
+
+func (x *Handler) getEvents(w http.ResponseWriter, r *http.Request) {
+	records := x.recorder.Records()
+	w.Header().Set(`Content-Type`, `application/x-ndjson`)
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	for _, record := range records {
+		v := recordJSON{
+			Time:  record.Time,
+			Level: record.Level.String(),
+		}
+		if data := bytes.TrimSpace(record.Data); json.Valid(data) {
+			v.Event = json.RawMessage(data)
+		} else if utf8.Valid(record.Data) {
+			v.Event = string(record.Data)
+		} else {
+			v.Event = record.Data
+		}
+		if err := encoder.Encode(v); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if r.Context().Err() != nil {
+			return
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set(`Content-Type`, `application/json`)
+	w.WriteHeader(status)
+	_, _ = w.Write(append(b, '\n'))
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	b, _ := json.Marshal(errorJSON{Error: err.Error()})
+	w.Header().Set(`Content-Type`, `application/json`)
+	w.WriteHeader(status)
+	_, _ = w.Write(append(b, '\n'))
+}
@@ -0,0 +1,203 @@
+package logadmin
+
+import (
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/flightrec"
+	"github.com/joeycumines/logiface/jsonl"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func doRequest(t *testing.T, h http.Handler, method, target, body string) (int, string) {
+	t.Helper()
+	var r io.Reader
+	if body != `` {
+		r = strings.NewReader(body)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(method, target, r))
+	return w.Code, w.Body.String()
+}
+
+func TestHandler_levels(t *testing.T) {
+	levels := NewLevels(nil)
+	levels.Var(`db`)
+	h := NewHandler(WithLevels(levels))
+
+	for _, tc := range [...]struct {
+		Method string
+		Target string
+		Body   string
+		Code   int
+		Output string
+	}{
+		{
+			Method: http.MethodGet,
+			Target: `/levels`,
+			Code:   http.StatusOK,
+			Output: `[{"name":"","level":"info","explicit":true},{"name":"db","level":"info","explicit":false}]` + "\n",
+		},
+		{
+			Method: http.MethodPut,
+			Target: `/levels`,
+			Body:   `{"level":"WARN"}`,
+			Code:   http.StatusOK,
+			Output: `[{"name":"","level":"warning","explicit":true},{"name":"db","level":"warning","explicit":false}]` + "\n",
+		},
+		{
+			Method: http.MethodPut,
+			Target: `/levels/db.pool`,
+			Body:   `{"level":"trace"}`,
+			Code:   http.StatusOK,
+			Output: `[{"name":"","level":"warning","explicit":true},{"name":"db","level":"warning","explicit":false},{"name":"db.pool","level":"trace","explicit":true}]` + "\n",
+		},
+		{
+			Method: http.MethodPut,
+			Target: `/levels/db`,
+			Body:   `{"level":"verbose"}`,
+			Code:   http.StatusBadRequest,
+			Output: `{"error":"logiface: invalid level: \"verbose\""}` + "\n",
+		},
+		{
+			Method: http.MethodPut,
+			Target: `/levels/db`,
+			Body:   `{"lvl":"debug"}`,
+			Code:   http.StatusBadRequest,
+			Output: `{"error":"json: unknown field \"lvl\""}` + "\n",
+		},
+		{
+			Method: http.MethodDelete,
+			Target: `/levels/db.pool`,
+			Code:   http.StatusOK,
+			Output: `[{"name":"","level":"warning","explicit":true},{"name":"db","level":"warning","explicit":false},{"name":"db.pool","level":"warning","explicit":false}]` + "\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: `/levels`,
+			Code:   http.StatusMethodNotAllowed,
+			Output: "Method Not Allowed\n",
+		},
+		{
+			Method: http.MethodGet,
+			Target: `/events`,
+			Code:   http.StatusNotFound,
+			Output: "404 page not found\n",
+		},
+	} {
+		code, output := doRequest(t, h, tc.Method, tc.Target, tc.Body)
+		if code != tc.Code || output != tc.Output {
+			t.Errorf("%s %s: unexpected response: %d %q", tc.Method, tc.Target, code, output)
+		}
+	}
+
+	if v := levels.Var(`db.pool`).Level(); v != logiface.LevelWarning {
+		t.Error(v)
+	}
+}
+
+func TestHandler_levels_maxLevels(t *testing.T) {
+	levels := NewLevels(nil)
+	levels.Var(`a`)
+	h := NewHandler(WithLevels(levels), WithMaxLevels(2))
+
+	for _, tc := range [...]struct {
+		Target string
+		Code   int
+	}{
+		{`/levels/a`, http.StatusOK},
+		{`/levels/b`, http.StatusOK},
+		{`/levels/c`, http.StatusBadRequest},
+		// registered names, and the root, may still be set
+		{`/levels/b`, http.StatusOK},
+		{`/levels`, http.StatusOK},
+	} {
+		code, output := doRequest(t, h, http.MethodPut, tc.Target, `{"level":"debug"}`)
+		if code != tc.Code {
+			t.Errorf("%s: unexpected response: %d %q", tc.Target, code, output)
+		} else if code != http.StatusOK && output != `{"error":"logadmin: too many levels"}`+"\n" {
+			t.Errorf("%s: unexpected output: %q", tc.Target, output)
+		}
+	}
+
+	if state := levels.State(); len(state) != 3 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	// the application may still register names
+	levels.Var(`d`)
+	if state := levels.State(); len(state) != 4 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestHandler_rateLimits(t *testing.T) {
+	logger := jsonl.L.New(
+		jsonl.L.WithJSONL(jsonl.WithWriter(io.Discard)),
+		jsonl.L.WithCategoryRateLimits(map[time.Duration]int{time.Minute: 1}),
+	)
+	h := NewHandler(WithRateLimits(logger))
+
+	code, output := doRequest(t, h, http.MethodGet, `/ratelimits`, ``)
+	if code != http.StatusOK || output != "[]\n" {
+		t.Errorf("unexpected response: %d %q", code, output)
+	}
+
+	for range 3 {
+		logger.Info().Limit().Log(`a`)
+	}
+	code, output = doRequest(t, h, http.MethodGet, `/ratelimits`, ``)
+	if code != http.StatusOK || !regexp.MustCompile(`^\[\{"function":"github\.com/joeycumines/logiface/logadmin\.TestHandler_rateLimits","file":"[^"]+handler_test\.go","line":\d+,"entry":\d+,"allowed":1,"limited":2,"next":"[^"]+"}]\n$`).MatchString(output) {
+		t.Errorf("unexpected response: %d %q", code, output)
+	}
+}
+
+func TestHandler_events(t *testing.T) {
+	l := jsonl.NewLogger(jsonl.WithWriter(io.Discard))
+	recorder := flightrec.NewRecorder(l.AppendEvent)
+	logger := jsonl.L.New(
+		jsonl.L.WithEventFactory(l),
+		jsonl.L.WithEventReleaser(l),
+		jsonl.L.WithWriter(recorder.Writer(l, logiface.LevelInformational)),
+		jsonl.L.WithLevel(logiface.LevelTrace),
+	)
+	h := NewHandler(WithRecorder(recorder))
+
+	logger.Debug().Str(`k`, `v`).Log(`a`)
+	logger.Info().Log(`b`)
+
+	code, output := doRequest(t, h, http.MethodGet, `/events`, ``)
+	output = regexp.MustCompile(`"time":"[^"]+"`).ReplaceAllString(output, `"time":"<time>"`)
+	if code != http.StatusOK || output != `{"time":"<time>","level":"debug","event":{"lvl":"debug","k":"v","msg":"a"}}
+{"time":"<time>","level":"info","event":{"lvl":"info","msg":"b"}}
+` {
+		t.Errorf("unexpected response: %d %q\n%s", code, output, output)
+	}
+}
+
+func TestHandler_events_notJSON(t *testing.T) {
+	recorder := flightrec.NewRecorder(func(dst []byte, event logiface.Event) []byte {
+		return append(dst, event.(*snapshotEvent).data...)
+	})
+	_ = recorder.Write(&snapshotEvent{data: []byte(`plain text`)})
+	_ = recorder.Write(&snapshotEvent{data: []byte{0xff, 0x00}})
+	code, output := doRequest(t, NewHandler(WithRecorder(recorder)), http.MethodGet, `/events`, ``)
+	output = regexp.MustCompile(`"time":"[^"]+"`).ReplaceAllString(output, `"time":"<time>"`)
+	if code != http.StatusOK || output != `{"time":"<time>","level":"info","event":"plain text"}
+{"time":"<time>","level":"info","event":"/wA="}
+` {
+		t.Errorf("unexpected response: %d %q\n%s", code, output, output)
+	}
+}
+
+type snapshotEvent struct {
+	logiface.UnimplementedEvent
+	data []byte
+}
+
+func (x *snapshotEvent) Level() logiface.Level { return logiface.LevelInformational }
+
+func (x *snapshotEvent) AddField(key string, val any) {}
@@ -0,0 +1,167 @@
+package logadmin
+
+import (
+	"github.com/joeycumines/logiface"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// Levels is a registry of named levels, forming a tree, where names are
+	// split on ".", e.g. "db.pool" is a child of "db". Each name inherits the
+	// level of its nearest ancestor, that has been set explicitly, or that of
+	// the root. Levels must be initialized using [NewLevels], and is safe for
+	// concurrent use.
+	//
+	// Each name is backed by a [logiface.LevelVar], which may be used to
+	// configure a logger, e.g. via [logiface.Context.LevelVar], or
+	// [logiface.WithLevelVar]. Levels must only be changed using
+	// [Levels.Set], or [Levels.Unset], including the root.
+	Levels struct {
+		root  *logiface.LevelVar
+		mu    sync.Mutex
+		names map[string]*namedLevel
+	}
+
+	// LevelState models the state of a single name, see [Levels.State].
+	LevelState struct {
+		// Name is the name, or empty, for the root.
+		Name string
+		// Level is the current (effective) level.
+		Level logiface.Level
+		// Explicit is true if the level was set explicitly, rather than
+		// being inherited. It is always true for the root.
+		Explicit bool
+	}
+
+	namedLevel struct {
+		v        logiface.LevelVar
+		level    logiface.Level
+		explicit bool
+	}
+)
+
+// NewLevels initializes a new [Levels], using the given root level, which
+// will be allocated (defaulting to [logiface.LevelInformational]) if nil.
+func NewLevels(root *logiface.LevelVar) *Levels {
+	if root == nil {
+		root = new(logiface.LevelVar)
+	}
+	return &Levels{
+		root:  root,
+		names: make(map[string]*namedLevel),
+	}
+}
+
+// Root returns the root level variable.
+func (x *Levels) Root() *logiface.LevelVar {
+	return x.root
+}
+
+// Var returns the level variable for the given name, registering it, if
+// necessary. The empty name refers to the root.
+func (x *Levels) Var(name string) *logiface.LevelVar {
+	if name == `` {
+		return x.root
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return &x.get(name).v
+}
+
+// Set explicitly sets the level for the given name, registering it, if
+// necessary, and updating any descendants that inherit it. The empty name
+// refers to the root.
+func (x *Levels) Set(name string, level logiface.Level) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.set(name, level)
+}
+
+// setLimited is Set, except it will not register a new name, if there are
+// already max (or more) registered names, returning false
+func (x *Levels) setLimited(name string, level logiface.Level, max int) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if name != `` && x.names[name] == nil && len(x.names) >= max {
+		return false
+	}
+	x.set(name, level)
+	return true
+}
+
+func (x *Levels) set(name string, level logiface.Level) {
+	if name == `` {
+		x.root.Set(level)
+	} else {
+		n := x.get(name)
+		n.level = level
+		n.explicit = true
+	}
+	x.update()
+}
+
+// Unset removes any explicitly set level for the given name, which will
+// inherit from its ancestors, instead. The root cannot be unset.
+func (x *Levels) Unset(name string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if n := x.names[name]; n != nil && n.explicit {
+		n.explicit = false
+		x.update()
+	}
+}
+
+// State returns the state of the root, and every registered name, sorted by
+// name, such that the root is first.
+func (x *Levels) State() []LevelState {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	state := make([]LevelState, 0, len(x.names)+1)
+	state = append(state, LevelState{Level: x.root.Level(), Explicit: true})
+	for name, n := range x.names {
+		state = append(state, LevelState{
+			Name:     name,
+			Level:    n.v.Level(),
+			Explicit: n.explicit,
+		})
+	}
+	sort.Slice(state, func(i, j int) bool { return state[i].Name < state[j].Name })
+	return state
+}
+
+func (x *Levels) get(name string) *namedLevel {
+	n := x.names[name]
+	if n == nil {
+		n = new(namedLevel)
+		x.names[name] = n
+		n.v.Set(x.inherited(name))
+	}
+	return n
+}
+
+// update recalculates the level of every name, from the explicit levels
+func (x *Levels) update() {
+	for name, n := range x.names {
+		if n.explicit {
+			n.v.Set(n.level)
+		} else {
+			n.v.Set(x.inherited(name))
+		}
+	}
+}
+
+// inherited returns the level of the nearest explicitly set ancestor
+func (x *Levels) inherited(name string) logiface.Level {
+	for {
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return x.root.Level()
+		}
+		name = name[:i]
+		if n := x.names[name]; n != nil && n.explicit {
+			return n.level
+		}
+	}
+}
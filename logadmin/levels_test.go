@@ -0,0 +1,67 @@
+package logadmin
+
+import (
+	"github.com/joeycumines/logiface"
+	"reflect"
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	levels := NewLevels(nil)
+	if v := levels.Root().Level(); v != logiface.LevelInformational {
+		t.Fatal(v)
+	}
+	if levels.Var(``) != levels.Root() {
+		t.Error(`expected root`)
+	}
+
+	ab := levels.Var(`a.b`)
+	abc := levels.Var(`a.b.c`)
+	d := levels.Var(`d`)
+	if levels.Var(`a.b`) != ab {
+		t.Error(`expected same var`)
+	}
+	if ab.Level() != logiface.LevelInformational || abc.Level() != logiface.LevelInformational {
+		t.Fatal(ab, abc)
+	}
+
+	levels.Set(``, logiface.LevelWarning)
+	levels.Set(`a`, logiface.LevelDebug)
+	if ab.Level() != logiface.LevelDebug || abc.Level() != logiface.LevelDebug || d.Level() != logiface.LevelWarning {
+		t.Error(ab, abc, d)
+	}
+
+	levels.Set(`a.b`, logiface.LevelError)
+	if ab.Level() != logiface.LevelError || abc.Level() != logiface.LevelError {
+		t.Error(ab, abc)
+	}
+	if v := levels.Var(`a.b.c.d`).Level(); v != logiface.LevelError {
+		t.Error(v)
+	}
+
+	levels.Unset(`a.b`)
+	levels.Unset(`unknown`)
+	levels.Unset(``)
+	if ab.Level() != logiface.LevelDebug || abc.Level() != logiface.LevelDebug {
+		t.Error(ab, abc)
+	}
+
+	if state := levels.State(); !reflect.DeepEqual(state, []LevelState{
+		{Name: ``, Level: logiface.LevelWarning, Explicit: true},
+		{Name: `a`, Level: logiface.LevelDebug, Explicit: true},
+		{Name: `a.b`, Level: logiface.LevelDebug},
+		{Name: `a.b.c`, Level: logiface.LevelDebug},
+		{Name: `a.b.c.d`, Level: logiface.LevelDebug},
+		{Name: `d`, Level: logiface.LevelWarning},
+	}) {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestNewLevels_root(t *testing.T) {
+	root := logiface.NewLevelVar(logiface.LevelTrace)
+	levels := NewLevels(root)
+	if levels.Root() != root || levels.Var(`x`).Level() != logiface.LevelTrace {
+		t.Error(`unexpected root`)
+	}
+}
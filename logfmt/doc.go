@@ -0,0 +1,27 @@
+// Package logfmt implements full logiface support, as a logfmt logger,
+// without any dependencies outside this module.
+//
+// Each event is written as a single line of space separated key=value pairs,
+// starting with the (optional) time, level, and message fields. Values are
+// quoted only if necessary, i.e. if they are empty, or contain spaces, `=`,
+// `"`, or any control or non-printable characters, using the same escape
+// sequences as JSON strings. Keys are never quoted, instead invalid
+// characters are replaced with `_`.
+//
+// # Value formats
+//
+// Times and durations are formatted the same as logiface's fallback behavior,
+// i.e. as RFC 3339 timestamps, in UTC, and as seconds with an "s" suffix, e.g.
+// 2019-05-17T05:07:20.361Z and 1.500s. Bytes are base64 encoded, raw JSON is
+// compacted then written as a (quoted) string, and values added via
+// [logiface.Event.AddField] are JSON encoded, unless they are strings, or
+// errors.
+//
+// # Flattening
+//
+// As logfmt has no concept of nesting, the fields of nested objects are
+// flattened, joining each key with a period, while array elements are keyed
+// by their (zero-based) index. For example, the object {"a":{"b":[1,{"c":2}]}}
+// is written as a.b.0=1 a.b.1.c=2. Empty objects and arrays are omitted.
+// Groups (see [logiface.Event.AddGroup]) are handled in the same manner.
+package logfmt
@@ -0,0 +1,368 @@
+package logfmt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/jsonenc"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"math"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		logger *Logger
+		buf    []byte
+		// group is the (encoded) key prefix, for all subsequent fields, see AddGroup
+		group []byte
+		// msg is the message, which is inserted after the time and level, on write
+		msg string
+		// head is the length of buf, after the time and level fields
+		head   int
+		lvl    logiface.Level
+		hasMsg bool
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Bytes returns the current buffer, for the event, which is without the
+// message, and without a trailing newline.
+// It may be used, for example, to customise the writer.
+func (x *Event) Bytes() []byte {
+	return x.buf
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.appendKey(key)
+	x.buf = appendInterface(x.buf, val)
+}
+
+// AddMessage sets the message, which will be written after the time and
+// level fields, regardless of when it was added.
+func (x *Event) AddMessage(msg string) bool {
+	x.msg = msg
+	x.hasMsg = true
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.appendEncodedKey(x.logger.errorField)
+		x.buf = appendError(x.buf, err)
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendKey(key)
+	x.buf = appendString(x.buf, val)
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendInt(x.buf, int64(val), 10)
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendKey(key)
+	x.buf = appendFloat32(x.buf, val)
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendKey(key)
+	x.buf = appendTime(x.buf, val)
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendKey(key)
+	x.buf = appendDuration(x.buf, val)
+	return true
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendKey(key)
+	x.buf = appendBase64Bytes(x.buf, val, enc)
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendBool(x.buf, val)
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendKey(key)
+	x.buf = appendFloat64(x.buf, val)
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendInt(x.buf, val, 10)
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendKey(key)
+	x.buf = strconv.AppendUint(x.buf, val, 10)
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.appendKey(key)
+	x.buf = appendRawJSON(x.buf, val)
+	return true
+}
+
+// AddGroup prefixes the keys of all subsequent fields with the group name,
+// and a period, see also the package documentation.
+func (x *Event) AddGroup(name string) bool {
+	x.group = appendKeyContent(x.group, name)
+	x.group = append(x.group, '.')
+	return true
+}
+
+func (x *Event) addFields(key string, val *Fields) {
+	for _, f := range val.fields {
+		x.appendSeparator()
+		x.buf = append(x.buf, x.group...)
+		x.buf = appendKeyContent(x.buf, key)
+		x.buf = append(x.buf, '.')
+		x.buf = appendKeyContent(x.buf, f.key)
+		x.buf = append(x.buf, '=')
+		x.buf = append(x.buf, val.buf[f.start:f.end]...)
+	}
+}
+
+func (x *Event) appendSeparator() {
+	if len(x.buf) != 0 {
+		x.buf = append(x.buf, ' ')
+	}
+}
+
+func (x *Event) appendKey(key string) {
+	x.appendSeparator()
+	if len(x.group) == 0 {
+		x.buf = appendKey(x.buf, key)
+	} else {
+		x.buf = append(x.buf, x.group...)
+		x.buf = appendKeyContent(x.buf, key)
+	}
+	x.buf = append(x.buf, '=')
+}
+
+func (x *Event) appendEncodedKey(key string) {
+	x.appendSeparator()
+	x.buf = append(x.buf, x.group...)
+	x.buf = append(x.buf, key...)
+	x.buf = append(x.buf, '=')
+}
+
+// insertMessage inserts the message field at x.head, without allocating.
+func (x *Event) insertMessage() {
+	n := len(x.buf)
+	if x.head != 0 {
+		x.buf = append(x.buf, ' ')
+	}
+	x.buf = append(x.buf, x.logger.messageField...)
+	x.buf = append(x.buf, '=')
+	x.buf = appendString(x.buf, x.msg)
+	if x.head == 0 && n != 0 {
+		// fields after the head are already prefixed with a separator
+		x.buf = append(x.buf, ' ')
+	}
+	rotate(x.buf[x.head:], n-x.head)
+}
+
+// rotate rotates b left by n bytes, without allocating.
+func rotate(b []byte, n int) {
+	reverse(b[:n])
+	reverse(b[n:])
+	reverse(b)
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+// appendKey appends key, replacing invalid characters with `_`, and using
+// `_` if key is empty.
+func appendKey(dst []byte, key string) []byte {
+	if key == `` {
+		return append(dst, '_')
+	}
+	return appendKeyContent(dst, key)
+}
+
+func appendKeyContent(dst []byte, key string) []byte {
+	for i := 0; i < len(key); {
+		r, size := utf8.DecodeRuneInString(key[i:])
+		if r == utf8.RuneError || r <= ' ' || r == '=' || r == '"' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			dst = append(dst, '_')
+		} else {
+			dst = append(dst, key[i:i+size]...)
+		}
+		i += size
+	}
+	return dst
+}
+
+// appendString appends val, quoting it only if necessary.
+func appendString(dst []byte, val string) []byte {
+	if needsQuote(val) {
+		return jsonenc.AppendString(dst, val)
+	}
+	return append(dst, val...)
+}
+
+func needsQuote(val string) bool {
+	if val == `` {
+		return true
+	}
+	for i := 0; i < len(val); {
+		if c := val[i]; c < utf8.RuneSelf {
+			if c <= ' ' || c == '=' || c == '"' || c == '\\' || c == 0x7f {
+				return true
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(val[i:])
+		if r == utf8.RuneError || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return true
+		}
+		i += size
+	}
+	return false
+}
+
+// appendQuotedIfNecessary quotes the value at dst[start:], if necessary,
+// avoiding allocating unless escaping is required.
+func appendQuotedIfNecessary(dst []byte, start int) []byte {
+	val := dst[start:]
+	if !needsQuote(string(val)) {
+		return dst
+	}
+	for _, c := range val {
+		if c < ' ' || c == '"' || c == '\\' || c >= utf8.RuneSelf {
+			return jsonenc.AppendString(dst[:start], string(val))
+		}
+	}
+	dst = append(dst, '"', '"')
+	// move the opening quote to start
+	rotate(dst[start:len(dst)-1], len(dst)-start-2)
+	return dst
+}
+
+func appendError(dst []byte, err error) []byte {
+	if err == nil {
+		return append(dst, `null`...)
+	}
+	// this seems sensible, even if it's inefficient
+	return appendString(dst, fmt.Sprint(err))
+}
+
+func appendFloat64(dst []byte, val float64) []byte {
+	// unlike jsonenc, these are not quoted
+	switch {
+	case math.IsNaN(val):
+		return append(dst, `NaN`...)
+	case math.IsInf(val, 1):
+		return append(dst, `+Inf`...)
+	case math.IsInf(val, -1):
+		return append(dst, `-Inf`...)
+	}
+	return jsonenc.AppendFloat64(dst, val)
+}
+
+func appendFloat32(dst []byte, val float32) []byte {
+	if math.IsNaN(float64(val)) || math.IsInf(float64(val), 0) {
+		return appendFloat64(dst, float64(val))
+	}
+	return jsonenc.AppendFloat32(dst, val)
+}
+
+func appendTime(dst []byte, val time.Time) []byte {
+	return pbtime.AppendTimestamp(dst, val)
+}
+
+func appendDuration(dst []byte, val time.Duration) []byte {
+	return pbtime.AppendDuration(dst, val)
+}
+
+func appendBase64Bytes(dst []byte, val []byte, enc *base64.Encoding) []byte {
+	start := len(dst)
+	dst = enc.AppendEncode(dst, val)
+	return appendQuotedIfNecessary(dst, start)
+}
+
+func appendRawJSON(dst []byte, val json.RawMessage) []byte {
+	if len(val) == 0 {
+		return append(dst, `null`...)
+	}
+	start := len(dst)
+	buf := bytes.NewBuffer(dst)
+	if err := json.Compact(buf, val); err != nil {
+		return appendString(dst, string(val))
+	}
+	dst = buf.Bytes()
+	return appendQuotedIfNecessary(dst, start)
+}
+
+func appendInterface(dst []byte, val any) []byte {
+	switch val := val.(type) {
+	case string:
+		return appendString(dst, val)
+	case error:
+		return appendError(dst, val)
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return appendString(dst, fmt.Sprintf("marshaling error: %v", err))
+	}
+	if len(b) != 0 && b[0] == '"' {
+		var s string
+		if json.Unmarshal(b, &s) == nil {
+			return appendString(dst, s)
+		}
+	}
+	return appendString(dst, string(b))
+}
@@ -0,0 +1,190 @@
+package logfmt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLogger_fieldTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range [...]struct {
+		Name   string
+		Log    func(l *logiface.Logger[*Event])
+		Output string
+	}{
+		{
+			Name:   `message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Log(`some message`) },
+			Output: `level=info msg="some message"`,
+		},
+		{
+			Name:   `message after fields`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Str(`a`, `b`).Log(`msg`) },
+			Output: `level=info msg=msg a=b`,
+		},
+		{
+			Name: `any`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Any(`a`, map[string]int{`z`: 1}).
+					Any(`b`, `str`).
+					Any(`c`, nil).
+					Object().Any(`da`, true).As(`d`).End().
+					Array().Any([]int{1}).As(`e`).End().
+					Log(``)
+			},
+			Output: `level=info a="{\"z\":1}" b=str c=null d.da=true e.0=[1]`,
+		},
+		{
+			Name: `error`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Err(nil).
+					Err(errors.New(`err 1`)).
+					Object().Err(nil).Err(errors.New(`err2`)).As(`obj`).End().
+					Array().Err(nil).Err(errors.New(`err 3`)).As(`arr`).End().
+					Log(``)
+			},
+			Output: `level=info err="err 1" obj.err=null obj.err=err2 arr.0=null arr.1="err 3"`,
+		},
+		{
+			Name: `string`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Str(`a`, "A\"\n\x01").
+					Str(`b`, ``).
+					Str(`c`, `x=y`).
+					Str(`d`, `back\slash`).
+					Str(`e`, "é").
+					Str(`f g="h`, `i`).
+					Str(``, `j`).
+					Log(``)
+			},
+			Output: `level=info a="A\"\n\u0001" b="" c="x=y" d="back\\slash" e=` + "é" + ` f_g__h=i _=j`,
+		},
+		{
+			Name: `numbers`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Int(`a`, -1).
+					Int64(`b`, math.MinInt64).
+					Uint64(`c`, math.MaxUint64).
+					Float32(`d`, 1.1).
+					Float64(`e`, 1e-7).
+					Float64(`f`, math.NaN()).
+					Float32(`g`, float32(math.Inf(-1))).
+					Log(``)
+			},
+			Output: `level=info a=-1 b=-9223372036854775808 c=18446744073709551615 d=1.1 e=1e-7 f=NaN g=-Inf`,
+		},
+		{
+			Name: `bool`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().Bool(`a`, true).Bool(`b`, false).Log(``)
+			},
+			Output: `level=info a=true b=false`,
+		},
+		{
+			Name: `time and duration`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Time(`a`, time.Unix(0, 1558069640361696123)).
+					Dur(`b`, time.Second*3/2).
+					Dur(`c`, -time.Nanosecond).
+					Log(``)
+			},
+			Output: `level=info a=2019-05-17T05:07:20.361696123Z b=1.500s c=-0.000000001s`,
+		},
+		{
+			Name: `base64`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Base64(`a`, []byte(`val 7`), nil).
+					Base64(`b`, []byte(`val 7`), base64.RawURLEncoding).
+					Object().Base64(`ca`, []byte(`val 7`), nil).As(`c`).End().
+					Log(``)
+			},
+			Output: `level=info a="dmFsIDc=" b=dmFsIDc c.ca="dmFsIDc="`,
+		},
+		{
+			Name: `raw json`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					RawJSON(`a`, json.RawMessage(`{"k": [1]}`)).
+					RawJSON(`b`, nil).
+					RawJSON(`c`, json.RawMessage(`123`)).
+					Log(``)
+			},
+			Output: `level=info a="{\"k\":[1]}" b=null c=123`,
+		},
+		{
+			Name: `flattening`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Object().
+					Array().Str(`a`).Object().Int(`b`, 1).Add().Array().Add().Array().Bool(true).Add().As(`c`).
+					Object().As(`d`).
+					Object().Dur(`f`, time.Second).As(`e`).
+					As(`obj`).End().
+					Log(`msg`)
+			},
+			Output: `level=info msg=msg obj.c.0=a obj.c.1.b=1 obj.c.3.0=true obj.e.f=1s`,
+		},
+		{
+			Name: `group`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().
+					Str(`a`, `A`).
+					Group(`g1`).
+					Str(`b`, `B`).
+					Err(errors.New(`e`)).
+					Group(`g 2`).
+					Object().Int(`d`, 1).As(`c`).End().
+					Log(`msg`)
+			},
+			Output: `level=info msg=msg a=A g1.b=B g1.err=e g1.g_2.c.d=1`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			tc.Log(L.New(L.WithLogfmt(WithWriter(&buf))))
+			if s := buf.String(); s != tc.Output+"\n" {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestEvent_Level_nil(t *testing.T) {
+	if v := (*Event)(nil).Level(); v != logiface.LevelDisabled {
+		t.Error(v)
+	}
+}
+
+func TestLogger_Write_restoresBuffer(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(WithWriter(&buf))
+	e := l.NewEvent(logiface.LevelWarning)
+	e.AddString(`k`, `v`)
+	e.AddMessage(`msg`)
+	if err := l.Write(e); err != nil {
+		t.Fatal(err)
+	}
+	if s := string(e.Bytes()); s != `level=warning k=v` {
+		t.Errorf("unexpected bytes: %q", s)
+	}
+	if err := l.Write(e); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "level=warning msg=msg k=v\nlevel=warning msg=msg k=v\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
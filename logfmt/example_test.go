@@ -0,0 +1,28 @@
+package logfmt_test
+
+import (
+	"errors"
+	"github.com/joeycumines/logiface/logfmt"
+	"os"
+)
+
+func ExampleWithLogfmt() {
+	logger := logfmt.L.New(logfmt.L.WithLogfmt(logfmt.WithWriter(os.Stdout)))
+
+	logger.Info().
+		Str(`request_id`, `c7d5a8f1`).
+		Int(`status`, 200).
+		Object().
+		Str(`method`, `GET`).
+		Array().Str(`admin`).Str(`user`).As(`roles`).
+		As(`request`).End().
+		Log(`handled request`)
+
+	logger.Err().
+		Err(errors.New(`connection refused`)).
+		Log(`failed to connect`)
+
+	//output:
+	//level=info msg="handled request" request_id=c7d5a8f1 status=200 request.method=GET request.roles.0=admin request.roles.1=user
+	//level=err msg="failed to connect" err="connection refused"
+}
@@ -0,0 +1,373 @@
+package logfmt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser],
+	// [logiface.Writer], and [logiface.JSONSupport], for [Event].
+	// See also [NewLogger], and [WithLogfmt].
+	Logger struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedJSONSupport
+
+		writer io.Writer
+
+		// these are pre-emptively encoded as keys
+
+		timeField    string
+		levelField   string
+		messageField string
+		errorField   string
+	}
+
+	// Fields is the [logiface.JSONSupport] object and array type, for
+	// [Event], and holds the flattened fields of a nested object or array.
+	// See also the package documentation.
+	Fields struct {
+		buf    []byte
+		fields []field
+		// n is the number of elements, for arrays
+		n int
+	}
+
+	// field is a flattened field, with the value stored in Fields.buf
+	field struct {
+		key        string
+		start, end int
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedJSONSupport = logiface.UnimplementedJSONSupport[*Event, *Fields, *Fields]
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]                  = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event]                 = (*Logger)(nil)
+	_ logiface.Writer[*Event]                        = (*Logger)(nil)
+	_ logiface.JSONSupport[*Event, *Fields, *Fields] = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{
+			buf: make([]byte, 0, 1<<10),
+		}
+	}}
+	timeNow = time.Now
+)
+
+func (x *Logger) NewEvent(level logiface.Level) (e *Event) {
+	e = eventPool.Get().(*Event)
+
+	e.logger = x
+	e.lvl = level
+	e.group = e.group[:0]
+	e.msg = ``
+	e.hasMsg = false
+
+	// note: buf isn't reset when added back to the pool
+	e.buf = e.buf[:0]
+
+	if x.timeField != `` {
+		e.buf = append(e.buf, x.timeField...)
+		e.buf = append(e.buf, '=')
+		e.buf = appendTime(e.buf, timeNow())
+	}
+
+	if x.levelField != `` {
+		e.appendSeparator()
+		e.buf = append(e.buf, x.levelField...)
+		e.buf = append(e.buf, '=')
+		e.buf = appendString(e.buf, level.String())
+	}
+
+	e.head = len(e.buf)
+
+	return
+}
+
+// Write writes the event to the underlying io.Writer, as a single line,
+// terminated by a newline.
+func (x *Logger) Write(event *Event) (err error) {
+	n := len(event.buf)
+	if event.hasMsg {
+		event.insertMessage()
+	}
+	event.buf = append(event.buf, '\n')
+	_, err = x.writer.Write(event.buf)
+	if event.hasMsg {
+		// restore event.buf, in case it is written again
+		event.buf = event.buf[:len(event.buf)-1]
+		rotate(event.buf[event.head:], len(event.buf)-n)
+		event.buf = event.buf[:n]
+	} else {
+		event.buf = event.buf[:n]
+	}
+	return
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.buf) <= 1<<16 && cap(e.group) <= 1<<10 {
+		// clear references that might need to be garbage collected
+		e.logger = nil
+		e.msg = ``
+
+		eventPool.Put(e)
+	}
+}
+
+func (x *Logger) NewObject() *Fields {
+	return new(Fields)
+}
+
+func (x *Logger) AddObject(evt *Event, key string, obj *Fields) {
+	evt.addFields(key, obj)
+}
+
+func (x *Logger) CanSetObject() bool { return true }
+
+func (x *Logger) SetObject(obj *Fields, key string, val *Fields) *Fields {
+	obj.setFields(key, val)
+	return obj
+}
+
+func (x *Logger) CanSetArray() bool { return true }
+
+func (x *Logger) SetArray(obj *Fields, key string, val *Fields) *Fields {
+	obj.setFields(key, val)
+	return obj
+}
+
+func (x *Logger) NewArray() *Fields {
+	return new(Fields)
+}
+
+func (x *Logger) AddArray(evt *Event, key string, arr *Fields) {
+	evt.addFields(key, arr)
+}
+
+func (x *Logger) CanAppendObject() bool { return true }
+
+func (x *Logger) AppendObject(arr *Fields, val *Fields) *Fields {
+	arr.setFields(arr.nextIndex(), val)
+	return arr
+}
+
+func (x *Logger) CanAppendArray() bool { return true }
+
+func (x *Logger) AppendArray(arr *Fields, val *Fields) *Fields {
+	arr.setFields(arr.nextIndex(), val)
+	return arr
+}
+
+func (x *Logger) SetField(obj *Fields, key string, val any) *Fields {
+	obj.set(key, appendInterface(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) AppendField(arr *Fields, val any) *Fields {
+	arr.set(arr.nextIndex(), appendInterface(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetError() bool { return true }
+
+func (x *Logger) SetError(obj *Fields, err error) *Fields {
+	obj.set(x.errorField, appendError(obj.buf, err))
+	return obj
+}
+
+func (x *Logger) CanAppendError() bool { return true }
+
+func (x *Logger) AppendError(arr *Fields, err error) *Fields {
+	arr.set(arr.nextIndex(), appendError(arr.buf, err))
+	return arr
+}
+
+func (x *Logger) CanSetString() bool { return true }
+
+func (x *Logger) SetString(obj *Fields, key string, val string) *Fields {
+	obj.set(key, appendString(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendString() bool { return true }
+
+func (x *Logger) AppendString(arr *Fields, val string) *Fields {
+	arr.set(arr.nextIndex(), appendString(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetInt() bool { return true }
+
+func (x *Logger) SetInt(obj *Fields, key string, val int) *Fields {
+	obj.set(key, strconv.AppendInt(obj.buf, int64(val), 10))
+	return obj
+}
+
+func (x *Logger) CanAppendInt() bool { return true }
+
+func (x *Logger) AppendInt(arr *Fields, val int) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendInt(arr.buf, int64(val), 10))
+	return arr
+}
+
+func (x *Logger) CanSetFloat32() bool { return true }
+
+func (x *Logger) SetFloat32(obj *Fields, key string, val float32) *Fields {
+	obj.set(key, appendFloat32(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendFloat32() bool { return true }
+
+func (x *Logger) AppendFloat32(arr *Fields, val float32) *Fields {
+	arr.set(arr.nextIndex(), appendFloat32(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetTime() bool { return true }
+
+func (x *Logger) SetTime(obj *Fields, key string, t time.Time) *Fields {
+	obj.set(key, appendTime(obj.buf, t))
+	return obj
+}
+
+func (x *Logger) CanAppendTime() bool { return true }
+
+func (x *Logger) AppendTime(arr *Fields, t time.Time) *Fields {
+	arr.set(arr.nextIndex(), appendTime(arr.buf, t))
+	return arr
+}
+
+func (x *Logger) CanSetDuration() bool { return true }
+
+func (x *Logger) SetDuration(obj *Fields, key string, d time.Duration) *Fields {
+	obj.set(key, appendDuration(obj.buf, d))
+	return obj
+}
+
+func (x *Logger) CanAppendDuration() bool { return true }
+
+func (x *Logger) AppendDuration(arr *Fields, d time.Duration) *Fields {
+	arr.set(arr.nextIndex(), appendDuration(arr.buf, d))
+	return arr
+}
+
+func (x *Logger) CanSetBase64Bytes() bool { return true }
+
+func (x *Logger) SetBase64Bytes(obj *Fields, key string, b []byte, enc *base64.Encoding) *Fields {
+	obj.set(key, appendBase64Bytes(obj.buf, b, enc))
+	return obj
+}
+
+func (x *Logger) CanAppendBase64Bytes() bool { return true }
+
+func (x *Logger) AppendBase64Bytes(arr *Fields, b []byte, enc *base64.Encoding) *Fields {
+	arr.set(arr.nextIndex(), appendBase64Bytes(arr.buf, b, enc))
+	return arr
+}
+
+func (x *Logger) CanSetBool() bool { return true }
+
+func (x *Logger) SetBool(obj *Fields, key string, val bool) *Fields {
+	obj.set(key, strconv.AppendBool(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendBool() bool { return true }
+
+func (x *Logger) AppendBool(arr *Fields, val bool) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendBool(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetFloat64() bool { return true }
+
+func (x *Logger) SetFloat64(obj *Fields, key string, val float64) *Fields {
+	obj.set(key, appendFloat64(obj.buf, val))
+	return obj
+}
+
+func (x *Logger) CanAppendFloat64() bool { return true }
+
+func (x *Logger) AppendFloat64(arr *Fields, val float64) *Fields {
+	arr.set(arr.nextIndex(), appendFloat64(arr.buf, val))
+	return arr
+}
+
+func (x *Logger) CanSetInt64() bool { return true }
+
+func (x *Logger) SetInt64(obj *Fields, key string, val int64) *Fields {
+	obj.set(key, strconv.AppendInt(obj.buf, val, 10))
+	return obj
+}
+
+func (x *Logger) CanAppendInt64() bool { return true }
+
+func (x *Logger) AppendInt64(arr *Fields, val int64) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendInt(arr.buf, val, 10))
+	return arr
+}
+
+func (x *Logger) CanSetUint64() bool { return true }
+
+func (x *Logger) SetUint64(obj *Fields, key string, val uint64) *Fields {
+	obj.set(key, strconv.AppendUint(obj.buf, val, 10))
+	return obj
+}
+
+func (x *Logger) CanAppendUint64() bool { return true }
+
+func (x *Logger) AppendUint64(arr *Fields, val uint64) *Fields {
+	arr.set(arr.nextIndex(), strconv.AppendUint(arr.buf, val, 10))
+	return arr
+}
+
+func (x *Logger) CanSetRawJSON() bool { return true }
+
+func (x *Logger) SetRawJSON(obj *Fields, key string, b json.RawMessage) *Fields {
+	obj.set(key, appendRawJSON(obj.buf, b))
+	return obj
+}
+
+func (x *Logger) CanAppendRawJSON() bool { return true }
+
+func (x *Logger) AppendRawJSON(arr *Fields, b json.RawMessage) *Fields {
+	arr.set(arr.nextIndex(), appendRawJSON(arr.buf, b))
+	return arr
+}
+
+// set adds a field, the value of which must have been appended to x.buf.
+func (x *Fields) set(key string, buf []byte) {
+	x.fields = append(x.fields, field{key: key, start: len(x.buf), end: len(buf)})
+	x.buf = buf
+}
+
+// setFields flattens val into x, prefixing each key.
+func (x *Fields) setFields(key string, val *Fields) {
+	for _, f := range val.fields {
+		x.set(joinKey(key, f.key), append(x.buf, val.buf[f.start:f.end]...))
+	}
+}
+
+func (x *Fields) nextIndex() string {
+	key := strconv.Itoa(x.n)
+	x.n++
+	return key
+}
+
+func joinKey(prefix, key string) string {
+	return prefix + `.` + key
+}
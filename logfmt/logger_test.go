@@ -0,0 +1,109 @@
+package logfmt
+
+import (
+	"bytes"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"io"
+	"testing"
+	"time"
+)
+
+type errWriter struct{ err error }
+
+func (x errWriter) Write([]byte) (int, error) { return 0, x.err }
+
+func TestNewLogger_fields(t *testing.T) {
+	l := NewLogger(
+		WithTimeField(`t s`),
+		WithLevelField(`lvl`),
+		WithMessageField(``),
+		WithErrorField(`error`),
+	)
+	if l.timeField != `t_s` || l.levelField != `lvl` || l.messageField != `msg` || l.errorField != `error` {
+		t.Errorf(`unexpected fields: %+v`, l)
+	}
+}
+
+func TestLogger_timeField(t *testing.T) {
+	old := timeNow
+	defer func() { timeNow = old }()
+	timeNow = func() time.Time { return time.Unix(1680693679, 496235772) }
+
+	var buf bytes.Buffer
+	logger := L.New(L.WithLogfmt(WithWriter(&buf), WithTimeField(`ts`)), L.WithLevel(logiface.LevelTrace))
+	logger.Trace().Err(errors.New(`some error`)).Log(`hello`)
+	logger.Build(100).Log(``)
+	if s := buf.String(); s != `ts=2023-04-05T11:21:19.496235772Z level=trace msg=hello err="some error"`+"\n"+
+		`ts=2023-04-05T11:21:19.496235772Z level=100`+"\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_noHead(t *testing.T) {
+	var buf bytes.Buffer
+	logger := L.New(L.WithLogfmt(WithWriter(&buf), WithLevelField(``)))
+	logger.Info().Log(`only`)
+	logger.Info().Int(`a`, 1).Log(`first`)
+	logger.Info().Int(`a`, 1).Log(``)
+	if s := buf.String(); s != "msg=only\nmsg=first a=1\na=1\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
+func TestLogger_Write_error(t *testing.T) {
+	expected := errors.New(`some error`)
+	logger := L.New(L.WithLogfmt(WithWriter(errWriter{expected})))
+	if err := logger.Log(logiface.LevelError, nil); err != expected {
+		t.Error(err)
+	}
+}
+
+func TestLogger_allocs(t *testing.T) {
+	logger := L.New(L.WithLogfmt(WithWriter(io.Discard), WithTimeField(`time`)))
+	b := []byte(`bytes`)
+	if n := testing.AllocsPerRun(100, func() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Time(`time`, time.Time{}).
+			Base64(`base64`, b, nil).
+			Log(`the message`)
+	}); n != 0 {
+		t.Errorf(`unexpected allocs: %v`, n)
+	}
+}
+
+func BenchmarkLogger_fields(b *testing.B) {
+	logger := L.New(L.WithLogfmt(WithWriter(io.Discard), WithTimeField(`time`)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Str(`str`, `some string`).
+			Int(`int`, 123).
+			Int64(`int64`, 456).
+			Float64(`float64`, 1.23).
+			Bool(`bool`, true).
+			Dur(`dur`, time.Second).
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
+
+func BenchmarkLogger_nested(b *testing.B) {
+	logger := L.New(L.WithLogfmt(WithWriter(io.Discard)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		logger.Info().
+			Object().
+			Str(`a`, `b`).
+			Array().Int(1).Int(2).Object().Bool(`c`, true).Add().As(`d`).
+			As(`obj`).End().
+			Log(`The quick brown fox jumps over the lazy dog`)
+	}
+}
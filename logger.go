@@ -16,7 +16,10 @@ type (
 		// WARNING: Fields added must be initialized in both New and Logger.Logger
 
 		modifier Modifier[E]
-		shared   *loggerShared[E]
+		// lazy applies the deferred fields, see Context.Func, after all
+		// other modifiers
+		lazy   Modifier[E]
+		shared *loggerShared[E]
 	}
 
 	// loggerShared models the shared state, common between a root Logger
@@ -30,8 +33,15 @@ type (
 		pool     *sync.Pool
 		json     *jsonSupport[E]
 		catrate  *catrate.Limiter
-		level    Level
-		dpanic   Level
+		limits   *limitStats
+		levelVar *LevelVar
+		clock    func() time.Time
+		// schema configures the fallback behavior, and may be nil
+		schema *FieldSchema
+		// timestamp is the key for the timestamp field, if enabled
+		timestamp string
+		level     Level
+		dpanic    Level
 	}
 
 	// Option is a configuration option for constructing Logger instances,
@@ -50,6 +60,10 @@ type (
 		categoryRateLimits map[time.Duration]int
 		writer             WriterSlice[E]
 		modifier           ModifierSlice[E]
+		levelVar           *LevelVar
+		clock              func() time.Time
+		schema             *FieldSchema
+		timestamp          string
 		level              Level
 		dpanic             Level
 	}
@@ -160,6 +174,7 @@ func (LoggerFactory[E]) WithModifier(modifier Modifier[E]) Option[E] {
 func WithLevel[E Event](level Level) Option[E] {
 	return optionFunc[E](func(c *loggerConfig[E]) {
 		c.level = level
+		c.levelVar = nil
 	})
 }
 
@@ -168,6 +183,25 @@ func (LoggerFactory[E]) WithLevel(level Level) Option[E] {
 	return WithLevel[E](level)
 }
 
+// WithLevelVar configures the logger's Level, using a variable, which may be
+// changed at runtime. It behaves like [WithLevel], and the last of either
+// option will apply. A nil value is ignored.
+//
+// See also LoggerFactory.WithLevelVar and L (an instance of
+// LoggerFactory[Event]{}).
+func WithLevelVar[E Event](level *LevelVar) Option[E] {
+	return optionFunc[E](func(c *loggerConfig[E]) {
+		if level != nil {
+			c.levelVar = level
+		}
+	})
+}
+
+// WithLevelVar is an alias of the package function of the same name.
+func (LoggerFactory[E]) WithLevelVar(level *LevelVar) Option[E] {
+	return WithLevelVar[E](level)
+}
+
 // WithDPanicLevel configures the level that the [Logger.DPanic] method will
 // alias to, defaulting to [LevelCritical].
 //
@@ -206,6 +240,48 @@ func (LoggerFactory[E]) WithCategoryRateLimits(rates map[time.Duration]int) Opti
 	return WithCategoryRateLimits[E](rates)
 }
 
+// WithTimestamp configures the logger to add a timestamp field, using the
+// given key, to every event, at the point it is built (or logged, per
+// [Logger.Log]), prior to any modifiers. The timestamp is added using
+// Event.AddTimestamp, if implemented, otherwise falling back to the behavior
+// of [Builder.Time]. An empty key disables the timestamp, which is the
+// default.
+//
+// The time is determined by the clock, see also [WithClock].
+//
+// See also LoggerFactory.WithTimestamp and L (an instance of
+// LoggerFactory[Event]{}).
+func WithTimestamp[E Event](key string) Option[E] {
+	return optionFunc[E](func(c *loggerConfig[E]) {
+		c.timestamp = key
+	})
+}
+
+// WithTimestamp is an alias of the package function of the same name.
+func (LoggerFactory[E]) WithTimestamp(key string) Option[E] {
+	return WithTimestamp[E](key)
+}
+
+// WithClock configures the source of the current time, used by the logger,
+// e.g. for [WithTimestamp]. A nil value (the default) is equivalent to
+// time.Now.
+//
+// Note that the category rate limiter always uses the system clock,
+// including for the `_limited` field, see [Builder.Limit].
+//
+// See also LoggerFactory.WithClock and L (an instance of
+// LoggerFactory[Event]{}).
+func WithClock[E Event](clock func() time.Time) Option[E] {
+	return optionFunc[E](func(c *loggerConfig[E]) {
+		c.clock = clock
+	})
+}
+
+// WithClock is an alias of the package function of the same name.
+func (LoggerFactory[E]) WithClock(clock func() time.Time) Option[E] {
+	return WithClock[E](clock)
+}
+
 // New constructs a new Logger instance.
 //
 // Configure the logger using either the With* prefixed functions (or methods
@@ -222,13 +298,20 @@ func New[E Event](options ...Option[E]) (logger *Logger[E]) {
 	WithOptions(options...).apply(&c)
 
 	shared := loggerShared[E]{
-		level:    c.level,
-		factory:  c.factory,
-		releaser: c.releaser,
-		writer:   c.resolveWriter(),
-		json:     c.resolveJSONSupport(),
-		dpanic:   c.dpanic,
-		catrate:  c.resolveCategoryRateLimiter(),
+		level:     c.level,
+		levelVar:  c.levelVar,
+		clock:     c.clock,
+		schema:    c.schema,
+		timestamp: c.timestamp,
+		factory:   c.factory,
+		releaser:  c.releaser,
+		writer:    c.resolveWriter(),
+		json:      c.resolveJSONSupport(),
+		dpanic:    c.dpanic,
+		catrate:   c.resolveCategoryRateLimiter(),
+	}
+	if shared.catrate != nil {
+		shared.limits = new(limitStats)
 	}
 	shared.init()
 
@@ -251,8 +334,10 @@ func (LoggerFactory[E]) New(options ...Option[E]) *Logger[E] {
 // Level returns the logger's [Level], note that it will be [LevelDisabled] if
 // it isn't writeable, or if the provided level was any disabled value.
 func (x *Logger[E]) Level() Level {
-	if x.Enabled() && x.shared.level.Enabled() {
-		return x.shared.level
+	if x.Enabled() {
+		if level := x.shared.currentLevel(); level.Enabled() {
+			return level
+		}
 	}
 	return LevelDisabled
 }
@@ -269,6 +354,14 @@ func (x *Logger[E]) Root() *Logger[E] {
 //
 // Use this for greater compatibility, but sacrificing ease of using the
 // underlying library directly.
+//
+// The generified logger shares the receiver's configuration, including the
+// category rate limiter (see [WithCategoryRateLimits]), meaning events are
+// limited across both loggers, and the DPanic level (see [WithDPanicLevel]).
+// Note that this is a change in behavior, as these were previously not
+// propagated, i.e. [Builder.Limit] was a no-op, and [Logger.DPanic] always
+// panicked (as per [LevelEmergency]), including for the default level,
+// [LevelCritical]. Rate limiting is unaffected, if not configured.
 func (x *Logger[E]) Logger() (logger *Logger[Event]) {
 	if x, ok := any(x).(*Logger[Event]); ok {
 		return x
@@ -278,13 +371,21 @@ func (x *Logger[E]) Logger() (logger *Logger[Event]) {
 	}
 	logger = &Logger[Event]{
 		modifier: generifyModifier(x.modifier),
+		lazy:     generifyModifier(x.lazy),
 		shared: &loggerShared[Event]{
-			level:    x.shared.level,
-			factory:  generifyEventFactory(x.shared.factory),
-			releaser: generifyEventReleaser(x.shared.releaser),
-			writer:   generifyWriter(x.shared.writer),
-			pool:     &genericBuilderPool,
-			json:     generifyJSONSupport(x.shared.json),
+			level:     x.shared.level,
+			levelVar:  x.shared.levelVar,
+			clock:     x.shared.clock,
+			schema:    x.shared.schema,
+			timestamp: x.shared.timestamp,
+			catrate:   x.shared.catrate,
+			limits:    x.shared.limits,
+			dpanic:    x.shared.dpanic,
+			factory:   generifyEventFactory(x.shared.factory),
+			releaser:  generifyEventReleaser(x.shared.releaser),
+			writer:    generifyWriter(x.shared.writer),
+			pool:      &genericBuilderPool,
+			json:      generifyJSONSupport(x.shared.json),
 		},
 	}
 	logger.shared.root = logger
@@ -302,6 +403,8 @@ func (x *Logger[E]) Log(level Level, modifier Modifier[E]) error {
 		defer x.shared.releaser.ReleaseEvent(event)
 	}
 
+	x.shared.stamp(event)
+
 	if x.modifier != nil {
 		if err := x.modifier.Modify(event); err != nil {
 			return err
@@ -314,6 +417,12 @@ func (x *Logger[E]) Log(level Level, modifier Modifier[E]) error {
 		}
 	}
 
+	if x.lazy != nil {
+		if err := x.lazy.Modify(event); err != nil {
+			return err
+		}
+	}
+
 	return x.shared.writer.Write(event)
 }
 
@@ -331,6 +440,12 @@ func (x *Logger[E]) Build(level Level) *Builder[E] {
 	// initialise the builder
 	b := x.shared.newBuilder(x.newEvent(level))
 
+	x.shared.stamp(b.Event)
+
+	if x.lazy != nil {
+		b.lazy = append(b.lazy, x.lazy)
+	}
+
 	// apply the logger's modifier, if any
 	return b.Modifier(x.modifier)
 }
@@ -339,6 +454,7 @@ func (x *loggerShared[E]) newBuilder(event E) *Builder[E] {
 	b := x.pool.Get().(*Builder[E])
 	b.Event = event
 	b.shared = x
+	b.methods = modifierMethods[E]{schema: x.schema}
 	return b
 }
 
@@ -353,12 +469,19 @@ func (x *Logger[E]) Clone() *Context[E] {
 	if x.modifier != nil {
 		c.Modifiers = append(c.Modifiers, x.modifier)
 	}
+	if x.lazy != nil {
+		c.lazy = append(c.lazy, x.lazy)
+	}
 	c.logger = &Logger[E]{
 		modifier: ModifierFunc[E](func(event E) error {
 			return c.Modifiers.Modify(event)
 		}),
 		shared: x.shared,
 	}
+	if c.lazy != nil {
+		c.logger.lazy = ModifierFunc[E](c.applyLazy)
+	}
+	c.methods = modifierMethods[E]{schema: x.shared.schema}
 
 	return &c
 }
@@ -461,7 +584,14 @@ func (x *Logger[E]) Enabled() bool {
 func (x *Logger[E]) canLog(level Level) bool {
 	return x.Enabled() &&
 		level.Enabled() &&
-		(level <= x.shared.level || level > LevelTrace)
+		(level <= x.shared.currentLevel() || level > LevelTrace)
+}
+
+func (x *loggerShared[E]) currentLevel() Level {
+	if x.levelVar != nil {
+		return x.levelVar.Level()
+	}
+	return x.level
 }
 
 func (x *Logger[E]) newEvent(level Level) (event E) {
@@ -471,6 +601,25 @@ func (x *Logger[E]) newEvent(level Level) (event E) {
 	return
 }
 
+// now returns the current time, per the configured clock
+func (x *loggerShared[E]) now() time.Time {
+	if x != nil && x.clock != nil {
+		return x.clock()
+	}
+	return time.Now()
+}
+
+// stamp adds the timestamp field, if configured, see WithTimestamp
+func (x *loggerShared[E]) stamp(event E) {
+	if x.timestamp == `` {
+		return
+	}
+	now := x.now()
+	if !event.AddTimestamp(x.timestamp, now) {
+		modifierMethods[E]{schema: x.schema}.time(event, x.timestamp, now)
+	}
+}
+
 func (x *loggerShared[E]) init() {
 	switch any(x).(type) {
 	case *loggerShared[Event]:
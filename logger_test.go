@@ -594,6 +594,33 @@ func TestLogger_Logger_nilShared(t *testing.T) {
 	}
 }
 
+func TestLogger_Logger_dpanic(t *testing.T) {
+	l := New(mockL.WithDPanicLevel(LevelError)).Logger()
+	if l.shared.dpanic != LevelError {
+		t.Errorf("unexpected dpanic level: %s", l.shared.dpanic)
+	}
+}
+
+// Loggers without rate limits are unaffected by Logger propagating them, but
+// DPanic now uses the default level, rather than panicking.
+func TestLogger_Logger_defaults(t *testing.T) {
+	var buf bytes.Buffer
+	l := newSimpleLogger(&buf, false).Logger()
+	if l.shared.catrate != nil || l.shared.limits != nil {
+		t.Error(`expected no rate limiter`)
+	}
+	if l.shared.dpanic != LevelCritical {
+		t.Errorf("unexpected dpanic level: %s", l.shared.dpanic)
+	}
+	for range 3 {
+		l.Info().Limit().Log(`unlimited`)
+	}
+	l.DPanic().Log(`no panic`)
+	if s := buf.String(); s != "[info] msg=unlimited\n[info] msg=unlimited\n[info] msg=unlimited\n[crit] msg=no panic\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+}
+
 // An example of how to use the non-fluent Log method.
 func ExampleLogger_Log() {
 	l := newSimpleLogger(os.Stdout, false).Logger()
@@ -825,3 +852,114 @@ func TestLogger_Root(t *testing.T) {
 		t.Error()
 	}
 }
+
+func TestWithLevelVar(t *testing.T) {
+	var buf bytes.Buffer
+	level := NewLevelVar(LevelWarning)
+	l := New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+		mockL.WithLevelVar(level),
+	)
+	if v := l.Level(); v != LevelWarning {
+		t.Error(v)
+	}
+	l.Info().Log(`1`)
+	l.Warning().Log(`2`)
+	level.Set(LevelDebug)
+	l.Debug().Log(`3`)
+	if v := l.Logger().Level(); v != LevelDebug {
+		t.Error(v)
+	}
+	level.Set(LevelDisabled)
+	if v := l.Level(); v != LevelDisabled {
+		t.Error(v)
+	}
+	l.Emerg().Log(`4`)
+	l.Build(9).Log(`5`)
+	if s := buf.String(); s != "[warning] msg=2\n[debug] msg=3\n[9] msg=5\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+
+	// the last option applies
+	l = New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+		mockL.WithLevelVar(level),
+		mockL.WithLevel(LevelError),
+		mockL.WithLevelVar(nil),
+	)
+	if v := l.Level(); v != LevelError {
+		t.Error(v)
+	}
+}
+
+func TestWithTimestamp(t *testing.T) {
+	ts := time.Unix(0, 1616592449876543213).UTC()
+	clock := func() time.Time { return ts }
+
+	t.Run(`AddTimestamp`, func(t *testing.T) {
+		w := mockComplexWriter{}
+		l := New[*mockComplexEvent](
+			WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+			WithWriter[*mockComplexEvent](&w),
+			WithTimestamp[*mockComplexEvent](`ts`),
+			WithClock[*mockComplexEvent](clock),
+		)
+		l.Clone().Str(`a`, `b`).Logger().Info().Log(`built`)
+		if err := l.Log(LevelInformational, nil); err != nil {
+			t.Fatal(err)
+		}
+		expected := []*mockComplexEvent{
+			{
+				LevelValue: LevelInformational,
+				FieldValues: []mockComplexEventField{
+					{Type: `AddTimestamp`, Key: `ts`, Value: ts},
+					{Type: `AddString`, Key: `a`, Value: `b`},
+					{Type: `AddMessage`, Value: `built`},
+				},
+			},
+			{
+				LevelValue: LevelInformational,
+				FieldValues: []mockComplexEventField{
+					{Type: `AddTimestamp`, Key: `ts`, Value: ts},
+				},
+			},
+		}
+		if !reflect.DeepEqual(w.events, expected) {
+			t.Errorf("got %v, want %v", w.events, expected)
+		}
+	})
+
+	t.Run(`fallback`, func(t *testing.T) {
+		var buf bytes.Buffer
+		l := New(
+			mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+			mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+			mockL.WithTimestamp(`ts`),
+			mockL.WithClock(clock),
+		).Logger()
+		l.Info().Log(`built`)
+		if err := l.Log(LevelNotice, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != "[info] ts=2021-03-24T13:27:29.876543213Z msg=built\n[notice] ts=2021-03-24T13:27:29.876543213Z\n" {
+			t.Errorf("unexpected output: %q\n%s", s, s)
+		}
+	})
+
+	t.Run(`disabled`, func(t *testing.T) {
+		var buf bytes.Buffer
+		l := New(
+			mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+			mockL.WithWriter(&mockSimpleWriter{Writer: &buf}),
+			mockL.WithTimestamp(`ts`),
+			mockL.WithTimestamp(``),
+			mockL.WithClock(func() time.Time { panic(`should not be called`) }),
+		)
+		l.Info().Log(`built`)
+		if s := buf.String(); s != "[info] msg=built\n" {
+			t.Errorf("unexpected output: %q\n%s", s, s)
+		}
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -37,17 +39,17 @@ type (
 	//   1. Add the new field type method to the [Event] interface (e.g. AddDuration)
 	//   2. Add the new field type method to the [UnimplementedEvent] struct (return false)
 	//   3. Add the calling/fallback behavior as a new unexported method of the internal modifierMethods struct (e.g. dur)
-	//   4. Update the (internal) modifierMethods.Field method, with type case(s) using 3., for the new field type
+	//   4. Update the (internal) modifierMethods.field method, with type case(s) using 3., for the new field type (and modifierMethods.fieldPtr, for the pointer form)
 	//   5. Add a new (internal) method to the modifierMethods struct, using 3., named per [Builder] and [Context] (e.g. Dur)
 	//   6. Add to each of [Builder] and [Context] a method named the same as and using 5. (e.g. Dur)
 	//   9. Add the Event method to mockComplexEvent in mock_test.go
 	//   10. Run make in the root of the git repository, fix any issues
 	//   11. Add appropriate Field and specific method calls (e.g. Dur) to internal/fieldtest.FluentObjectTemplate (note: update the T generic interface)
 	//   12. Fix all test cases that fail
-	//   13. Update the testsuite module to include the new field type (e.g. throw it on eventTemplate1 in templates.go)
+	//   13. Add the Event method to eventtest, i.e. the masked (maskedEvent) and reference (refEvent) implementations
 	//   14. Run make in the root of the git repository, everything should still pass
 	//   15. Implement new field type in all relevant implementation modules (e.g. joeycumines/stumpy, joeycumines/izerolog)
-	//   16. Fix any issues with the test harness implementations, which may require adding additional functionality to logiface-testsuite, see also normalizeEvent
+	//   16. Fix any issues with the implementations, see also eventtest.Run, which may require updating the comparison logic in eventtest
 	//   17. Consider adding or updating benchmarks, e.g. the comparison (vs direct use) benchmarks in joeycumines/izerolog
 	//   18. Add the field to fieldBuilderObjectInterface in method_test.go
 	//   19. Add the field to fieldBuilderArrayInterface, unless not applicable
@@ -104,6 +106,54 @@ type (
 		// If not implemented, consumers (like SlogHandler) may fall back to
 		// flattening keys (e.g. "group.key").
 		AddGroup(name string) bool
+		// AddStrings adds a field of type []string. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Strs].
+		AddStrings(key string, val []string) bool
+		// AddInts adds a field of type []int. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Ints].
+		AddInts(key string, val []int) bool
+		// AddInt64s adds a field of type []int64. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Int64s].
+		AddInt64s(key string, val []int64) bool
+		// AddFloat64s adds a field of type []float64. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Float64s].
+		AddFloat64s(key string, val []float64) bool
+		// AddBools adds a field of type []bool. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Bools].
+		AddBools(key string, val []bool) bool
+		// AddDurations adds a field of type []time.Duration. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Durs].
+		AddDurations(key string, val []time.Duration) bool
+		// AddTimes adds a field of type []time.Time. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Times].
+		AddTimes(key string, val []time.Time) bool
+		// AddErrors adds a field of type []error. It's an optional optimisation.
+		// If not implemented, the field will be added as an array, per [Builder.Errs].
+		AddErrors(key string, val []error) bool
+		// AddIPAddr adds a field of type netip.Addr. It's an optional optimisation.
+		// If not implemented, it will be encoded then handled as a string, per
+		// netip.Addr.MarshalText (the zero value is encoded as an empty string).
+		AddIPAddr(key string, val netip.Addr) bool
+		// AddIPPrefix adds a field of type netip.Prefix. It's an optional optimisation.
+		// If not implemented, it will be encoded then handled as a string, per
+		// netip.Prefix.MarshalText (the zero value is encoded as an empty string).
+		AddIPPrefix(key string, val netip.Prefix) bool
+		// AddURL adds a field of type *url.URL. It's an optional optimisation.
+		// The val param will always be non-nil. Implementations should avoid
+		// logging any password, e.g. by using url.URL.Redacted.
+		// If not implemented, it will be encoded then handled as a string, per
+		// url.URL.Redacted.
+		AddURL(key string, val *url.URL) bool
+		// AddHexBytes adds bytes as a field, to be hex encoded. It's an optional
+		// optimisation.
+		// If not implemented, it will be encoded then handled as a string, per
+		// hex.EncodeToString (lowercase, no separators).
+		AddHexBytes(key string, val []byte) bool
+		// AddTimestamp adds the timestamp of the event, see also WithTimestamp.
+		// The key will be the one configured, but implementations may prefer
+		// to use a dedicated field or encoding. It's an optional optimisation.
+		// If not implemented, AddTime will be used instead.
+		AddTimestamp(key string, val time.Time) bool
 
 		mustEmbedUnimplementedEvent()
 	}
@@ -289,6 +339,32 @@ func (UnimplementedEvent) AddRawJSON(string, json.RawMessage) bool { return fals
 
 func (UnimplementedEvent) AddGroup(string) bool { return false }
 
+func (UnimplementedEvent) AddStrings(string, []string) bool { return false }
+
+func (UnimplementedEvent) AddInts(string, []int) bool { return false }
+
+func (UnimplementedEvent) AddInt64s(string, []int64) bool { return false }
+
+func (UnimplementedEvent) AddFloat64s(string, []float64) bool { return false }
+
+func (UnimplementedEvent) AddBools(string, []bool) bool { return false }
+
+func (UnimplementedEvent) AddDurations(string, []time.Duration) bool { return false }
+
+func (UnimplementedEvent) AddTimes(string, []time.Time) bool { return false }
+
+func (UnimplementedEvent) AddErrors(string, []error) bool { return false }
+
+func (UnimplementedEvent) AddIPAddr(string, netip.Addr) bool { return false }
+
+func (UnimplementedEvent) AddIPPrefix(string, netip.Prefix) bool { return false }
+
+func (UnimplementedEvent) AddURL(string, *url.URL) bool { return false }
+
+func (UnimplementedEvent) AddHexBytes(string, []byte) bool { return false }
+
+func (UnimplementedEvent) AddTimestamp(string, time.Time) bool { return false }
+
 func (UnimplementedEvent) mustEmbedUnimplementedEvent() {}
 
 // NewEventFactoryFunc is an alias provided as a convenience, to make it easier to cast a function to an
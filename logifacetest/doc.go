@@ -0,0 +1,10 @@
+// Package logifacetest provides utilities for testing code that uses
+// logiface, including a capturing [Event] implementation, an [Observer],
+// which records events, for inspection and assertions, similar to zap's
+// observer package, value matchers (see [Matcher]), and deterministic
+// rendering, suitable for golden files (see [Render]).
+//
+// Captured events retain field values as provided (e.g. int, time.Time),
+// though comparisons, matching, and rendering are performed against
+// normalized values, see [Normalize].
+package logifacetest
@@ -0,0 +1,152 @@
+package logifacetest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/joeycumines/logiface"
+	"time"
+)
+
+type (
+	// Event implements [logiface.Event], capturing everything that was
+	// added, and is safe to retain, after it has been written, as it is
+	// never pooled. See also [Observer].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// Time is the time the event was created, or zero, if the observer
+		// was not configured with a clock, see [WithClock].
+		Time time.Time
+
+		// Err is the error, see [logiface.Builder.Err].
+		Err error
+
+		// Message is the message, see [logiface.Builder.Log].
+		Message string
+
+		// Fields are all other fields, in the order they were added.
+		Fields []Field
+
+		// Lvl is the level of the event.
+		Lvl logiface.Level
+	}
+
+	// Field is a single key-value pair, see [Event.Fields].
+	Field struct {
+		Value any
+		Key   string
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Level returns the level of the event, or [logiface.LevelDisabled], if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.Lvl
+}
+
+// Field returns the value of the first field with the given key.
+func (x *Event) Field(key string) (any, bool) {
+	for _, f := range x.Fields {
+		if f.Key == key {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Map returns the normalized fields, keyed by name, excluding the level,
+// message, and error. If a key is duplicated, the last value is used.
+func (x *Event) Map() map[string]any {
+	m := make(map[string]any, len(x.Fields))
+	for _, f := range x.Fields {
+		m[f.Key] = Normalize(f.Value)
+	}
+	return m
+}
+
+// String returns the rendered event, see [Render].
+func (x *Event) String() string {
+	return string(x.appendJSON(nil))
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.Fields = append(x.Fields, Field{Key: key, Value: val})
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	x.Message = msg
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	x.Err = err
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.AddField(key, enc.EncodeToString(val))
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.AddField(key, val)
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.AddField(key, append(json.RawMessage(nil), val...))
+	return true
+}
@@ -0,0 +1,44 @@
+package logifacetest_test
+
+import (
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/logifacetest"
+	"os"
+	"testing"
+)
+
+func Example() {
+	logger, observer := logifacetest.New(logifacetest.L.WithLevel(logiface.LevelDebug))
+
+	logger.Info().
+		Str(`user`, `alice`).
+		Object().
+		Int(`id`, 3).
+		Array().Str(`a`).Str(`b`).As(`tags`).
+		As(`req`).
+		End().
+		Log(`request`)
+	logger.Debug().Str(`user`, `bob`).Log(`request`)
+
+	// in a test: observer.AssertLogged(t, logiface.LevelInformational, `request`, ...)
+	matched := observer.
+		FilterMessage(`request`).
+		FilterField(`req`, logifacetest.Object(`id`, 3, `tags`, logifacetest.Array(`a`, logifacetest.Any())))
+	fmt.Println(matched.Len())
+
+	_ = logifacetest.Render(os.Stdout, observer.All()...)
+
+	//output:
+	//1
+	//{"lvl":"info","msg":"request","req":{"id":3,"tags":["a","b"]},"user":"alice"}
+	//{"lvl":"debug","msg":"request","user":"bob"}
+}
+
+func ExampleNewT() {
+	// in a test, this would be: func TestSomething(t *testing.T) {
+	_ = func(t *testing.T) {
+		logger := logifacetest.NewT(t, logifacetest.WithDumpOnFailure(true))
+		logger.Debug().Log(`only shown if the test fails`)
+	}
+}
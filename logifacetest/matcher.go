@@ -0,0 +1,144 @@
+package logifacetest
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+type (
+	// Matcher matches a normalized value (see [Normalize]), e.g. that of a
+	// field. Wherever a Matcher is accepted, any other value will be
+	// treated as [Eq].
+	Matcher interface {
+		Match(val any) bool
+	}
+
+	// MatcherFunc implements [Matcher].
+	MatcherFunc func(val any) bool
+)
+
+var (
+	// compile time assertions
+
+	_ Matcher = MatcherFunc(nil)
+)
+
+func (x MatcherFunc) Match(val any) bool { return x(val) }
+
+// Any matches any value, e.g. to assert that a field is present.
+func Any() Matcher {
+	return MatcherFunc(func(val any) bool { return true })
+}
+
+// Eq matches values that are deeply equal to v, after normalization.
+func Eq(v any) Matcher {
+	v = Normalize(v)
+	return MatcherFunc(func(val any) bool { return reflect.DeepEqual(val, v) })
+}
+
+// Contains matches string values that contain substr.
+func Contains(substr string) Matcher {
+	return MatcherFunc(func(val any) bool {
+		s, ok := val.(string)
+		return ok && strings.Contains(s, substr)
+	})
+}
+
+// Regexp matches string values that match the pattern, which must be valid.
+func Regexp(pattern string) Matcher {
+	re := regexp.MustCompile(pattern)
+	return MatcherFunc(func(val any) bool {
+		s, ok := val.(string)
+		return ok && re.MatchString(s)
+	})
+}
+
+// Object matches object values that contain the given fields, as
+// alternating keys (strings) and values, which may be a [Matcher]. Other
+// fields are ignored.
+func Object(fields ...any) Matcher {
+	matchers := parseFields(fields)
+	return MatcherFunc(func(val any) bool {
+		m, ok := val.(map[string]any)
+		if !ok {
+			return false
+		}
+		for _, f := range matchers {
+			if v, ok := m[f.key]; !ok || !f.matcher.Match(v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Array matches array values that have exactly the given elements, in
+// order, each of which may be a [Matcher].
+func Array(elems ...any) Matcher {
+	matchers := make([]Matcher, len(elems))
+	for i, v := range elems {
+		matchers[i] = toMatcher(v)
+	}
+	return MatcherFunc(func(val any) bool {
+		s, ok := val.([]any)
+		if !ok || len(s) != len(matchers) {
+			return false
+		}
+		for i, m := range matchers {
+			if !m.Match(s[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Not inverts the given matcher, or value.
+func Not(v any) Matcher {
+	m := toMatcher(v)
+	return MatcherFunc(func(val any) bool { return !m.Match(val) })
+}
+
+type fieldMatcher struct {
+	matcher Matcher
+	key     string
+}
+
+func toMatcher(v any) Matcher {
+	if m, ok := v.(Matcher); ok {
+		return m
+	}
+	return Eq(v)
+}
+
+func parseFields(fields []any) []fieldMatcher {
+	if len(fields)%2 != 0 {
+		panic(fmt.Sprintf(`logifacetest: odd number of field arguments: %d`, len(fields)))
+	}
+	matchers := make([]fieldMatcher, len(fields)/2)
+	for i := range matchers {
+		key, ok := fields[i*2].(string)
+		if !ok {
+			panic(fmt.Sprintf(`logifacetest: field key must be a string: %T`, fields[i*2]))
+		}
+		matchers[i] = fieldMatcher{key: key, matcher: toMatcher(fields[i*2+1])}
+	}
+	return matchers
+}
+
+// matchFields returns true if every matcher matches at least one field with
+// the same key
+func (x *Event) matchFields(matchers []fieldMatcher) bool {
+Outer:
+	for _, m := range matchers {
+		for _, f := range x.Fields {
+			if f.Key == m.key && m.matcher.Match(Normalize(f.Value)) {
+				continue Outer
+			}
+		}
+		return false
+	}
+	return true
+}
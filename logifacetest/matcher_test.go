@@ -0,0 +1,54 @@
+package logifacetest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	obj := Normalize(map[string]any{
+		`a`: 1,
+		`b`: []any{`x`, map[string]any{`c`: true}},
+	})
+	for _, tc := range [...]struct {
+		Name    string
+		Matcher Matcher
+		Value   any
+		Want    bool
+	}{
+		{Name: `any`, Matcher: Any(), Value: nil, Want: true},
+		{Name: `eq`, Matcher: Eq(1), Value: json.Number(`1`), Want: true},
+		{Name: `eq mismatch`, Matcher: Eq(1), Value: `1`, Want: false},
+		{Name: `contains`, Matcher: Contains(`b`), Value: `abc`, Want: true},
+		{Name: `contains not string`, Matcher: Contains(`1`), Value: json.Number(`1`), Want: false},
+		{Name: `regexp`, Matcher: Regexp(`^a.c$`), Value: `abc`, Want: true},
+		{Name: `regexp mismatch`, Matcher: Regexp(`^a.c$`), Value: `abcd`, Want: false},
+		{Name: `object`, Matcher: Object(`a`, 1, `b`, Array(`x`, Object(`c`, true))), Value: obj, Want: true},
+		{Name: `object partial`, Matcher: Object(`a`, Any()), Value: obj, Want: true},
+		{Name: `object missing key`, Matcher: Object(`z`, Any()), Value: obj, Want: false},
+		{Name: `object not object`, Matcher: Object(), Value: `a`, Want: false},
+		{Name: `array length`, Matcher: Array(`x`), Value: []any{`x`, `y`}, Want: false},
+		{Name: `array not array`, Matcher: Array(), Value: `a`, Want: false},
+		{Name: `not`, Matcher: Not(`a`), Value: `b`, Want: true},
+		{Name: `not matcher`, Matcher: Not(Any()), Value: `b`, Want: false},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if v := tc.Matcher.Match(tc.Value); v != tc.Want {
+				t.Errorf("unexpected result: %v", v)
+			}
+		})
+	}
+}
+
+func TestParseFields_panics(t *testing.T) {
+	for _, fields := range [...][]any{{`a`}, {1, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error(`expected panic`)
+				}
+			}()
+			parseFields(fields)
+		}()
+	}
+}
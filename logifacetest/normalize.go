@@ -0,0 +1,139 @@
+package logifacetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Normalize converts a value to the form used for comparison, matching, and
+// rendering, which is that of JSON decoded using [json.Decoder.UseNumber],
+// i.e. nil, bool, string, json.Number, []any, and map[string]any.
+//
+// Errors are converted to their message, and time.Time and time.Duration
+// values are converted to strings, using the same format as the fallback
+// behavior of logiface (that of protobuf's JSON encoding), e.g.
+// "2020-01-02T03:04:05.123Z", and "1.500s". Raw JSON is decoded, and other
+// values are round-tripped through encoding/json, or formatted using
+// fmt.Sprint, if that fails.
+func Normalize(v any) any {
+	switch v := v.(type) {
+	case nil, bool, string, json.Number:
+		return v
+	case error:
+		return v.Error()
+	case time.Time:
+		return pbtime.FormatTimestamp(v)
+	case time.Duration:
+		return pbtime.FormatDuration(v)
+	case int:
+		return json.Number(strconv.FormatInt(int64(v), 10))
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case uint64:
+		return json.Number(strconv.FormatUint(v, 10))
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			m[k] = Normalize(v)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, v := range v {
+			s[i] = Normalize(v)
+		}
+		return s
+	case json.RawMessage:
+		if r, ok := decodeJSON(v); ok {
+			return r
+		}
+		return string(v)
+	}
+	if b, err := json.Marshal(v); err == nil {
+		if r, ok := decodeJSON(b); ok {
+			return r
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+func decodeJSON(b []byte) (v any, ok bool) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, false
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, false
+	}
+	return v, true
+}
+
+// Render writes each event to w, as a single line of JSON, with sorted keys,
+// and normalized values (see [Normalize]), making it suitable for use with
+// golden files. The level, message (if any), error (if any), and time (if
+// any), use the keys "lvl", "msg", "err", and "time". Fields with duplicate
+// keys are rendered in the order they were added.
+func Render(w io.Writer, events ...*Event) error {
+	var b []byte
+	for _, event := range events {
+		b = append(event.appendJSON(b[:0]), '\n')
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func renderString(events []*Event) string {
+	var b strings.Builder
+	_ = Render(&b, events...)
+	return b.String()
+}
+
+func (x *Event) appendJSON(b []byte) []byte {
+	fields := make([]Field, 0, len(x.Fields)+4)
+	fields = append(fields, Field{Key: `lvl`, Value: x.Lvl.String()})
+	if x.Message != `` {
+		fields = append(fields, Field{Key: `msg`, Value: x.Message})
+	}
+	if x.Err != nil {
+		fields = append(fields, Field{Key: `err`, Value: x.Err})
+	}
+	if !x.Time.IsZero() {
+		fields = append(fields, Field{Key: `time`, Value: x.Time})
+	}
+	fields = append(fields, x.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+
+	b = append(b, '{')
+	for i, f := range fields {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONValue(b, f.Key)
+		b = append(b, ':')
+		b = appendJSONValue(b, Normalize(f.Value))
+	}
+	return append(b, '}')
+}
+
+// appendJSONValue appends a normalized value, noting that encoding/json
+// sorts map keys
+func appendJSONValue(b []byte, v any) []byte {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		// unreachable for normalized values
+		panic(err)
+	}
+	return append(b, bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})...)
+}
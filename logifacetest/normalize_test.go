@@ -0,0 +1,42 @@
+package logifacetest
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	type S struct {
+		A int `json:"a"`
+	}
+	for _, tc := range [...]struct {
+		Name  string
+		Value any
+		Want  any
+	}{
+		{Name: `nil`, Value: nil, Want: nil},
+		{Name: `int`, Value: 5, Want: json.Number(`5`)},
+		{Name: `int64`, Value: int64(math.MinInt64), Want: json.Number(`-9223372036854775808`)},
+		{Name: `uint64`, Value: uint64(math.MaxUint64), Want: json.Number(`18446744073709551615`)},
+		{Name: `float64`, Value: 1.5, Want: json.Number(`1.5`)},
+		{Name: `float32`, Value: float32(5), Want: json.Number(`5`)},
+		{Name: `error`, Value: errors.New(`e`), Want: `e`},
+		{Name: `time`, Value: time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone(``, 3600)), Want: `2020-01-02T02:04:05Z`},
+		{Name: `duration`, Value: time.Millisecond, Want: `0.001s`},
+		{Name: `raw json`, Value: json.RawMessage(`{"a":[1,null]}`), Want: map[string]any{`a`: []any{json.Number(`1`), nil}}},
+		{Name: `invalid raw json`, Value: json.RawMessage(`{`), Want: `{`},
+		{Name: `struct`, Value: S{A: 2}, Want: map[string]any{`a`: json.Number(`2`)}},
+		{Name: `nested`, Value: map[string]any{`a`: []any{time.Second}}, Want: map[string]any{`a`: []any{`1s`}}},
+		{Name: `unsupported`, Value: math.Inf(1), Want: `+Inf`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if v := Normalize(tc.Value); !reflect.DeepEqual(v, tc.Want) {
+				t.Errorf("unexpected value: %#v", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package logifacetest
+
+import (
+	"github.com/joeycumines/logiface"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	// Observer implements [logiface.EventFactory], and [logiface.Writer],
+	// recording every event that is written, which may then be inspected,
+	// filtered, or asserted against. It is safe for concurrent use.
+	// See also [New], and [WithObserver].
+	Observer struct {
+		clock  func() time.Time
+		mu     sync.Mutex
+		events []*Event
+	}
+
+	// Option models a configuration option for [NewObserver], all of which
+	// may also be used with [NewT].
+	Option interface {
+		TOption
+		apply(c *observerConfig)
+	}
+
+	optionFunc func(c *observerConfig)
+
+	observerConfig struct {
+		clock func() time.Time
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event] = (*Observer)(nil)
+	_ logiface.Writer[*Event]       = (*Observer)(nil)
+	_ Option                        = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = logiface.LoggerFactory[*Event]{}
+)
+
+func (x optionFunc) apply(c *observerConfig) { x(c) }
+
+func (x optionFunc) applyT(c *tConfig) { x(&c.observer) }
+
+// WithClock configures the observer to set [Event.Time], when each event is
+// created. By default, the time is not set, which keeps rendering
+// deterministic. See also [FixedClock].
+func WithClock(clock func() time.Time) Option {
+	return optionFunc(func(c *observerConfig) {
+		c.clock = clock
+	})
+}
+
+// FixedClock returns a clock, for use with [WithClock], that always returns
+// t.
+func FixedClock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+// NewObserver initializes a new [Observer].
+func NewObserver(options ...Option) *Observer {
+	var c observerConfig
+	for _, o := range options {
+		o.apply(&c)
+	}
+	return &Observer{clock: c.clock}
+}
+
+// WithObserver configures a logiface logger to write to the given observer.
+func WithObserver(observer *Observer) logiface.Option[*Event] {
+	return L.WithOptions(
+		L.WithEventFactory(observer),
+		L.WithWriter(observer),
+	)
+}
+
+// New initializes a new logger, writing to a new [Observer], with any
+// additional options (e.g. [logiface.WithLevel]) applied last.
+func New(options ...logiface.Option[*Event]) (*logiface.Logger[*Event], *Observer) {
+	observer := NewObserver()
+	return L.New(append([]logiface.Option[*Event]{WithObserver(observer)}, options...)...), observer
+}
+
+func (x *Observer) NewEvent(level logiface.Level) *Event {
+	e := Event{Lvl: level}
+	if x.clock != nil {
+		e.Time = x.clock()
+	}
+	return &e
+}
+
+// Write records the event.
+func (x *Observer) Write(event *Event) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.events = append(x.events, event)
+	return nil
+}
+
+// Len returns the number of recorded events.
+func (x *Observer) Len() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return len(x.events)
+}
+
+// All returns a copy of the recorded events, in the order they were written.
+func (x *Observer) All() []*Event {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return append([]*Event(nil), x.events...)
+}
+
+// TakeAll returns the recorded events, and resets the observer.
+func (x *Observer) TakeAll() []*Event {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	events := x.events
+	x.events = nil
+	return events
+}
+
+// String returns the rendered events, see [Render].
+func (x *Observer) String() string {
+	return renderString(x.All())
+}
+
+// Filter returns a new observer, containing only the recorded events for
+// which fn returns true.
+func (x *Observer) Filter(fn func(event *Event) bool) *Observer {
+	filtered := Observer{clock: x.clock}
+	for _, event := range x.All() {
+		if fn(event) {
+			filtered.events = append(filtered.events, event)
+		}
+	}
+	return &filtered
+}
+
+// FilterLevelExact filters the events, see [Observer.Filter], to those with
+// the given level.
+func (x *Observer) FilterLevelExact(level logiface.Level) *Observer {
+	return x.Filter(func(event *Event) bool { return event.Lvl == level })
+}
+
+// FilterMessage filters the events, see [Observer.Filter], to those with
+// the given message.
+func (x *Observer) FilterMessage(msg string) *Observer {
+	return x.Filter(func(event *Event) bool { return event.Message == msg })
+}
+
+// FilterMessageSnippet filters the events, see [Observer.Filter], to those
+// with a message containing the given substring.
+func (x *Observer) FilterMessageSnippet(snippet string) *Observer {
+	return x.Filter(func(event *Event) bool { return strings.Contains(event.Message, snippet) })
+}
+
+// FilterField filters the events, see [Observer.Filter], to those with a
+// field with the given key, and a value matching val, which may be a
+// [Matcher].
+func (x *Observer) FilterField(key string, val any) *Observer {
+	matchers := parseFields([]any{key, val})
+	return x.Filter(func(event *Event) bool { return event.matchFields(matchers) })
+}
+
+// FilterFieldKey filters the events, see [Observer.Filter], to those with a
+// field with the given key.
+func (x *Observer) FilterFieldKey(key string) *Observer {
+	return x.FilterField(key, Any())
+}
+
+// AssertLogged asserts that at least one event was recorded, with the given
+// level and message, and fields, provided as alternating keys (strings) and
+// values, which may be a [Matcher]. Fields that are not specified are
+// ignored. The first matching event is returned, or nil, in which case the
+// test is marked as failed, and the recorded events are logged.
+func (x *Observer) AssertLogged(t testing.TB, level logiface.Level, msg string, fields ...any) *Event {
+	t.Helper()
+	if event := x.find(level, msg, fields); event != nil {
+		return event
+	}
+	t.Errorf("logifacetest: no event matching level=%s msg=%q fields=%v, recorded:\n%s", level, msg, fields, x)
+	return nil
+}
+
+// AssertNotLogged asserts that no event was recorded that would satisfy
+// [Observer.AssertLogged].
+func (x *Observer) AssertNotLogged(t testing.TB, level logiface.Level, msg string, fields ...any) {
+	t.Helper()
+	if event := x.find(level, msg, fields); event != nil {
+		t.Errorf("logifacetest: unexpected event matching level=%s msg=%q fields=%v: %s", level, msg, fields, event)
+	}
+}
+
+func (x *Observer) find(level logiface.Level, msg string, fields []any) *Event {
+	matchers := parseFields(fields)
+	for _, event := range x.All() {
+		if event.Lvl == level && event.Message == msg && event.matchFields(matchers) {
+			return event
+		}
+	}
+	return nil
+}
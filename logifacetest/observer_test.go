@@ -0,0 +1,128 @@
+package logifacetest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type mockTB struct {
+	testing.TB
+	errors []string
+}
+
+func (x *mockTB) Helper() {}
+
+func (x *mockTB) Errorf(format string, args ...any) {
+	x.errors = append(x.errors, fmt.Sprintf(format, args...))
+}
+
+func TestObserver(t *testing.T) {
+	logger, observer := New(L.WithLevel(logiface.LevelDebug))
+
+	logger.Info().
+		Str(`str`, `v`).
+		Int(`int`, 1).
+		Err(errors.New(`some error`)).
+		Dur(`dur`, time.Second*3/2).
+		Object().
+		Field(`a`, 1).
+		Array().
+		Str(`b`).
+		Bool(true).
+		As(`arr`).
+		As(`obj`).
+		End().
+		Log(`first`)
+	logger.Debug().Str(`k`, `v1`).Log(`second`)
+	logger.Trace().Log(`not logged`)
+	logger.Warning().Str(`k`, `v2`).Log(`third message`)
+
+	if n := observer.Len(); n != 3 {
+		t.Fatal(n)
+	}
+
+	observer.AssertLogged(t, logiface.LevelInformational, `first`)
+	observer.AssertLogged(t, logiface.LevelInformational, `first`,
+		`str`, `v`,
+		`int`, int64(1),
+		`dur`, `1.500s`,
+		`obj`, Object(`a`, 1.0, `arr`, Array(Contains(`b`), true)),
+	)
+	if event := observer.AssertLogged(t, logiface.LevelDebug, `second`, `k`, Any()); event == nil || event.Err != nil {
+		t.Error(event)
+	}
+	observer.AssertNotLogged(t, logiface.LevelTrace, `not logged`)
+	observer.AssertNotLogged(t, logiface.LevelDebug, `second`, `k`, `v2`)
+
+	if n := observer.FilterLevelExact(logiface.LevelDebug).Len(); n != 1 {
+		t.Error(n)
+	}
+	if n := observer.FilterMessage(`first`).Len(); n != 1 {
+		t.Error(n)
+	}
+	if n := observer.FilterMessageSnippet(`d`).Len(); n != 2 {
+		t.Error(n)
+	}
+	if n := observer.FilterFieldKey(`k`).Len(); n != 2 {
+		t.Error(n)
+	}
+	if n := observer.FilterField(`k`, `v2`).FilterMessageSnippet(`message`).Len(); n != 1 {
+		t.Error(n)
+	}
+
+	if s := observer.String(); s != `{"dur":"1.500s","err":"some error","int":1,"lvl":"info","msg":"first","obj":{"a":1,"arr":["b",true]},"str":"v"}
+{"k":"v1","lvl":"debug","msg":"second"}
+{"k":"v2","lvl":"warning","msg":"third message"}
+` {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+
+	if events := observer.TakeAll(); len(events) != 3 || observer.Len() != 0 {
+		t.Error(len(events), observer.Len())
+	}
+}
+
+func TestObserver_AssertLogged_failure(t *testing.T) {
+	logger, observer := New()
+	logger.Info().Str(`k`, `v`).Log(`msg`)
+
+	var tb mockTB
+	if event := observer.AssertLogged(&tb, logiface.LevelInformational, `msg`, `k`, `other`); event != nil {
+		t.Error(event)
+	}
+	observer.AssertNotLogged(&tb, logiface.LevelInformational, `msg`, `k`, Not(`other`))
+	if len(tb.errors) != 2 ||
+		tb.errors[0] != "logifacetest: no event matching level=info msg=\"msg\" fields=[k other], recorded:\n{\"k\":\"v\",\"lvl\":\"info\",\"msg\":\"msg\"}\n" ||
+		!strings.HasPrefix(tb.errors[1], `logifacetest: unexpected event matching level=info msg="msg"`) {
+		t.Errorf("unexpected errors: %q", tb.errors)
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	observer := NewObserver(WithClock(FixedClock(time.Date(2020, 1, 2, 3, 4, 5, 6000000, time.UTC))))
+	logger := L.New(WithObserver(observer))
+	logger.Info().Log(`a`)
+	if s := observer.String(); s != `{"lvl":"info","msg":"a","time":"2020-01-02T03:04:05.006Z"}`+"\n" {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
+
+func TestObserver_concurrent(t *testing.T) {
+	logger, observer := New()
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			logger.Info().Int(`i`, i).Log(``)
+			_ = observer.All()
+		})
+	}
+	wg.Wait()
+	if n := observer.Len(); n != 10 {
+		t.Error(n)
+	}
+}
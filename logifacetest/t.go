@@ -0,0 +1,115 @@
+package logifacetest
+
+import (
+	"github.com/joeycumines/logiface"
+	"sync"
+	"testing"
+)
+
+type (
+	// TOption models a configuration option for [NewT], which includes all
+	// [Option] values, see also [WithLevel], and [WithDumpOnFailure].
+	TOption interface {
+		applyT(c *tConfig)
+	}
+
+	tOptionFunc func(c *tConfig)
+
+	tConfig struct {
+		observer      observerConfig
+		level         logiface.Level
+		dumpOnFailure bool
+	}
+
+	// tWriter writes events using testing.TB.Log
+	tWriter struct {
+		t        testing.TB
+		observer *Observer // non-nil if dumping on failure
+		mu       sync.Mutex
+		done     bool
+	}
+)
+
+var (
+	// compile time assertions
+
+	_ TOption = tOptionFunc(nil)
+	_ TOption = optionFunc(nil)
+)
+
+func (x tOptionFunc) applyT(c *tConfig) { x(c) }
+
+// WithLevel configures the level of the logger returned by [NewT], which
+// defaults to [logiface.LevelTrace].
+func WithLevel(level logiface.Level) TOption {
+	return tOptionFunc(func(c *tConfig) {
+		c.level = level
+	})
+}
+
+// WithDumpOnFailure configures the logger returned by [NewT] to buffer all
+// events, logging them only if the test has failed, once it completes.
+func WithDumpOnFailure(dumpOnFailure bool) TOption {
+	return tOptionFunc(func(c *tConfig) {
+		c.dumpOnFailure = dumpOnFailure
+	})
+}
+
+// NewT initializes a new logger, which writes each event, rendered per
+// [Render], using t.Log, meaning output is attributed to the test,
+// interleaved with other test output, and (under go test, without -v) only
+// shown if the test fails. See also [WithDumpOnFailure], and [WithLevel].
+//
+// Events written after the test completes are discarded.
+//
+// The writer is marked as a helper (see testing.TB.Helper), so output is
+// attributed to the test, rather than this package. Note that the file and
+// line reported may still be within logiface, rather than the call site of
+// the log method, as only functions that call Helper are skipped.
+func NewT(t testing.TB, options ...TOption) *logiface.Logger[*Event] {
+	t.Helper()
+	c := tConfig{level: logiface.LevelTrace}
+	for _, o := range options {
+		o.applyT(&c)
+	}
+
+	w := tWriter{t: t}
+	observer := Observer{clock: c.observer.clock}
+	if c.dumpOnFailure {
+		w.observer = &observer
+	}
+
+	t.Cleanup(w.cleanup)
+
+	return L.New(
+		L.WithEventFactory(&observer),
+		L.WithWriter(&w),
+		L.WithLevel(c.level),
+	)
+}
+
+func (x *tWriter) Write(event *Event) error {
+	x.t.Helper()
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.done {
+		return nil
+	}
+	if x.observer != nil {
+		return x.observer.Write(event)
+	}
+	x.t.Log(event.String())
+	return nil
+}
+
+func (x *tWriter) cleanup() {
+	x.t.Helper()
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.done = true
+	if x.observer != nil && x.t.Failed() {
+		if events := x.observer.TakeAll(); len(events) != 0 {
+			x.t.Logf("logifacetest: dumping %d event(s):\n%s", len(events), renderString(events))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package logifacetest
+
+import (
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"testing"
+)
+
+type mockT struct {
+	testing.TB
+	logs     []string
+	cleanups []func()
+	helpers  int
+	failed   bool
+}
+
+func (x *mockT) Helper() { x.helpers++ }
+
+func (x *mockT) Log(args ...any) { x.logs = append(x.logs, fmt.Sprint(args...)) }
+
+func (x *mockT) Logf(format string, args ...any) {
+	x.logs = append(x.logs, fmt.Sprintf(format, args...))
+}
+
+func (x *mockT) Cleanup(fn func()) { x.cleanups = append(x.cleanups, fn) }
+
+func (x *mockT) Failed() bool { return x.failed }
+
+func (x *mockT) runCleanups() {
+	for i := len(x.cleanups) - 1; i >= 0; i-- {
+		x.cleanups[i]()
+	}
+}
+
+func TestNewT(t *testing.T) {
+	var m mockT
+	logger := NewT(&m)
+	if v := logger.Level(); v != logiface.LevelTrace {
+		t.Error(v)
+	}
+	logger.Trace().Int(`a`, 1).Log(`one`)
+	logger.Info().Log(`two`)
+	if m.helpers != 3 {
+		t.Errorf("unexpected helpers: %d", m.helpers)
+	}
+	m.failed = true
+	m.runCleanups()
+	logger.Info().Log(`after`)
+	if len(m.logs) != 2 || m.logs[0] != `{"a":1,"lvl":"trace","msg":"one"}` || m.logs[1] != `{"lvl":"info","msg":"two"}` {
+		t.Errorf("unexpected logs: %q", m.logs)
+	}
+}
+
+func TestNewT_dumpOnFailure(t *testing.T) {
+	for _, failed := range [...]bool{false, true} {
+		t.Run(fmt.Sprint(failed), func(t *testing.T) {
+			var m mockT
+			logger := NewT(&m, WithDumpOnFailure(true), WithLevel(logiface.LevelInformational))
+			logger.Debug().Log(`zero`)
+			logger.Info().Log(`one`)
+			logger.Err().Str(`k`, `v`).Log(`two`)
+			if len(m.logs) != 0 {
+				t.Fatalf("unexpected logs: %q", m.logs)
+			}
+			m.failed = failed
+			m.runCleanups()
+			logger.Info().Log(`after`)
+			if !failed {
+				if len(m.logs) != 0 {
+					t.Errorf("unexpected logs: %q", m.logs)
+				}
+			} else if len(m.logs) != 1 || m.logs[0] != "logifacetest: dumping 2 event(s):\n{\"lvl\":\"info\",\"msg\":\"one\"}\n{\"k\":\"v\",\"lvl\":\"err\",\"msg\":\"two\"}\n" {
+				t.Errorf("unexpected logs: %q", m.logs)
+			}
+		})
+	}
+}
+
+func TestNewT_real(t *testing.T) {
+	logger := NewT(t)
+	logger.Info().Str(`k`, `v`).Log(`visible with -v`)
+}
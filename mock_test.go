@@ -3,10 +3,13 @@ package logiface
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	diff "github.com/hexops/gotextdiff"
 	"github.com/hexops/gotextdiff/myers"
 	"io"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -213,6 +216,71 @@ func (x *mockComplexEvent) AddGroup(name string) bool {
 	return true
 }
 
+func (x *mockComplexEvent) AddStrings(key string, val []string) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddStrings`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddInts(key string, val []int) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddInts`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddInt64s(key string, val []int64) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddInt64s`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddFloat64s(key string, val []float64) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddFloat64s`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddBools(key string, val []bool) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddBools`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddDurations(key string, val []time.Duration) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddDurations`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddTimes(key string, val []time.Time) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddTimes`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddErrors(key string, val []error) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddErrors`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddIPAddr(key string, val netip.Addr) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddIPAddr`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddIPPrefix(key string, val netip.Prefix) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddIPPrefix`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddURL(key string, val *url.URL) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddURL`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddHexBytes(key string, val []byte) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddHexBytes`, Key: key, Value: val})
+	return true
+}
+
+func (x *mockComplexEvent) AddTimestamp(key string, val time.Time) bool {
+	x.FieldValues = append(x.FieldValues, mockComplexEventField{Type: `AddTimestamp`, Key: key, Value: val})
+	return true
+}
+
 func (x *mockComplexEvent) mustEmbedUnimplementedEvent() {}
 
 func (x *mockComplexWriter) Write(event *mockComplexEvent) error {
@@ -237,3 +305,16 @@ func newSimpleLoggerPrintTypes(w io.Writer, multiLine bool) *Logger[*mockSimpleE
 		mockL.WithWriter(&mockSimpleWriter{Writer: w, MultiLine: multiLine, Type: true}),
 	)
 }
+
+type mockFailingJSONMarshaler struct{}
+
+func (mockFailingJSONMarshaler) MarshalJSON() ([]byte, error) { return nil, errors.New(`some error`) }
+
+func (mockFailingJSONMarshaler) String() string { return `not used` }
+
+// mockStringerError implements both error and fmt.Stringer
+type mockStringerError struct{}
+
+func (mockStringerError) Error() string { return `error message` }
+
+func (mockStringerError) String() string { return `not used` }
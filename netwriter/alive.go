@@ -0,0 +1,21 @@
+package netwriter
+
+import (
+	"errors"
+	"net"
+	"time"
+)
+
+// aliveDeadline performs a read, with a short deadline, to detect if the
+// peer has closed the connection, see alive
+func aliveDeadline(conn net.Conn) bool {
+	// note: an expired deadline would fail without attempting the read
+	if err := conn.SetReadDeadline(time.Now().Add(time.Millisecond)); err != nil {
+		return false
+	}
+	var b [1]byte
+	_, err := conn.Read(b[:])
+	_ = conn.SetReadDeadline(time.Time{})
+	var ne net.Error
+	return err == nil || (errors.As(err, &ne) && ne.Timeout())
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package netwriter
+
+import (
+	"net"
+)
+
+func alive(conn net.Conn) bool {
+	return aliveDeadline(conn)
+}
@@ -0,0 +1,41 @@
+//go:build unix
+
+package netwriter
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// alive performs a non-blocking peek, to detect if the peer has closed the
+// connection, e.g. while idle, which would otherwise result in the next
+// write being lost
+func alive(conn net.Conn) bool {
+	sc, ok := conn.(syscall.Conn)
+	if !ok {
+		return aliveDeadline(conn)
+	}
+	rc, err := sc.SyscallConn()
+	if err != nil {
+		return false
+	}
+	var (
+		b    [1]byte
+		n    int
+		rerr error
+	)
+	if err := rc.Read(func(fd uintptr) bool {
+		n, _, rerr = syscall.Recvfrom(int(fd), b[:], syscall.MSG_PEEK|syscall.MSG_DONTWAIT)
+		return true
+	}); err != nil {
+		return false
+	}
+	switch {
+	case rerr != nil:
+		return errors.Is(rerr, syscall.EAGAIN) || errors.Is(rerr, syscall.EWOULDBLOCK) || errors.Is(rerr, syscall.EINTR)
+	default:
+		// zero bytes indicates EOF
+		return n != 0
+	}
+}
@@ -0,0 +1,20 @@
+// Package netwriter implements a reconnecting network [io.Writer], for
+// shipping logs to a local agent (e.g. via TCP, or a unix socket), for use
+// with any logiface implementation that writes to an [io.Writer], e.g.
+// package jsonl.
+//
+// Each call to Write is treated as a single record, which is queued, and
+// sent, in order, by a background goroutine. Writes never block on the
+// network. If the connection fails, it is re-established, with exponential
+// backoff, while records are buffered in memory, up to a limit, then
+// (optionally) spilled to a bounded, on-disk, segment file. Once spilling has
+// started, all subsequent records are spilled, until the segment has been
+// replayed, preserving order. Records are discarded, with [ErrBufferFull],
+// only once all buffers are full.
+//
+// Delivery is at-least-once, within the limits of the transport: a record
+// may be resent, in full, after a failed (possibly partial) write, and, as
+// with any network logger, a successful write does not guarantee delivery.
+// Spilled records that remain on [Writer.Close] are retained, and will be
+// replayed by the next Writer, using the same segment file.
+package netwriter
@@ -0,0 +1,20 @@
+package netwriter_test
+
+import (
+	"github.com/joeycumines/logiface/jsonl"
+	"github.com/joeycumines/logiface/netwriter"
+	"os"
+	"path/filepath"
+)
+
+func ExampleNewWriter() {
+	w := netwriter.NewWriter(`tcp`, `127.0.0.1:5170`,
+		netwriter.WithMemoryLimit(4<<20),
+		netwriter.WithSpillFile(filepath.Join(os.TempDir(), `app.spill`), 256<<20),
+	)
+	defer w.Close()
+
+	logger := jsonl.L.New(jsonl.L.WithJSONL(jsonl.WithWriter(w)))
+
+	logger.Info().Log(`hello`)
+}
@@ -0,0 +1,110 @@
+package netwriter
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+type (
+	// Option models a configuration option for [NewWriter].
+	Option interface {
+		apply(c *writerConfig)
+	}
+
+	optionFunc func(c *writerConfig)
+
+	writerConfig struct {
+		dial         DialFunc
+		timeout      time.Duration
+		minBackoff   time.Duration
+		maxBackoff   time.Duration
+		memoryLimit  int
+		spillPath    string
+		spillLimit   int64
+		errorHandler func(err error)
+	}
+
+	// DialFunc establishes a connection, see [WithDialFunc].
+	DialFunc func(ctx context.Context) (net.Conn, error)
+)
+
+const (
+	// DefaultTimeout is the default timeout for dialing, and writing.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultMinBackoff is the default delay, before the first reconnect
+	// attempt, after a failure.
+	DefaultMinBackoff = 100 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum delay, between reconnect
+	// attempts.
+	DefaultMaxBackoff = 30 * time.Second
+
+	// DefaultMemoryLimit is the default maximum number of bytes, of records,
+	// buffered in memory.
+	DefaultMemoryLimit = 1 << 20
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+func (x optionFunc) apply(c *writerConfig) { x(c) }
+
+// WithDialFunc configures a custom function, to establish the connection,
+// overriding the network and address passed to [NewWriter].
+func WithDialFunc(dial DialFunc) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.dial = dial
+	})
+}
+
+// WithTimeout configures the timeout for dialing, and writing each record,
+// which defaults to [DefaultTimeout]. It also bounds the time that
+// [Writer.Close] will spend sending buffered records.
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.timeout = timeout
+	})
+}
+
+// WithBackoff configures the delay between reconnect attempts, which starts
+// at minimum, doubling after each failure, up to maximum. The defaults are
+// [DefaultMinBackoff], and [DefaultMaxBackoff].
+func WithBackoff(minimum, maximum time.Duration) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.minBackoff = minimum
+		c.maxBackoff = maximum
+	})
+}
+
+// WithMemoryLimit configures the maximum number of bytes, of records, that
+// will be buffered in memory, which defaults to [DefaultMemoryLimit].
+func WithMemoryLimit(limit int) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.memoryLimit = limit
+	})
+}
+
+// WithSpillFile enables spilling records to a segment file, at path, once
+// the memory limit is reached, up to limit bytes (including a 4 byte length
+// prefix, per record). The file is created if it doesn't exist, and any
+// records it contains are replayed, before any new records.
+func WithSpillFile(path string, limit int64) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.spillPath = path
+		c.spillLimit = limit
+	})
+}
+
+// WithErrorHandler configures a function to be called with any errors that
+// occur in the background, e.g. failure to connect. Such errors are
+// otherwise discarded.
+func WithErrorHandler(handler func(err error)) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.errorHandler = handler
+	})
+}
@@ -0,0 +1,181 @@
+package netwriter
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// recordHeaderSize is the size of the length prefix, of each spilled record
+const recordHeaderSize = 4
+
+// spill is an append-only segment file, of length-prefixed records, which is
+// truncated once fully read, or compacted, to reclaim the space used by read
+// records, see compactThreshold
+type spill struct {
+	file  *os.File
+	limit int64
+	read  int64
+	write int64
+	buf   []byte
+}
+
+// openSpill opens (or creates) the segment file, discarding any trailing
+// partial record, e.g. from a crash
+func openSpill(path string, limit int64) (*spill, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	s := spill{file: f, limit: limit}
+	var header [recordHeaderSize]byte
+	for {
+		if _, err := f.ReadAt(header[:], s.write); err != nil {
+			if err != io.EOF {
+				_ = f.Close()
+				return nil, err
+			}
+			break
+		}
+		next := s.write + recordHeaderSize + int64(binary.BigEndian.Uint32(header[:]))
+		if info, err := f.Stat(); err != nil {
+			_ = f.Close()
+			return nil, err
+		} else if next > info.Size() {
+			break
+		}
+		s.write = next
+	}
+	if err := f.Truncate(s.write); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (x *spill) empty() bool { return x.read == x.write }
+
+// append writes a record, returning false if there is insufficient space,
+// i.e. if the unread records, plus p, would exceed the limit
+func (x *spill) append(p []byte) (bool, error) {
+	n := int64(recordHeaderSize + len(p))
+	if x.write-x.read+n > x.limit {
+		return false, nil
+	}
+	if x.write+n > x.limit {
+		if err := x.compact(); err != nil {
+			return false, err
+		}
+	}
+	x.buf = binary.BigEndian.AppendUint32(x.buf[:0], uint32(len(p)))
+	x.buf = append(x.buf, p...)
+	if _, err := x.file.WriteAt(x.buf, x.write); err != nil {
+		return false, err
+	}
+	x.write += n
+	return true, nil
+}
+
+// peek reads the next record, returning the offset of the following record
+func (x *spill) peek() ([]byte, int64, error) {
+	var header [recordHeaderSize]byte
+	if _, err := x.file.ReadAt(header[:], x.read); err != nil {
+		return nil, 0, err
+	}
+	size := int64(binary.BigEndian.Uint32(header[:]))
+	next := x.read + recordHeaderSize + size
+	if next > x.write {
+		return nil, 0, errors.New(`netwriter: corrupt spill file`)
+	}
+	p := make([]byte, size)
+	if _, err := x.file.ReadAt(p, x.read+recordHeaderSize); err != nil {
+		return nil, 0, err
+	}
+	return p, next, nil
+}
+
+// advance marks all records, before next, as read, truncating the file, once
+// all records have been read, or compacting it, once the read offset passes
+// the threshold
+func (x *spill) advance(next int64) error {
+	x.read = next
+	if x.read == x.write {
+		return x.reset()
+	}
+	if x.read >= x.compactThreshold() {
+		return x.compact()
+	}
+	return nil
+}
+
+// compactThreshold is the read offset at which the file will be compacted,
+// which bounds the space used by read records
+func (x *spill) compactThreshold() int64 {
+	return max(x.limit/2, 1<<16)
+}
+
+// compact moves the unread records to the start of the file, truncating it
+func (x *spill) compact() error {
+	if x.read == 0 {
+		return nil
+	}
+	if x.read == x.write {
+		return x.reset()
+	}
+	// note: the destination always precedes the source, so copying forwards,
+	// in chunks, is safe
+	buf := make([]byte, min(x.write-x.read, 1<<16))
+	var offset int64
+	for src := x.read; src < x.write; {
+		n, err := x.file.ReadAt(buf[:min(int64(len(buf)), x.write-src)], src)
+		if err != nil && !(err == io.EOF && n != 0) {
+			return err
+		}
+		if _, err := x.file.WriteAt(buf[:n], offset); err != nil {
+			return err
+		}
+		src += int64(n)
+		offset += int64(n)
+	}
+	if err := x.file.Truncate(offset); err != nil {
+		return err
+	}
+	x.read, x.write = 0, offset
+	return nil
+}
+
+func (x *spill) reset() error {
+	x.read, x.write = 0, 0
+	return x.file.Truncate(0)
+}
+
+// close compacts the file, retaining only unread records, prefixed with
+// records (e.g. from memory), that fit within the limit, then closes it
+func (x *spill) close(records [][]byte) (dropped int, err error) {
+	remaining := make([]byte, x.write-x.read)
+	if _, err = x.file.ReadAt(remaining, x.read); err != nil && err != io.EOF {
+		_ = x.file.Close()
+		return
+	}
+	var buf []byte
+	for i, p := range records {
+		if int64(len(buf)+recordHeaderSize+len(p)+len(remaining)) > x.limit {
+			dropped = len(records) - i
+			break
+		}
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(p)))
+		buf = append(buf, p...)
+	}
+	buf = append(buf, remaining...)
+	if _, err = x.file.WriteAt(buf, 0); err == nil {
+		err = x.file.Truncate(int64(len(buf)))
+	}
+	if err == nil {
+		err = x.file.Sync()
+	}
+	if e := x.file.Close(); err == nil {
+		err = e
+	}
+	return
+}
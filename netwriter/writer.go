@@ -0,0 +1,353 @@
+package netwriter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Writer is a reconnecting network [io.Writer], see the package
+	// documentation. Writer is safe for concurrent use.
+	Writer struct {
+		dial        DialFunc
+		timeout     time.Duration
+		minBackoff  time.Duration
+		maxBackoff  time.Duration
+		memoryLimit int
+		onError     func(err error)
+
+		mu       sync.Mutex
+		queue    [][]byte
+		queued   int
+		spill    *spill
+		progress chan struct{}
+		closed   bool
+
+		dropped atomic.Uint64
+
+		wake    chan struct{}
+		done    chan struct{}
+		stopped chan struct{}
+	}
+)
+
+var (
+	// ErrBufferFull is returned by [Writer.Write], if the record was
+	// discarded, as all buffers are full.
+	ErrBufferFull = errors.New(`netwriter: buffer full`)
+
+	// ErrClosed is returned by [Writer.Write], after [Writer.Close].
+	ErrClosed = errors.New(`netwriter: writer closed`)
+
+	// compile time assertions
+
+	_ io.WriteCloser = (*Writer)(nil)
+)
+
+// NewWriter initializes a new [Writer], which will connect to address, on
+// the named network (see [net.Dial]), and starts the background goroutine,
+// which will run until [Writer.Close] is called.
+//
+// If a spill file is configured (see [WithSpillFile]), but it cannot be
+// opened, the error is passed to the error handler, and the writer operates
+// without it.
+func NewWriter(network, address string, options ...Option) *Writer {
+	c := writerConfig{
+		timeout:     DefaultTimeout,
+		minBackoff:  DefaultMinBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		memoryLimit: DefaultMemoryLimit,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	w := Writer{
+		dial:        c.dial,
+		timeout:     c.timeout,
+		minBackoff:  c.minBackoff,
+		maxBackoff:  max(c.maxBackoff, c.minBackoff),
+		memoryLimit: c.memoryLimit,
+		onError:     c.errorHandler,
+		progress:    make(chan struct{}),
+		wake:        make(chan struct{}, 1),
+		done:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+
+	if w.dial == nil {
+		d := net.Dialer{Timeout: w.timeout}
+		w.dial = func(ctx context.Context) (net.Conn, error) {
+			return d.DialContext(ctx, network, address)
+		}
+	}
+
+	if c.spillPath != `` {
+		if s, err := openSpill(c.spillPath, c.spillLimit); err != nil {
+			w.handleError(err)
+		} else {
+			w.spill = s
+		}
+	}
+
+	go w.run()
+
+	return &w
+}
+
+// Write queues a copy of p, as a single record, returning [ErrBufferFull] if
+// it was discarded.
+func (x *Writer) Write(p []byte) (int, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.closed {
+		return 0, ErrClosed
+	}
+
+	switch {
+	case x.spilling():
+		// preserve order, until the spill file has been replayed
+		if err := x.spillRecord(p); err != nil {
+			return 0, err
+		}
+
+	case x.queued+len(p) <= x.memoryLimit:
+		x.queue = append(x.queue, append([]byte(nil), p...))
+		x.queued += len(p)
+
+	default:
+		if err := x.spillRecord(p); err != nil {
+			return 0, err
+		}
+	}
+
+	select {
+	case x.wake <- struct{}{}:
+	default:
+	}
+
+	return len(p), nil
+}
+
+// Flush blocks until all buffered records have been sent, or ctx is done.
+func (x *Writer) Flush(ctx context.Context) error {
+	for {
+		x.mu.Lock()
+		pending := len(x.queue) != 0 || x.spilling()
+		progress := x.progress
+		x.mu.Unlock()
+		if !pending {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-progress:
+		}
+	}
+}
+
+// Dropped returns the number of records discarded, due to full buffers.
+func (x *Writer) Dropped() uint64 {
+	return x.dropped.Load()
+}
+
+// Close attempts to send all buffered records, for up to the configured
+// timeout (see [WithTimeout]), then stops the background goroutine, and
+// closes the connection. Any remaining records are retained in the spill
+// file, if configured, space permitting, otherwise they are discarded.
+func (x *Writer) Close() error {
+	x.mu.Lock()
+	if x.closed {
+		x.mu.Unlock()
+		return nil
+	}
+	x.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	_ = x.Flush(ctx)
+	cancel()
+
+	x.mu.Lock()
+	x.closed = true
+	x.mu.Unlock()
+
+	close(x.done)
+	<-x.stopped
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	queue := x.queue
+	x.queue, x.queued = nil, 0
+	if x.spill != nil {
+		dropped, err := x.spill.close(queue)
+		x.dropped.Add(uint64(dropped))
+		x.spill = nil
+		return err
+	}
+	x.dropped.Add(uint64(len(queue)))
+	return nil
+}
+
+func (x *Writer) spilling() bool {
+	return x.spill != nil && !x.spill.empty()
+}
+
+func (x *Writer) spillRecord(p []byte) error {
+	if x.spill != nil {
+		ok, err := x.spill.append(p)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	x.dropped.Add(1)
+	return ErrBufferFull
+}
+
+// next returns the next record to send, and a function to mark it as sent
+func (x *Writer) next() (record []byte, sent func() error, err error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if len(x.queue) != 0 {
+		record = x.queue[0]
+		return record, func() error {
+			x.queue[0] = nil
+			x.queue = x.queue[1:]
+			x.queued -= len(record)
+			x.sent()
+			return nil
+		}, nil
+	}
+
+	if x.spilling() {
+		record, offset, err := x.spill.peek()
+		if err != nil {
+			// the file is unusable, so discard its content
+			defer x.sent()
+			return nil, nil, errors.Join(err, x.spill.reset())
+		}
+		return record, func() error {
+			defer x.sent()
+			return x.spill.advance(offset)
+		}, nil
+	}
+
+	return nil, nil, nil
+}
+
+// sent must be called with mu held, after each record is sent
+func (x *Writer) sent() {
+	close(x.progress)
+	x.progress = make(chan struct{})
+}
+
+// markSent calls sent, which must be a function returned by next
+func (x *Writer) markSent(sent func() error) {
+	x.mu.Lock()
+	err := sent()
+	x.mu.Unlock()
+	if err != nil {
+		x.handleError(err)
+	}
+}
+
+func (x *Writer) run() {
+	defer close(x.stopped)
+
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	backoff := x.minBackoff
+	// idle indicates the connection should be checked, before the next write
+	idle := true
+
+	for {
+		record, sent, err := x.next()
+		if err != nil {
+			x.handleError(err)
+			continue
+		}
+		if sent == nil {
+			idle = true
+			select {
+			case <-x.done:
+				return
+			case <-x.wake:
+				continue
+			}
+		}
+
+		if conn != nil && idle && !alive(conn) {
+			_ = conn.Close()
+			conn = nil
+		}
+		idle = false
+
+		if conn == nil {
+			var err error
+			conn, err = x.connect()
+			if err != nil {
+				x.handleError(err)
+				select {
+				case <-x.done:
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(backoff*2, x.maxBackoff)
+				continue
+			}
+			backoff = x.minBackoff
+		}
+
+		if err := x.send(conn, record); err != nil {
+			x.handleError(err)
+			_ = conn.Close()
+			conn = nil
+			continue
+		}
+
+		x.markSent(sent)
+	}
+}
+
+func (x *Writer) connect() (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-x.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return x.dial(ctx)
+}
+
+func (x *Writer) send(conn net.Conn, record []byte) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(x.timeout)); err != nil && !errors.Is(err, os.ErrNoDeadline) {
+		return err
+	}
+	_, err := conn.Write(record)
+	return err
+}
+
+func (x *Writer) handleError(err error) {
+	if x.onError != nil {
+		x.onError(err)
+	}
+}
@@ -0,0 +1,382 @@
+package netwriter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type server struct {
+	ln    net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+	lines chan string
+	wg    sync.WaitGroup
+}
+
+func startServer(t *testing.T, address string) *server {
+	t.Helper()
+	ln, err := net.Listen(`tcp`, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := server{ln: ln, lines: make(chan string, 1<<12)}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns = append(s.conns, conn)
+			s.mu.Unlock()
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					s.lines <- scanner.Text()
+				}
+			}()
+		}
+	}()
+	t.Cleanup(s.stop)
+	return &s
+}
+
+func (x *server) addr() string { return x.ln.Addr().String() }
+
+func (x *server) stop() {
+	_ = x.ln.Close()
+	x.mu.Lock()
+	for _, conn := range x.conns {
+		_ = conn.Close()
+	}
+	x.conns = nil
+	x.mu.Unlock()
+	x.wg.Wait()
+}
+
+func (x *server) expect(t *testing.T, lines ...string) {
+	t.Helper()
+	for _, expected := range lines {
+		select {
+		case line := <-x.lines:
+			if line != expected {
+				t.Fatalf("unexpected line: %q, expected %q", line, expected)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", expected)
+		}
+	}
+	select {
+	case line := <-x.lines:
+		t.Fatalf("unexpected line: %q", line)
+	default:
+	}
+}
+
+func records(prefix string, n int) (lines []string) {
+	for i := range n {
+		lines = append(lines, prefix+strconv.Itoa(i))
+	}
+	return
+}
+
+func writeLines(t *testing.T, w *Writer, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if _, err := w.Write([]byte(line + "\n")); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func flush(t *testing.T, w *Writer) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := w.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// closedAddress returns an address, which is not listening
+func closedAddress(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen(`tcp`, `127.0.0.1:0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+	return addr
+}
+
+func TestWriter_order(t *testing.T) {
+	s := startServer(t, `127.0.0.1:0`)
+	w := NewWriter(`tcp`, s.addr())
+	lines := records(`a`, 500)
+	writeLines(t, w, lines...)
+	flush(t, w)
+	s.expect(t, lines...)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("b\n")); err != ErrClosed {
+		t.Error(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriter_reconnect(t *testing.T) {
+	s := startServer(t, `127.0.0.1:0`)
+	addr := s.addr()
+
+	w := NewWriter(`tcp`, addr,
+		WithBackoff(time.Millisecond, 10*time.Millisecond),
+		WithMemoryLimit(64),
+		WithSpillFile(filepath.Join(t.TempDir(), `spill`), 1<<20),
+	)
+	defer w.Close()
+
+	writeLines(t, w, `first`)
+	flush(t, w)
+	s.expect(t, `first`)
+
+	// the agent restarts
+	s.stop()
+
+	lines := records(`b`, 200)
+	writeLines(t, w, lines...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	if err := w.Flush(ctx); err != context.DeadlineExceeded {
+		t.Error(err)
+	}
+	cancel()
+
+	// more records, while spilling, must be ordered after the spilled ones
+	more := records(`c`, 10)
+	writeLines(t, w, more...)
+
+	s = startServer(t, addr)
+	flush(t, w)
+	s.expect(t, append(lines, more...)...)
+
+	// back to memory, once the spill file has been replayed
+	if !w.spill.empty() || w.spill.write != 0 {
+		t.Error(`expected spill file to be reset`)
+	}
+	writeLines(t, w, `last`)
+	flush(t, w)
+	s.expect(t, `last`)
+	if w.Dropped() != 0 {
+		t.Error(w.Dropped())
+	}
+}
+
+func TestWriter_bufferFull(t *testing.T) {
+	var mu sync.Mutex
+	var errs []error
+	w := NewWriter(`tcp`, closedAddress(t),
+		WithTimeout(10*time.Millisecond),
+		WithBackoff(time.Millisecond, time.Millisecond),
+		WithMemoryLimit(10),
+		WithErrorHandler(func(err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		}),
+	)
+	if _, err := w.Write([]byte(`0123456789`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte(`a`)); err != ErrBufferFull {
+		t.Fatal(err)
+	}
+	if w.Dropped() != 1 {
+		t.Error(w.Dropped())
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// the buffered record was discarded, on close
+	if w.Dropped() != 2 {
+		t.Error(w.Dropped())
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) == 0 {
+		t.Error(`expected dial errors`)
+	}
+}
+
+func TestWriter_spillLimit(t *testing.T) {
+	w := NewWriter(`tcp`, closedAddress(t),
+		WithTimeout(10*time.Millisecond),
+		WithMemoryLimit(0),
+		WithSpillFile(filepath.Join(t.TempDir(), `spill`), 2*(recordHeaderSize+5)),
+	)
+	defer w.Close()
+	writeLines(t, w, `abcd`, `efgh`)
+	if _, err := w.Write([]byte("ijkl\n")); err != ErrBufferFull {
+		t.Fatal(err)
+	}
+}
+
+func TestWriter_persisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `spill`)
+	addr := closedAddress(t)
+
+	w := NewWriter(`tcp`, addr,
+		WithTimeout(10*time.Millisecond),
+		WithMemoryLimit(20),
+		WithSpillFile(path, 1<<20),
+	)
+	lines := records(`line `, 10)
+	writeLines(t, w, lines...)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if w.Dropped() != 0 {
+		t.Fatal(w.Dropped())
+	}
+
+	s := startServer(t, addr)
+	w = NewWriter(`tcp`, addr, WithSpillFile(path, 1<<20))
+	defer w.Close()
+	writeLines(t, w, `new`)
+	flush(t, w)
+	s.expect(t, append(lines, `new`)...)
+}
+
+func TestOpenSpill_partial(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `spill`)
+	// one complete record, followed by a partial record
+	if err := os.WriteFile(path, []byte("\x00\x00\x00\x02ab\x00\x00\x00\x05cd"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	s, err := openSpill(path, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.file.Close()
+	if s.read != 0 || s.write != 6 {
+		t.Fatalf("unexpected offsets: %d %d", s.read, s.write)
+	}
+	p, next, err := s.peek()
+	if err != nil || string(p) != `ab` || next != 6 {
+		t.Fatal(p, next, err)
+	}
+	if info, err := s.file.Stat(); err != nil || info.Size() != 6 {
+		t.Fatal(info, err)
+	}
+}
+
+func TestSpill_reclaim(t *testing.T) {
+	s, err := openSpill(filepath.Join(t.TempDir(), `spill`), 3*(recordHeaderSize+5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.file.Close()
+	for _, p := range [...]string{`aaaaa`, `bbbbb`, `ccccc`} {
+		if ok, err := s.append([]byte(p)); err != nil || !ok {
+			t.Fatal(p, ok, err)
+		}
+	}
+	if ok, err := s.append([]byte(`ddddd`)); err != nil || ok {
+		t.Fatal(ok, err)
+	}
+	// reading a single record frees enough space, for another
+	if p, next, err := s.peek(); err != nil || string(p) != `aaaaa` {
+		t.Fatal(p, err)
+	} else if err := s.advance(next); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.append([]byte(`ddddd`)); err != nil || !ok {
+		t.Fatal(ok, err)
+	}
+	if info, err := s.file.Stat(); err != nil || info.Size() != s.limit {
+		t.Fatal(info, err)
+	}
+	var records []string
+	for !s.empty() {
+		p, next, err := s.peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, string(p))
+		if err := s.advance(next); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(records, []string{`bbbbb`, `ccccc`, `ddddd`}) {
+		t.Errorf("unexpected records: %q", records)
+	}
+	if info, err := s.file.Stat(); err != nil || info.Size() != 0 {
+		t.Fatal(info, err)
+	}
+}
+
+func TestSpill_compactThreshold(t *testing.T) {
+	s, err := openSpill(filepath.Join(t.TempDir(), `spill`), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.file.Close()
+	record := bytes.Repeat([]byte{'x'}, 1<<10)
+	for range s.compactThreshold()/int64(len(record)) + 8 {
+		if ok, err := s.append(record); err != nil || !ok {
+			t.Fatal(ok, err)
+		}
+	}
+	total := s.write
+	// read until the file is compacted
+	for s.write == total {
+		_, next, err := s.peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.advance(next); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the read records were reclaimed
+	if s.read != 0 || s.write >= total || s.write == 0 {
+		t.Fatal(s.read, s.write, total)
+	}
+	if info, err := s.file.Stat(); err != nil || info.Size() != s.write {
+		t.Fatal(info, err)
+	}
+}
+
+func TestNewWriter_spillError(t *testing.T) {
+	var errs []error
+	w := NewWriter(`tcp`, closedAddress(t),
+		WithTimeout(10*time.Millisecond),
+		WithSpillFile(filepath.Join(t.TempDir(), `missing`, `spill`), 100),
+		WithErrorHandler(func(err error) {
+			if errs == nil {
+				errs = append(errs, err)
+			}
+		}),
+	)
+	defer w.Close()
+	if len(errs) != 1 || !errors.Is(errs[0], os.ErrNotExist) || w.spill != nil {
+		t.Error(errs)
+	}
+}
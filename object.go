@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -24,6 +26,7 @@ func ObjectWithKey[E Event, P Parent[E]](p P, key string) (obj *ObjectBuilder[E,
 	if p.Enabled() {
 		obj = (*ObjectBuilder[E, P])(refPoolGet())
 		obj.a = p
+		obj.schema = p.Root().fieldSchema()
 		if obj.jsonMustUseDefault() {
 			obj.b = (defaultJSONSupport[E]{}).NewObject()
 		} else {
@@ -246,6 +249,138 @@ func (x *Context[E]) objRawJSON(obj any, key string, val json.RawMessage) (any,
 	return obj, false
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objInt8(obj any, key string, val int8) (any, bool) {
+	if x.logger.shared.json.iface.CanSetInt8() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setInt8(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objInt16(obj any, key string, val int16) (any, bool) {
+	if x.logger.shared.json.iface.CanSetInt16() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setInt16(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objInt32(obj any, key string, val int32) (any, bool) {
+	if x.logger.shared.json.iface.CanSetInt32() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setInt32(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objUint(obj any, key string, val uint) (any, bool) {
+	if x.logger.shared.json.iface.CanSetUint() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setUint(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objUint8(obj any, key string, val uint8) (any, bool) {
+	if x.logger.shared.json.iface.CanSetUint8() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setUint8(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objUint16(obj any, key string, val uint16) (any, bool) {
+	if x.logger.shared.json.iface.CanSetUint16() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setUint16(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objUint32(obj any, key string, val uint32) (any, bool) {
+	if x.logger.shared.json.iface.CanSetUint32() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setUint32(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objIPAddr(obj any, key string, val netip.Addr) (any, bool) {
+	if x.logger.shared.json.iface.CanSetIPAddr() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setIPAddr(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objIPPrefix(obj any, key string, val netip.Prefix) (any, bool) {
+	if x.logger.shared.json.iface.CanSetIPPrefix() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setIPPrefix(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objURL(obj any, key string, val *url.URL) (any, bool) {
+	if x.logger.shared.json.iface.CanSetURL() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setURL(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Context[E]) objHexBytes(obj any, key string, val []byte) (any, bool) {
+	if x.logger.shared.json.iface.CanSetHexBytes() {
+		o := obj.(*contextFieldData[E])
+		o.values = append(o.values, func(shared *loggerShared[E], obj any) any {
+			return shared.json.setHexBytes(obj, key, val)
+		})
+		return obj, true
+	}
+	return obj, false
+}
+
 //lint:ignore U1000 it is or will be used
 func (x *Builder[E]) jsonNewObject(key string) any {
 	return x.shared.json.addStartOrNewObject(x.Event, key)
@@ -383,6 +518,94 @@ func (x *Builder[E]) objRawJSON(obj any, key string, val json.RawMessage) (any,
 	return obj, false
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objInt8(obj any, key string, val int8) (any, bool) {
+	if x.shared.json.iface.CanSetInt8() {
+		return x.shared.json.setInt8(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objInt16(obj any, key string, val int16) (any, bool) {
+	if x.shared.json.iface.CanSetInt16() {
+		return x.shared.json.setInt16(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objInt32(obj any, key string, val int32) (any, bool) {
+	if x.shared.json.iface.CanSetInt32() {
+		return x.shared.json.setInt32(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objUint(obj any, key string, val uint) (any, bool) {
+	if x.shared.json.iface.CanSetUint() {
+		return x.shared.json.setUint(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objUint8(obj any, key string, val uint8) (any, bool) {
+	if x.shared.json.iface.CanSetUint8() {
+		return x.shared.json.setUint8(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objUint16(obj any, key string, val uint16) (any, bool) {
+	if x.shared.json.iface.CanSetUint16() {
+		return x.shared.json.setUint16(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objUint32(obj any, key string, val uint32) (any, bool) {
+	if x.shared.json.iface.CanSetUint32() {
+		return x.shared.json.setUint32(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objIPAddr(obj any, key string, val netip.Addr) (any, bool) {
+	if x.shared.json.iface.CanSetIPAddr() {
+		return x.shared.json.setIPAddr(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objIPPrefix(obj any, key string, val netip.Prefix) (any, bool) {
+	if x.shared.json.iface.CanSetIPPrefix() {
+		return x.shared.json.setIPPrefix(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objURL(obj any, key string, val *url.URL) (any, bool) {
+	if x.shared.json.iface.CanSetURL() {
+		return x.shared.json.setURL(obj, key, val), true
+	}
+	return obj, false
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *Builder[E]) objHexBytes(obj any, key string, val []byte) (any, bool) {
+	if x.shared.json.iface.CanSetHexBytes() {
+		return x.shared.json.setHexBytes(obj, key, val), true
+	}
+	return obj, false
+}
+
 // p returns the parent of this object builder
 func (x *ObjectBuilder[E, P]) p() (p P) {
 	if x != nil && x.a != nil {
@@ -466,6 +689,76 @@ func (x *ObjectBuilder[E, P]) Int(key string, val int) *ObjectBuilder[E, P] {
 	return x
 }
 
+func (x *ObjectBuilder[E, P]) Int8(key string, val int8) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objInt8(x.b, key, val); !ok {
+			_ = x.methods().Int8(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Int16(key string, val int16) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objInt16(x.b, key, val); !ok {
+			_ = x.methods().Int16(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Int32(key string, val int32) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objInt32(x.b, key, val); !ok {
+			_ = x.methods().Int32(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Uint(key string, val uint) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objUint(x.b, key, val); !ok {
+			_ = x.methods().Uint(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Uint8(key string, val uint8) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objUint8(x.b, key, val); !ok {
+			_ = x.methods().Uint8(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Uint16(key string, val uint16) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objUint16(x.b, key, val); !ok {
+			_ = x.methods().Uint16(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Uint32(key string, val uint32) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		var ok bool
+		if x.b, ok = x.objUint32(x.b, key, val); !ok {
+			_ = x.methods().Uint32(x.fields(), key, val)
+		}
+	}
+	return x
+}
+
 func (x *ObjectBuilder[E, P]) Float32(key string, val float32) *ObjectBuilder[E, P] {
 	_ = x.methods().Float32(x.fields(), key, val)
 	return x
@@ -511,6 +804,26 @@ func (x *ObjectBuilder[E, P]) RawJSON(key string, val json.RawMessage) *ObjectBu
 	return x
 }
 
+func (x *ObjectBuilder[E, P]) IPAddr(key string, val netip.Addr) *ObjectBuilder[E, P] {
+	_ = x.methods().IPAddr(x.fields(), key, val)
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) IPPrefix(key string, val netip.Prefix) *ObjectBuilder[E, P] {
+	_ = x.methods().IPPrefix(x.fields(), key, val)
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) URL(key string, val *url.URL) *ObjectBuilder[E, P] {
+	_ = x.methods().URL(x.fields(), key, val)
+	return x
+}
+
+func (x *ObjectBuilder[E, P]) Hex(key string, val []byte) *ObjectBuilder[E, P] {
+	_ = x.methods().Hex(x.fields(), key, val)
+	return x
+}
+
 func (x *ObjectBuilder[E, P]) as(key string) any {
 	return x.As(key)
 }
@@ -520,11 +833,17 @@ func (x *ObjectBuilder[E, P]) isObjectBuilder() *refPoolItem {
 }
 
 func (x *ObjectBuilder[E, P]) jsonMustUseDefault() bool {
+	return x.jsonMustUseDefaultFor(x.p().jsonSupport())
+}
+
+// jsonMustUseDefaultFor implements jsonMustUseDefault, given the parent's
+// JSON support, which avoids resolving it more than once, per level
+func (x *ObjectBuilder[E, P]) jsonMustUseDefaultFor(parent iJSONSupport[E]) bool {
 	switch getParentJSONType(x.a) {
 	case parentJSONTypeArray:
-		return !x.p().jsonSupport().CanAppendObject()
+		return !parent.CanAppendObject()
 	case parentJSONTypeObject:
-		return !x.p().jsonSupport().CanSetObject()
+		return !parent.CanSetObject()
 	default:
 		return false
 	}
@@ -537,10 +856,11 @@ func (x *ObjectBuilder[E, P]) Root() *Logger[E] {
 
 //lint:ignore U1000 it is or will be used
 func (x *ObjectBuilder[E, P]) jsonSupport() iJSONSupport[E] {
-	if x.jsonMustUseDefault() {
+	parent := x.p().jsonSupport()
+	if x.jsonMustUseDefaultFor(parent) {
 		return defaultJSONSupport[E]{}
 	}
-	return x.p().jsonSupport()
+	return parent
 }
 
 //lint:ignore U1000 it is or will be used
@@ -693,6 +1013,94 @@ func (x *ObjectBuilder[E, P]) objRawJSON(obj any, key string, val json.RawMessag
 	return x.p().objRawJSON(obj, key, val)
 }
 
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objInt8(obj any, key string, val int8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objInt16(obj any, key string, val int16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objInt32(obj any, key string, val int32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objInt32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objUint(obj any, key string, val uint) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objUint8(obj any, key string, val uint8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint8(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objUint16(obj any, key string, val uint16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint16(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objUint32(obj any, key string, val uint32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objUint32(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objIPAddr(obj any, key string, val netip.Addr) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objIPAddr(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objIPPrefix(obj any, key string, val netip.Prefix) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objIPPrefix(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objURL(obj any, key string, val *url.URL) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objURL(obj, key, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) objHexBytes(obj any, key string, val []byte) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return obj, false
+	}
+	return x.p().objHexBytes(obj, key, val)
+}
+
 //lint:ignore U1000 it is or will be used
 func (x *ObjectBuilder[E, P]) jsonNewArray(key string) any {
 	return x.objNewArray(x.b, key)
@@ -844,3 +1252,91 @@ func (x *ObjectBuilder[E, P]) arrRawJSON(arr any, val json.RawMessage) (any, boo
 	}
 	return x.p().arrRawJSON(arr, val)
 }
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrInt8(arr any, val int8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrInt16(arr any, val int16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrInt32(arr any, val int32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrInt32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrUint(arr any, val uint) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrUint8(arr any, val uint8) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint8(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrUint16(arr any, val uint16) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint16(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrUint32(arr any, val uint32) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrUint32(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrIPAddr(arr any, val netip.Addr) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrIPAddr(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrIPPrefix(arr any, val netip.Prefix) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrIPPrefix(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrURL(arr any, val *url.URL) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrURL(arr, val)
+}
+
+//lint:ignore U1000 it is or will be used
+func (x *ObjectBuilder[E, P]) arrHexBytes(arr any, val []byte) (any, bool) {
+	if x.jsonMustUseDefault() {
+		return arr, false
+	}
+	return x.p().arrHexBytes(arr, val)
+}
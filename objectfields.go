@@ -3,6 +3,8 @@ package logiface
 import (
 	"encoding/base64"
 	"encoding/json"
+	"net/netip"
+	"net/url"
 	"time"
 )
 
@@ -12,7 +14,11 @@ type (
 )
 
 func (x *ObjectBuilder[E, P]) methods() modifierMethods[*objectFields[E, P]] {
-	return modifierMethods[*objectFields[E, P]]{}
+	if x == nil {
+		// nil builders are returned by disabled loggers, and must be no-ops
+		return modifierMethods[*objectFields[E, P]]{}
+	}
+	return modifierMethods[*objectFields[E, P]]{schema: x.schema}
 }
 
 func (x *ObjectBuilder[E, P]) fields() *objectFields[E, P] {
@@ -99,6 +105,44 @@ func (x *objectFields[E, P]) AddRawJSON(key string, val json.RawMessage) (ok boo
 	return
 }
 
+func (x *objectFields[E, P]) AddIPAddr(key string, val netip.Addr) (ok bool) {
+	x.b, ok = x.builder().objIPAddr(x.b, key, val)
+	return
+}
+
+func (x *objectFields[E, P]) AddIPPrefix(key string, val netip.Prefix) (ok bool) {
+	x.b, ok = x.builder().objIPPrefix(x.b, key, val)
+	return
+}
+
+func (x *objectFields[E, P]) AddURL(key string, val *url.URL) (ok bool) {
+	x.b, ok = x.builder().objURL(x.b, key, val)
+	return
+}
+
+func (x *objectFields[E, P]) AddHexBytes(key string, val []byte) (ok bool) {
+	x.b, ok = x.builder().objHexBytes(x.b, key, val)
+	return
+}
+
 func (x *objectFields[E, P]) AddGroup(string) bool { return false }
 
+func (x *objectFields[E, P]) AddStrings(string, []string) bool { return false }
+
+func (x *objectFields[E, P]) AddInts(string, []int) bool { return false }
+
+func (x *objectFields[E, P]) AddInt64s(string, []int64) bool { return false }
+
+func (x *objectFields[E, P]) AddFloat64s(string, []float64) bool { return false }
+
+func (x *objectFields[E, P]) AddBools(string, []bool) bool { return false }
+
+func (x *objectFields[E, P]) AddDurations(string, []time.Duration) bool { return false }
+
+func (x *objectFields[E, P]) AddTimes(string, []time.Time) bool { return false }
+
+func (x *objectFields[E, P]) AddErrors(string, []error) bool { return false }
+
+func (x *objectFields[E, P]) AddTimestamp(string, time.Time) bool { return false }
+
 func (x *objectFields[E, P]) mustEmbedUnimplementedEvent() {}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"testing"
 	"time"
+
+	"github.com/joeycumines/logiface/internal/fieldtest"
 )
 
 var (
@@ -43,7 +45,7 @@ func ExampleObjectBuilder_defaultFieldFormats() {
 	//str={"e":"str val"}
 	//bools={"F":false,"f":true}
 	//map={"g":{"1":1,"2":0.5,"3":0.3333333333333333}}
-	//err={"err":{}}
+	//err={"err":"some error"}
 	//int={"h":123}
 	//uint64={"i":"123"}
 	//int64={"j":"123"}
@@ -72,3 +74,21 @@ func TestObjectFields_Level_nil(t *testing.T) {
 		t.Error(v)
 	}
 }
+
+func TestObjectBuilder_disabled(t *testing.T) {
+	logger := mockL.New(mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)))
+	for _, b := range [...]*Builder[*mockSimpleEvent]{
+		nil,
+		logger.Debug(),
+		(*Logger[*mockSimpleEvent])(nil).Info(),
+	} {
+		obj := Object[*mockSimpleEvent](b)
+		if obj != nil {
+			t.Fatal(obj)
+		}
+		fieldtest.FluentObjectTemplate(obj)
+		if v := obj.Array().Str(`v`).As(`k`).Object().Int(`i`, 1).As(`o`).End(); v != nil {
+			t.Error(v)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package otlp
+
+import (
+	"context"
+	"github.com/joeycumines/logiface"
+)
+
+type (
+	// SpanContext is the subset of a trace span context, that is recorded on
+	// each log record.
+	SpanContext struct {
+		TraceID    [16]byte
+		SpanID     [8]byte
+		TraceFlags byte
+	}
+
+	// SpanContextFunc extracts a span context, from a [context.Context],
+	// returning false if there is none. See also [WithSpanContextFunc].
+	SpanContextFunc func(ctx context.Context) (SpanContext, bool)
+
+	spanContextKey struct{}
+)
+
+// IsValid returns true if both the trace and span IDs are non-zero.
+func (x SpanContext) IsValid() bool {
+	return x.TraceID != [16]byte{} && x.SpanID != [8]byte{}
+}
+
+// ContextWithSpanContext returns a copy of ctx, carrying the span context, as
+// extracted by [SpanContextFromContext].
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the valid span context set by
+// [ContextWithSpanContext], if any.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if ctx == nil {
+		return SpanContext{}, false
+	}
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	if !ok || !sc.IsValid() {
+		return SpanContext{}, false
+	}
+	return sc, true
+}
+
+// Ctx returns a modifier, which sets the trace and span IDs from ctx, see
+// [Event.SetContext]. It may be used with [logiface.Builder.Modifier], or
+// [logiface.Context.Modifier].
+func Ctx(ctx context.Context) logiface.Modifier[*Event] {
+	return logiface.ModifierFunc[*Event](func(event *Event) error {
+		event.SetContext(ctx)
+		return nil
+	})
+}
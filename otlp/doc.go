@@ -0,0 +1,24 @@
+// Package otlp implements a logiface logger, which builds OpenTelemetry log
+// records, per the logs data model, and exports them to a collector, using
+// OTLP/HTTP, with JSON encoding, without any dependencies outside this
+// module.
+//
+// The level is mapped to the severity number, per [SeverityNumber], the
+// severity text is the level's keyword (see [logiface.Level.String]), and the
+// message is the (string) body. Fields are added as typed attributes, with
+// nested objects and arrays represented as kvlist and array values, and
+// groups (see [logiface.Event.AddGroup]) as kvlist values. Errors added via
+// [logiface.Builder.Err] are represented using the exception.message, and
+// exception.type attributes, per the semantic conventions. Times and
+// durations are represented as strings, in the same format as logiface's
+// fallback behavior, and non-finite floating point values are represented as
+// the strings "NaN", "+Inf", and "-Inf".
+//
+// The trace and span IDs may be set from a [context.Context], using [Ctx], or
+// [Event.SetContext], see also [ContextWithSpanContext], and
+// [WithSpanContextFunc].
+//
+// Records are exported in batches, when the batch size is reached, on an
+// interval, or on [Logger.Flush], or [Logger.Close]. The number of buffered
+// records is bounded, see [WithMaxQueueSize].
+package otlp
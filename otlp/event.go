@@ -0,0 +1,167 @@
+package otlp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		logger *Logger
+		record LogRecord
+		// attrs is the attributes that fields are currently being added to,
+		// which will differ from record.Attributes, within a group
+		attrs *[]KeyValue
+		lvl   logiface.Level
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Record returns the log record, for the event, which may be modified, prior
+// to write. The record is retained, until exported.
+func (x *Event) Record() *LogRecord {
+	return &x.record
+}
+
+// SetContext sets the trace and span IDs, and the trace flags, using the
+// logger's [SpanContextFunc], see [WithSpanContextFunc]. The record is not
+// modified, if ctx doesn't carry a span context.
+func (x *Event) SetContext(ctx context.Context) {
+	if x == nil || x.logger == nil || x.logger.spanContextFunc == nil {
+		return
+	}
+	if sc, ok := x.logger.spanContextFunc(ctx); ok {
+		x.SetSpanContext(sc)
+	}
+}
+
+// SetSpanContext sets the trace and span IDs, and the trace flags.
+func (x *Event) SetSpanContext(sc SpanContext) {
+	x.record.TraceID = hex.EncodeToString(sc.TraceID[:])
+	x.record.SpanID = hex.EncodeToString(sc.SpanID[:])
+	x.record.Flags = uint32(sc.TraceFlags)
+}
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	x.add(key, Value(val))
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	v := StringValue(msg)
+	x.record.Body = &v
+	return true
+}
+
+// AddError adds the exception.message, and exception.type attributes, which
+// are always added to the top level of the record, i.e. outside any group.
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.record.Attributes = append(x.record.Attributes,
+			KeyValue{Key: `exception.message`, Value: StringValue(err.Error())},
+			KeyValue{Key: `exception.type`, Value: StringValue(fmt.Sprintf(`%T`, err))},
+		)
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.add(key, StringValue(val))
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.add(key, IntValue(int64(val)))
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.add(key, DoubleValue(float64(val)))
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.add(key, StringValue(pbtime.FormatTimestamp(val)))
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.add(key, StringValue(pbtime.FormatDuration(val)))
+	return true
+}
+
+// AddBase64Bytes adds a bytes value, which is always encoded using standard
+// base64, per OTLP/JSON, i.e. enc is ignored.
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.add(key, BytesValue(val))
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.add(key, BoolValue(val))
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.add(key, DoubleValue(val))
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.add(key, IntValue(val))
+	return true
+}
+
+// AddUint64 adds an int value, or, if val exceeds the range of int64, a
+// string value.
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.add(key, uint64Value(val))
+	return true
+}
+
+// AddRawJSON decodes val, adding it as the equivalent value, see [Value].
+// Invalid JSON is added as a string.
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	x.add(key, rawJSONValue(val))
+	return true
+}
+
+// AddGroup adds a kvlist attribute, to which all subsequent fields will be
+// added.
+func (x *Event) AddGroup(name string) bool {
+	kvs := &KeyValueList{Values: []KeyValue{}}
+	x.add(name, AnyValue{KvlistValue: kvs})
+	x.attrs = &kvs.Values
+	return true
+}
+
+func (x *Event) add(key string, val AnyValue) {
+	*x.attrs = append(*x.attrs, KeyValue{Key: key, Value: val})
+}
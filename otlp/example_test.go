@@ -0,0 +1,27 @@
+package otlp_test
+
+import (
+	"context"
+	"github.com/joeycumines/logiface/otlp"
+)
+
+func ExampleNewLogger() {
+	l := otlp.NewLogger(
+		otlp.WithEndpoint(`http://localhost:4318/v1/logs`),
+		otlp.WithResource(otlp.KeyValue{Key: `service.name`, Value: otlp.StringValue(`example`)}),
+	)
+	// exports any buffered records
+	defer l.Close()
+
+	logger := otlp.L.New(l.Option())
+
+	ctx := otlp.ContextWithSpanContext(context.Background(), otlp.SpanContext{
+		TraceID: [16]byte{1},
+		SpanID:  [8]byte{2},
+	})
+
+	logger.Info().
+		Modifier(otlp.Ctx(ctx)).
+		Str(`user`, `alice`).
+		Log(`request handled`)
+}
@@ -0,0 +1,241 @@
+package otlp
+
+import (
+	"github.com/joeycumines/logiface"
+	"net/http"
+	"time"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		endpoint        string
+		header          http.Header
+		client          *http.Client
+		resource        []KeyValue
+		scopeName       string
+		scopeVersion    string
+		batchSize       int
+		maxQueueSize    int
+		flushInterval   time.Duration
+		errorHandler    func(err error)
+		spanContextFunc SpanContextFunc
+	}
+)
+
+const (
+	// DefaultEndpoint is the default OTLP/HTTP logs endpoint, of a local
+	// collector.
+	DefaultEndpoint = `http://localhost:4318/v1/logs`
+
+	// DefaultScopeName is the default instrumentation scope name.
+	DefaultScopeName = `github.com/joeycumines/logiface/otlp`
+
+	// DefaultBatchSize is the default maximum number of records per export.
+	DefaultBatchSize = 512
+
+	// DefaultMaxQueueSize is the default maximum number of buffered records,
+	// see [WithMaxQueueSize].
+	DefaultMaxQueueSize = 2048
+
+	// DefaultFlushInterval is the default interval, at which buffered records
+	// are exported.
+	DefaultFlushInterval = 5 * time.Second
+
+	// DefaultTimeout is the default timeout, for each export request, used
+	// unless a client is provided via [WithHTTPClient].
+	DefaultTimeout = 10 * time.Second
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithOTLP configures a logiface logger to export OpenTelemetry log records,
+// using the implementations provided by this package.
+//
+// By default, records are exported to [DefaultEndpoint], in batches of up to
+// [DefaultBatchSize], at least every [DefaultFlushInterval]. Note that the
+// [Logger] should be closed, to export any remaining records, which requires
+// using [NewLogger], rather than this function.
+func WithOTLP(options ...Option) logiface.Option[*Event] {
+	return NewLogger(options...).Option()
+}
+
+// WithOTLP is an alias of the package function of the same name.
+func (LoggerFactory) WithOTLP(options ...Option) logiface.Option[*Event] {
+	return WithOTLP(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.Writer], and [logiface.JSONSupport]
+// interfaces. See also [Logger.Option].
+//
+// If the flush interval is positive, a background goroutine is started,
+// which will run until [Logger.Close] is called.
+func NewLogger(options ...Option) *Logger {
+	c := loggerConfig{
+		endpoint:        DefaultEndpoint,
+		scopeName:       DefaultScopeName,
+		batchSize:       DefaultBatchSize,
+		maxQueueSize:    DefaultMaxQueueSize,
+		flushInterval:   DefaultFlushInterval,
+		spanContextFunc: SpanContextFromContext,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{
+		endpoint:        c.endpoint,
+		header:          c.header,
+		client:          c.client,
+		batchSize:       max(c.batchSize, 1),
+		maxQueueSize:    max(c.maxQueueSize, c.batchSize, 1),
+		errorHandler:    c.errorHandler,
+		spanContextFunc: c.spanContextFunc,
+		done:            make(chan struct{}),
+	}
+
+	if l.client == nil {
+		l.client = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	l.request.ResourceLogs = []resourceLogs{{
+		Resource: resource{Attributes: c.resource},
+		ScopeLogs: []scopeLogs{{
+			Scope: instrumentationScope{
+				Name:    c.scopeName,
+				Version: c.scopeVersion,
+			},
+		}},
+	}}
+
+	if c.flushInterval > 0 {
+		l.stopped = make(chan struct{})
+		l.flush = make(chan struct{}, 1)
+		go l.run(c.flushInterval)
+	}
+
+	return &l
+}
+
+// WithEndpoint configures the URL that records will be POSTed to, which
+// defaults to [DefaultEndpoint].
+func WithEndpoint(endpoint string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.endpoint = endpoint
+	})
+}
+
+// WithHeader adds a header to each export request, e.g. for authentication.
+func WithHeader(key, value string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		if c.header == nil {
+			c.header = make(http.Header)
+		}
+		c.header.Add(key, value)
+	})
+}
+
+// WithHTTPClient configures the client used to export records, which
+// defaults to a client with a timeout of [DefaultTimeout].
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.client = client
+	})
+}
+
+// WithResource adds attributes to the resource, e.g. service.name.
+func WithResource(attributes ...KeyValue) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.resource = append(c.resource, attributes...)
+	})
+}
+
+// WithScope configures the instrumentation scope, which defaults to
+// [DefaultScopeName], without a version.
+func WithScope(name, version string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.scopeName = name
+		c.scopeVersion = version
+	})
+}
+
+// WithBatchSize configures the maximum number of records per export, which
+// defaults to [DefaultBatchSize]. The write that fills the batch will trigger
+// the background export, or, if it is disabled (see [WithFlushInterval]),
+// will export the batch synchronously.
+func WithBatchSize(size int) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.batchSize = size
+	})
+}
+
+// WithMaxQueueSize configures the maximum number of buffered records, which
+// defaults to [DefaultMaxQueueSize], and is at least the batch size. It
+// bounds memory use, if the background export (see [WithFlushInterval])
+// can't keep up, e.g. due to a slow or unavailable collector. Records written
+// while the queue is full are dropped, see [Logger.Dropped].
+func WithMaxQueueSize(size int) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.maxQueueSize = size
+	})
+}
+
+// WithFlushInterval configures the interval, at which buffered records are
+// exported, which defaults to [DefaultFlushInterval]. A non-positive interval
+// disables the background export.
+func WithFlushInterval(interval time.Duration) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.flushInterval = interval
+	})
+}
+
+// WithErrorHandler configures a function to be called with any errors from
+// exports triggered by [Logger.Write], including background exports, which
+// are otherwise discarded (as logiface ignores writer errors).
+func WithErrorHandler(handler func(err error)) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.errorHandler = handler
+	})
+}
+
+// WithSpanContextFunc configures the function used to extract the span
+// context, from a [context.Context], which defaults to
+// [SpanContextFromContext]. This may be used to integrate with a tracing
+// library, e.g. the OpenTelemetry API.
+func WithSpanContextFunc(fn SpanContextFunc) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.spanContextFunc = fn
+	})
+}
@@ -0,0 +1,457 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.Writer], and
+	// [logiface.JSONSupport], for [Event], buffering and exporting the
+	// records. See also [NewLogger], and [WithOTLP].
+	Logger struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedJSONSupport
+
+		endpoint        string
+		header          http.Header
+		client          *http.Client
+		batchSize       int
+		maxQueueSize    int
+		errorHandler    func(err error)
+		spanContextFunc SpanContextFunc
+
+		// exportMu serializes exports, and guards request
+		exportMu sync.Mutex
+		request  exportLogsServiceRequest
+
+		mu      sync.Mutex
+		records []LogRecord
+		closed  bool
+
+		// dropped counts the records discarded, as the queue was full
+		dropped atomic.Uint64
+
+		// flush signals the background export, that a batch is full
+		flush     chan struct{}
+		done      chan struct{}
+		stopped   chan struct{}
+		closeOnce sync.Once
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedJSONSupport = logiface.UnimplementedJSONSupport[*Event, *KeyValueList, *ArrayValue]
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]                            = (*Logger)(nil)
+	_ logiface.Writer[*Event]                                  = (*Logger)(nil)
+	_ logiface.JSONSupport[*Event, *KeyValueList, *ArrayValue] = (*Logger)(nil)
+)
+
+var (
+	// ErrClosed is returned by [Logger.Write], after [Logger.Close].
+	ErrClosed = errors.New(`otlp: logger closed`)
+
+	// ErrQueueFull is returned by [Logger.Write], if the record was dropped,
+	// as the queue is full, see [WithMaxQueueSize].
+	ErrQueueFull = errors.New(`otlp: queue full`)
+
+	timeNow = time.Now
+)
+
+// Option returns a logiface option, configuring a logger to use the receiver.
+func (x *Logger) Option() logiface.Option[*Event] {
+	return L.WithOptions(
+		L.WithWriter(x),
+		L.WithEventFactory(x),
+		logiface.WithJSONSupport[*Event, *KeyValueList, *ArrayValue](x),
+	)
+}
+
+func (x *Logger) NewEvent(level logiface.Level) *Event {
+	now := uint64(timeNow().UnixNano())
+	e := &Event{
+		logger: x,
+		lvl:    level,
+		record: LogRecord{
+			TimeUnixNano:         now,
+			ObservedTimeUnixNano: now,
+			SeverityNumber:       int(Severity(level)),
+			SeverityText:         level.String(),
+		},
+	}
+	e.attrs = &e.record.Attributes
+	return e
+}
+
+// Write buffers the event's record. If the batch is full, it will be
+// exported by the background goroutine, if any (see [WithFlushInterval]),
+// otherwise it is exported synchronously, with a timeout of
+// [DefaultTimeout], in which case any export error is both passed to the
+// error handler (see [WithErrorHandler]) and returned.
+//
+// If the queue is full (see [WithMaxQueueSize]), the record is dropped, and
+// [ErrQueueFull] is returned.
+func (x *Logger) Write(event *Event) error {
+	x.mu.Lock()
+	if x.closed {
+		x.mu.Unlock()
+		return ErrClosed
+	}
+	if len(x.records) >= x.maxQueueSize {
+		x.mu.Unlock()
+		x.dropped.Add(1)
+		return ErrQueueFull
+	}
+	x.records = append(x.records, event.record)
+	var batch []LogRecord
+	if len(x.records) >= x.batchSize {
+		if x.flush != nil {
+			// hand off to the background export, without blocking
+			select {
+			case x.flush <- struct{}{}:
+			default:
+			}
+		} else {
+			batch = x.records
+			x.records = nil
+		}
+	}
+	x.mu.Unlock()
+
+	if batch == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	err := x.export(ctx, batch)
+	if err != nil {
+		x.handleError(err)
+	}
+	return err
+}
+
+// Dropped returns the number of records that have been dropped, as the queue
+// was full, see [WithMaxQueueSize].
+func (x *Logger) Dropped() uint64 {
+	return x.dropped.Load()
+}
+
+// Flush exports all buffered records.
+func (x *Logger) Flush(ctx context.Context) error {
+	x.mu.Lock()
+	batch := x.records
+	x.records = nil
+	x.mu.Unlock()
+
+	var errs []error
+	for len(batch) != 0 {
+		n := min(len(batch), x.batchSize)
+		if err := x.export(ctx, batch[:n]); err != nil {
+			errs = append(errs, err)
+		}
+		batch = batch[n:]
+	}
+	return errors.Join(errs...)
+}
+
+// Close stops the background export, if any, and exports all buffered
+// records. Subsequent writes will fail, with [ErrClosed].
+func (x *Logger) Close() error {
+	x.closeOnce.Do(func() {
+		x.mu.Lock()
+		x.closed = true
+		x.mu.Unlock()
+		close(x.done)
+		if x.stopped != nil {
+			<-x.stopped
+		}
+	})
+	return x.Flush(context.Background())
+}
+
+func (x *Logger) run(interval time.Duration) {
+	defer close(x.stopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-x.done:
+			return
+		case <-ticker.C:
+			x.backgroundFlush()
+		case <-x.flush:
+			x.backgroundFlush()
+		}
+	}
+}
+
+func (x *Logger) backgroundFlush() {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	if err := x.Flush(ctx); err != nil {
+		x.handleError(err)
+	}
+}
+
+func (x *Logger) handleError(err error) {
+	if x.errorHandler != nil {
+		x.errorHandler(err)
+	}
+}
+
+// export sends an ExportLogsServiceRequest, containing records, using
+// OTLP/HTTP, with JSON encoding.
+func (x *Logger) export(ctx context.Context, records []LogRecord) error {
+	x.exportMu.Lock()
+	x.request.ResourceLogs[0].ScopeLogs[0].LogRecords = records
+	body, err := json.Marshal(&x.request)
+	x.request.ResourceLogs[0].ScopeLogs[0].LogRecords = nil
+	x.exportMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, x.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range x.header {
+		req.Header[k] = v
+	}
+	req.Header.Set(`Content-Type`, `application/json`)
+
+	res, err := x.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	b, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf(`otlp: export failed: %s: %s`, res.Status, bytes.TrimSpace(b))
+	}
+	return nil
+}
+
+func (x *Logger) NewObject() *KeyValueList {
+	return &KeyValueList{Values: []KeyValue{}}
+}
+
+func (x *Logger) AddObject(evt *Event, key string, obj *KeyValueList) {
+	evt.add(key, AnyValue{KvlistValue: obj})
+}
+
+func (x *Logger) CanSetObject() bool { return true }
+
+func (x *Logger) SetObject(obj *KeyValueList, key string, val *KeyValueList) *KeyValueList {
+	return obj.set(key, AnyValue{KvlistValue: val})
+}
+
+func (x *Logger) CanSetArray() bool { return true }
+
+func (x *Logger) SetArray(obj *KeyValueList, key string, val *ArrayValue) *KeyValueList {
+	return obj.set(key, AnyValue{ArrayValue: val})
+}
+
+func (x *Logger) NewArray() *ArrayValue {
+	return &ArrayValue{Values: []AnyValue{}}
+}
+
+func (x *Logger) AddArray(evt *Event, key string, arr *ArrayValue) {
+	evt.add(key, AnyValue{ArrayValue: arr})
+}
+
+func (x *Logger) CanAppendObject() bool { return true }
+
+func (x *Logger) AppendObject(arr *ArrayValue, val *KeyValueList) *ArrayValue {
+	return arr.append(AnyValue{KvlistValue: val})
+}
+
+func (x *Logger) CanAppendArray() bool { return true }
+
+func (x *Logger) AppendArray(arr *ArrayValue, val *ArrayValue) *ArrayValue {
+	return arr.append(AnyValue{ArrayValue: val})
+}
+
+func (x *Logger) SetField(obj *KeyValueList, key string, val any) *KeyValueList {
+	return obj.set(key, Value(val))
+}
+
+func (x *Logger) AppendField(arr *ArrayValue, val any) *ArrayValue {
+	return arr.append(Value(val))
+}
+
+func (x *Logger) CanSetError() bool { return true }
+
+func (x *Logger) SetError(obj *KeyValueList, err error) *KeyValueList {
+	if err != nil {
+		obj.set(`err`, StringValue(err.Error()))
+	}
+	return obj
+}
+
+func (x *Logger) CanAppendError() bool { return true }
+
+func (x *Logger) AppendError(arr *ArrayValue, err error) *ArrayValue {
+	if err == nil {
+		return arr.append(AnyValue{})
+	}
+	return arr.append(StringValue(err.Error()))
+}
+
+func (x *Logger) CanSetString() bool { return true }
+
+func (x *Logger) SetString(obj *KeyValueList, key string, val string) *KeyValueList {
+	return obj.set(key, StringValue(val))
+}
+
+func (x *Logger) CanAppendString() bool { return true }
+
+func (x *Logger) AppendString(arr *ArrayValue, val string) *ArrayValue {
+	return arr.append(StringValue(val))
+}
+
+func (x *Logger) CanSetInt() bool { return true }
+
+func (x *Logger) SetInt(obj *KeyValueList, key string, val int) *KeyValueList {
+	return obj.set(key, IntValue(int64(val)))
+}
+
+func (x *Logger) CanAppendInt() bool { return true }
+
+func (x *Logger) AppendInt(arr *ArrayValue, val int) *ArrayValue {
+	return arr.append(IntValue(int64(val)))
+}
+
+func (x *Logger) CanSetFloat32() bool { return true }
+
+func (x *Logger) SetFloat32(obj *KeyValueList, key string, val float32) *KeyValueList {
+	return obj.set(key, DoubleValue(float64(val)))
+}
+
+func (x *Logger) CanAppendFloat32() bool { return true }
+
+func (x *Logger) AppendFloat32(arr *ArrayValue, val float32) *ArrayValue {
+	return arr.append(DoubleValue(float64(val)))
+}
+
+func (x *Logger) CanSetTime() bool { return true }
+
+func (x *Logger) SetTime(obj *KeyValueList, key string, t time.Time) *KeyValueList {
+	return obj.set(key, StringValue(pbtime.FormatTimestamp(t)))
+}
+
+func (x *Logger) CanAppendTime() bool { return true }
+
+func (x *Logger) AppendTime(arr *ArrayValue, t time.Time) *ArrayValue {
+	return arr.append(StringValue(pbtime.FormatTimestamp(t)))
+}
+
+func (x *Logger) CanSetDuration() bool { return true }
+
+func (x *Logger) SetDuration(obj *KeyValueList, key string, d time.Duration) *KeyValueList {
+	return obj.set(key, StringValue(pbtime.FormatDuration(d)))
+}
+
+func (x *Logger) CanAppendDuration() bool { return true }
+
+func (x *Logger) AppendDuration(arr *ArrayValue, d time.Duration) *ArrayValue {
+	return arr.append(StringValue(pbtime.FormatDuration(d)))
+}
+
+func (x *Logger) CanSetBase64Bytes() bool { return true }
+
+func (x *Logger) SetBase64Bytes(obj *KeyValueList, key string, b []byte, enc *base64.Encoding) *KeyValueList {
+	return obj.set(key, BytesValue(b))
+}
+
+func (x *Logger) CanAppendBase64Bytes() bool { return true }
+
+func (x *Logger) AppendBase64Bytes(arr *ArrayValue, b []byte, enc *base64.Encoding) *ArrayValue {
+	return arr.append(BytesValue(b))
+}
+
+func (x *Logger) CanSetBool() bool { return true }
+
+func (x *Logger) SetBool(obj *KeyValueList, key string, val bool) *KeyValueList {
+	return obj.set(key, BoolValue(val))
+}
+
+func (x *Logger) CanAppendBool() bool { return true }
+
+func (x *Logger) AppendBool(arr *ArrayValue, val bool) *ArrayValue {
+	return arr.append(BoolValue(val))
+}
+
+func (x *Logger) CanSetFloat64() bool { return true }
+
+func (x *Logger) SetFloat64(obj *KeyValueList, key string, val float64) *KeyValueList {
+	return obj.set(key, DoubleValue(val))
+}
+
+func (x *Logger) CanAppendFloat64() bool { return true }
+
+func (x *Logger) AppendFloat64(arr *ArrayValue, val float64) *ArrayValue {
+	return arr.append(DoubleValue(val))
+}
+
+func (x *Logger) CanSetInt64() bool { return true }
+
+func (x *Logger) SetInt64(obj *KeyValueList, key string, val int64) *KeyValueList {
+	return obj.set(key, IntValue(val))
+}
+
+func (x *Logger) CanAppendInt64() bool { return true }
+
+func (x *Logger) AppendInt64(arr *ArrayValue, val int64) *ArrayValue {
+	return arr.append(IntValue(val))
+}
+
+func (x *Logger) CanSetUint64() bool { return true }
+
+func (x *Logger) SetUint64(obj *KeyValueList, key string, val uint64) *KeyValueList {
+	return obj.set(key, uint64Value(val))
+}
+
+func (x *Logger) CanAppendUint64() bool { return true }
+
+func (x *Logger) AppendUint64(arr *ArrayValue, val uint64) *ArrayValue {
+	return arr.append(uint64Value(val))
+}
+
+func (x *Logger) CanSetRawJSON() bool { return true }
+
+func (x *Logger) SetRawJSON(obj *KeyValueList, key string, b json.RawMessage) *KeyValueList {
+	return obj.set(key, rawJSONValue(b))
+}
+
+func (x *Logger) CanAppendRawJSON() bool { return true }
+
+func (x *Logger) AppendRawJSON(arr *ArrayValue, b json.RawMessage) *ArrayValue {
+	return arr.append(rawJSONValue(b))
+}
+
+func (x *KeyValueList) set(key string, val AnyValue) *KeyValueList {
+	x.Values = append(x.Values, KeyValue{Key: key, Value: val})
+	return x
+}
+
+func (x *ArrayValue) append(val AnyValue) *ArrayValue {
+	x.Values = append(x.Values, val)
+	return x
+}
@@ -0,0 +1,475 @@
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type collector struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   int
+}
+
+func fixedTime(t *testing.T) {
+	old := timeNow
+	t.Cleanup(func() { timeNow = old })
+	timeNow = func() time.Time { return time.Unix(1680693679, 496235772) }
+}
+
+func newCollector(t *testing.T) *collector {
+	t.Helper()
+	c := collector{status: http.StatusOK}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		c.mu.Lock()
+		c.requests = append(c.requests, r)
+		c.bodies = append(c.bodies, b)
+		status := c.status
+		c.mu.Unlock()
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{}`))
+		} else {
+			_, _ = w.Write([]byte(`{"message":"nope"}`))
+		}
+	}))
+	t.Cleanup(c.Close)
+	return &c
+}
+
+// records returns the JSON of each exported record, across all requests,
+// clearing them
+func (x *collector) records(t *testing.T) (records []string) {
+	t.Helper()
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, b := range x.bodies {
+		var req struct {
+			ResourceLogs []struct {
+				ScopeLogs []struct {
+					LogRecords []json.RawMessage `json:"logRecords"`
+				} `json:"scopeLogs"`
+			} `json:"resourceLogs"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("%v\n%s", err, b)
+		}
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				for _, r := range sl.LogRecords {
+					records = append(records, string(r))
+				}
+			}
+		}
+	}
+	x.requests = nil
+	x.bodies = nil
+	return
+}
+
+type testError struct{}
+
+func (testError) Error() string { return `some error` }
+
+func TestLogger_fieldTypes(t *testing.T) {
+	fixedTime(t)
+	c := newCollector(t)
+
+	l := NewLogger(WithEndpoint(c.URL), WithBatchSize(1), WithFlushInterval(0))
+	defer l.Close()
+	logger := L.New(l.Option(), L.WithLevel(logiface.LevelTrace))
+
+	const header = `{"timeUnixNano":"1680693679496235772","observedTimeUnixNano":"1680693679496235772",`
+
+	for _, tc := range [...]struct {
+		Name   string
+		Log    func(l *logiface.Logger[*Event])
+		Output string
+	}{
+		{
+			Name:   `message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Info().Log(`some message`) },
+			Output: header + `"severityNumber":9,"severityText":"info","body":{"stringValue":"some message"}}`,
+		},
+		{
+			Name:   `no message`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Emerg().Log(``) },
+			Output: header + `"severityNumber":22,"severityText":"emerg"}`,
+		},
+		{
+			Name:   `custom level`,
+			Log:    func(l *logiface.Logger[*Event]) { l.Build(10).Log(`custom`) },
+			Output: header + `"severityText":"10","body":{"stringValue":"custom"}}`,
+		},
+		{
+			Name: `scalars`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Warning().
+					Str(`str`, `value`).
+					Int(`int`, -3).
+					Int64(`int64`, math.MinInt64).
+					Uint64(`uint64`, 42).
+					Uint64(`uint64 max`, math.MaxUint64).
+					Float32(`float32`, 1.5).
+					Float64(`float64`, math.Inf(-1)).
+					Bool(`bool`, true).
+					Time(`time`, time.Unix(1, 5).UTC()).
+					Dur(`dur`, 1500*time.Millisecond).
+					Base64(`bytes`, []byte(`hi`), nil).
+					Log(`scalars`)
+			},
+			Output: header + `"severityNumber":13,"severityText":"warning","body":{"stringValue":"scalars"},"attributes":[` +
+				`{"key":"str","value":{"stringValue":"value"}},` +
+				`{"key":"int","value":{"intValue":"-3"}},` +
+				`{"key":"int64","value":{"intValue":"-9223372036854775808"}},` +
+				`{"key":"uint64","value":{"intValue":"42"}},` +
+				`{"key":"uint64 max","value":{"stringValue":"18446744073709551615"}},` +
+				`{"key":"float32","value":{"doubleValue":1.5}},` +
+				`{"key":"float64","value":{"stringValue":"-Inf"}},` +
+				`{"key":"bool","value":{"boolValue":true}},` +
+				`{"key":"time","value":{"stringValue":"1970-01-01T00:00:01.000000005Z"}},` +
+				`{"key":"dur","value":{"stringValue":"1.500s"}},` +
+				`{"key":"bytes","value":{"bytesValue":"aGk="}}]}`,
+		},
+		{
+			Name: `error`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Err().Err(testError{}).Log(`failed`)
+			},
+			Output: header + `"severityNumber":17,"severityText":"err","body":{"stringValue":"failed"},"attributes":[` +
+				`{"key":"exception.message","value":{"stringValue":"some error"}},` +
+				`{"key":"exception.type","value":{"stringValue":"otlp.testError"}}]}`,
+		},
+		{
+			Name: `any`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Debug().
+					Any(`map`, map[string]any{`b`: 2, `a`: []any{`x`, nil, 1.25}}).
+					Any(`struct`, struct {
+						A int64
+						B string
+					}{A: 9, B: `y`}).
+					RawJSON(`raw`, json.RawMessage(`{"n":1.5,"i":3}`)).
+					Log(``)
+			},
+			Output: header + `"severityNumber":5,"severityText":"debug","attributes":[` +
+				`{"key":"map","value":{"kvlistValue":{"values":[{"key":"a","value":{"arrayValue":{"values":[{"stringValue":"x"},{},{"doubleValue":1.25}]}}},{"key":"b","value":{"intValue":"2"}}]}}},` +
+				`{"key":"struct","value":{"kvlistValue":{"values":[{"key":"A","value":{"intValue":"9"}},{"key":"B","value":{"stringValue":"y"}}]}}},` +
+				`{"key":"raw","value":{"kvlistValue":{"values":[{"key":"i","value":{"intValue":"3"}},{"key":"n","value":{"doubleValue":1.5}}]}}}]}`,
+		},
+		{
+			Name: `nested`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Notice().
+					Object().
+					Field(`a`, 1).
+					Array().Str(`b`).Int(2).As(`arr`).
+					Object().Dur(`c`, time.Second).As(`d`).
+					As(`obj`).
+					End().
+					Log(`nested`)
+			},
+			Output: header + `"severityNumber":10,"severityText":"notice","body":{"stringValue":"nested"},"attributes":[` +
+				`{"key":"obj","value":{"kvlistValue":{"values":[` +
+				`{"key":"a","value":{"intValue":"1"}},` +
+				`{"key":"arr","value":{"arrayValue":{"values":[{"stringValue":"b"},{"intValue":"2"}]}}},` +
+				`{"key":"d","value":{"kvlistValue":{"values":[{"key":"c","value":{"stringValue":"1s"}}]}}}]}}}]}`,
+		},
+		{
+			Name: `group`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().Str(`a`, `1`).Group(`g`).Str(`b`, `2`).Err(errors.New(`e`)).Log(`grouped`)
+			},
+			Output: header + `"severityNumber":9,"severityText":"info","body":{"stringValue":"grouped"},"attributes":[` +
+				`{"key":"a","value":{"stringValue":"1"}},` +
+				`{"key":"g","value":{"kvlistValue":{"values":[{"key":"b","value":{"stringValue":"2"}}]}}},` +
+				`{"key":"exception.message","value":{"stringValue":"e"}},` +
+				`{"key":"exception.type","value":{"stringValue":"*errors.errorString"}}]}`,
+		},
+		{
+			Name: `span context`,
+			Log: func(l *logiface.Logger[*Event]) {
+				ctx := ContextWithSpanContext(context.Background(), SpanContext{
+					TraceID:    [16]byte{0: 0xab, 15: 0x01},
+					SpanID:     [8]byte{0: 0xcd, 7: 0x02},
+					TraceFlags: 1,
+				})
+				l.Trace().Modifier(Ctx(ctx)).Log(`traced`)
+			},
+			Output: header + `"severityNumber":1,"severityText":"trace","body":{"stringValue":"traced"},"flags":1,` +
+				`"traceId":"ab000000000000000000000000000001","spanId":"cd00000000000002"}`,
+		},
+		{
+			Name: `no span context`,
+			Log: func(l *logiface.Logger[*Event]) {
+				l.Info().Modifier(Ctx(context.Background())).Log(`untraced`)
+			},
+			Output: header + `"severityNumber":9,"severityText":"info","body":{"stringValue":"untraced"}}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Log(logger)
+			records := c.records(t)
+			if len(records) != 1 {
+				t.Fatalf("unexpected records: %q", records)
+			}
+			if s := records[0]; s != tc.Output {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestLogger_export(t *testing.T) {
+	fixedTime(t)
+	c := newCollector(t)
+
+	l := NewLogger(
+		WithEndpoint(c.URL+`/v1/logs`),
+		WithHeader(`Authorization`, `Bearer token`),
+		WithResource(KeyValue{Key: `service.name`, Value: StringValue(`svc`)}),
+		WithScope(`scope`, `v1.2.3`),
+		WithBatchSize(2),
+		WithFlushInterval(0),
+	)
+	logger := L.New(l.Option())
+
+	logger.Info().Log(`one`)
+	if records := c.records(t); len(records) != 0 {
+		t.Fatalf("unexpected records: %q", records)
+	}
+
+	logger.Info().Log(`two`)
+	c.mu.Lock()
+	if len(c.requests) != 1 {
+		c.mu.Unlock()
+		t.Fatalf("unexpected requests: %d", len(c.requests))
+	}
+	r, body := c.requests[0], string(c.bodies[0])
+	c.mu.Unlock()
+	if r.Method != http.MethodPost || r.URL.Path != `/v1/logs` {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+	}
+	if v := r.Header.Get(`Content-Type`); v != `application/json` {
+		t.Errorf("unexpected content type: %q", v)
+	}
+	if v := r.Header.Get(`Authorization`); v != `Bearer token` {
+		t.Errorf("unexpected authorization: %q", v)
+	}
+	const prefix = `{"resourceLogs":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"svc"}}]},"scopeLogs":[{"scope":{"name":"scope","version":"v1.2.3"},"logRecords":[`
+	if !strings.HasPrefix(body, prefix) || strings.Count(body, `"severityText"`) != 2 {
+		t.Errorf("unexpected body: %s", body)
+	}
+	if records := c.records(t); len(records) != 2 ||
+		!strings.Contains(records[0], `"one"`) ||
+		!strings.Contains(records[1], `"two"`) {
+		t.Errorf("unexpected records: %q", records)
+	}
+
+	logger.Info().Log(`three`)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if records := c.records(t); len(records) != 1 || !strings.Contains(records[0], `"three"`) {
+		t.Errorf("unexpected records: %q", records)
+	}
+
+	if err := l.Write(l.NewEvent(logiface.LevelInformational)); err != ErrClosed {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLogger_flushInterval(t *testing.T) {
+	c := newCollector(t)
+	l := NewLogger(WithEndpoint(c.URL), WithFlushInterval(time.Millisecond*10))
+	defer l.Close()
+	logger := L.New(l.Option())
+
+	logger.Info().Log(`a`)
+	logger.Info().Log(`b`)
+
+	deadline := time.Now().Add(5 * time.Second)
+	var records []string
+	for len(records) < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 5)
+		records = append(records, c.records(t)...)
+	}
+	if len(records) != 2 {
+		t.Fatalf("unexpected records: %q", records)
+	}
+}
+
+func TestLogger_exportError(t *testing.T) {
+	c := newCollector(t)
+	c.status = http.StatusBadRequest
+
+	errs := make(chan error, 10)
+	l := NewLogger(
+		WithEndpoint(c.URL),
+		WithFlushInterval(time.Millisecond*10),
+		WithErrorHandler(func(err error) { errs <- err }),
+	)
+	defer l.Close()
+
+	if err := l.Write(l.NewEvent(logiface.LevelInformational)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errs:
+		if s := err.Error(); s != `otlp: export failed: 400 Bad Request: {"message":"nope"}` {
+			t.Errorf("unexpected error: %q", s)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal(`expected error`)
+	}
+}
+
+func TestLogger_Write_batchFull(t *testing.T) {
+	t.Run(`background`, func(t *testing.T) {
+		c := newCollector(t)
+		c.status = http.StatusBadRequest
+
+		errs := make(chan error, 10)
+		l := NewLogger(
+			WithEndpoint(c.URL),
+			WithBatchSize(2),
+			// long enough that only the full batch triggers the export
+			WithFlushInterval(time.Hour),
+			WithErrorHandler(func(err error) { errs <- err }),
+		)
+		defer l.Close()
+
+		for range 2 {
+			// the export happens in the background
+			if err := l.Write(l.NewEvent(logiface.LevelInformational)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		select {
+		case err := <-errs:
+			if s := err.Error(); s != `otlp: export failed: 400 Bad Request: {"message":"nope"}` {
+				t.Errorf("unexpected error: %q", s)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal(`expected error`)
+		}
+		if records := c.records(t); len(records) != 2 {
+			t.Errorf("unexpected records: %q", records)
+		}
+	})
+
+	t.Run(`synchronous`, func(t *testing.T) {
+		c := newCollector(t)
+		c.status = http.StatusBadRequest
+
+		var errs []error
+		l := NewLogger(
+			WithEndpoint(c.URL),
+			WithBatchSize(1),
+			WithFlushInterval(0),
+			WithErrorHandler(func(err error) { errs = append(errs, err) }),
+		)
+		defer l.Close()
+
+		logger := L.New(l.Option())
+		logger.Info().Log(`dropped`)
+		if len(errs) != 1 || errs[0].Error() != `otlp: export failed: 400 Bad Request: {"message":"nope"}` {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+}
+
+func TestLogger_Write_queueFull(t *testing.T) {
+	c := newCollector(t)
+	l := NewLogger(
+		WithEndpoint(c.URL),
+		WithBatchSize(2),
+		WithMaxQueueSize(3),
+		WithFlushInterval(0),
+	)
+	defer l.Close()
+	// simulates a background export that isn't keeping up
+	l.flush = make(chan struct{}, 1)
+
+	for i := range 5 {
+		err := l.Write(l.NewEvent(logiface.LevelInformational))
+		if i < 3 {
+			if err != nil {
+				t.Fatal(i, err)
+			}
+		} else if err != ErrQueueFull {
+			t.Fatal(i, err)
+		}
+	}
+	if v := l.Dropped(); v != 2 {
+		t.Errorf("unexpected dropped: %d", v)
+	}
+
+	if err := l.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if records := c.records(t); len(records) != 3 {
+		t.Errorf("unexpected records: %q", records)
+	}
+
+	// space is available again
+	if err := l.Write(l.NewEvent(logiface.LevelInformational)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWithMaxQueueSize_batchSize(t *testing.T) {
+	if l := NewLogger(WithBatchSize(10), WithMaxQueueSize(5), WithFlushInterval(0)); l.maxQueueSize != 10 {
+		t.Error(l.maxQueueSize)
+	}
+}
+
+func TestWithSpanContextFunc(t *testing.T) {
+	l := NewLogger(
+		WithFlushInterval(0),
+		WithSpanContextFunc(func(ctx context.Context) (SpanContext, bool) {
+			return SpanContext{TraceID: [16]byte{1}, SpanID: [8]byte{2}}, true
+		}),
+	)
+	e := l.NewEvent(logiface.LevelInformational)
+	e.SetContext(context.Background())
+	if r := e.Record(); r.TraceID != `01000000000000000000000000000000` || r.SpanID != `0200000000000000` {
+		t.Errorf("unexpected record: %+v", r)
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	for level, expected := range map[logiface.Level]SeverityNumber{
+		logiface.LevelDisabled:      SeverityUnspecified,
+		logiface.LevelEmergency:     SeverityFatal2,
+		logiface.LevelAlert:         SeverityFatal,
+		logiface.LevelCritical:      SeverityError2,
+		logiface.LevelError:         SeverityError,
+		logiface.LevelWarning:       SeverityWarn,
+		logiface.LevelNotice:        SeverityInfo2,
+		logiface.LevelInformational: SeverityInfo,
+		logiface.LevelDebug:         SeverityDebug,
+		logiface.LevelTrace:         SeverityTrace,
+		logiface.LevelTrace + 1:     SeverityUnspecified,
+	} {
+		if v := Severity(level); v != expected {
+			t.Errorf("%s: unexpected severity: %d", level, v)
+		}
+	}
+}
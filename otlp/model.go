@@ -0,0 +1,273 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type (
+	// LogRecord models the OpenTelemetry LogRecord message, and encodes to
+	// the OTLP/JSON format.
+	LogRecord struct {
+		TimeUnixNano         uint64     `json:"timeUnixNano,string,omitempty"`
+		ObservedTimeUnixNano uint64     `json:"observedTimeUnixNano,string,omitempty"`
+		SeverityNumber       int        `json:"severityNumber,omitempty"`
+		SeverityText         string     `json:"severityText,omitempty"`
+		Body                 *AnyValue  `json:"body,omitempty"`
+		Attributes           []KeyValue `json:"attributes,omitempty"`
+		Flags                uint32     `json:"flags,omitempty"`
+		// TraceID is hex encoded, as per OTLP/JSON.
+		TraceID string `json:"traceId,omitempty"`
+		// SpanID is hex encoded, as per OTLP/JSON.
+		SpanID string `json:"spanId,omitempty"`
+	}
+
+	// AnyValue models the OpenTelemetry AnyValue message, at most one field
+	// of which should be set.
+	AnyValue struct {
+		StringValue *string       `json:"stringValue,omitempty"`
+		BoolValue   *bool         `json:"boolValue,omitempty"`
+		IntValue    *int64        `json:"intValue,string,omitempty"`
+		DoubleValue *float64      `json:"doubleValue,omitempty"`
+		ArrayValue  *ArrayValue   `json:"arrayValue,omitempty"`
+		KvlistValue *KeyValueList `json:"kvlistValue,omitempty"`
+		BytesValue  []byte        `json:"bytesValue,omitempty"`
+	}
+
+	// ArrayValue models the OpenTelemetry ArrayValue message, and is the
+	// [logiface.JSONSupport] array type, for [Event].
+	ArrayValue struct {
+		Values []AnyValue `json:"values"`
+	}
+
+	// KeyValueList models the OpenTelemetry KeyValueList message, and is the
+	// [logiface.JSONSupport] object type, for [Event].
+	KeyValueList struct {
+		Values []KeyValue `json:"values"`
+	}
+
+	// KeyValue models the OpenTelemetry KeyValue message.
+	KeyValue struct {
+		Key   string   `json:"key"`
+		Value AnyValue `json:"value"`
+	}
+
+	// SeverityNumber models the OpenTelemetry SeverityNumber enum.
+	SeverityNumber int
+
+	exportLogsServiceRequest struct {
+		ResourceLogs []resourceLogs `json:"resourceLogs"`
+	}
+
+	resourceLogs struct {
+		Resource  resource    `json:"resource"`
+		ScopeLogs []scopeLogs `json:"scopeLogs"`
+	}
+
+	resource struct {
+		Attributes []KeyValue `json:"attributes,omitempty"`
+	}
+
+	scopeLogs struct {
+		Scope      instrumentationScope `json:"scope"`
+		LogRecords []LogRecord          `json:"logRecords"`
+	}
+
+	instrumentationScope struct {
+		Name    string `json:"name,omitempty"`
+		Version string `json:"version,omitempty"`
+	}
+)
+
+// SeverityNumber values, as defined by the logs data model.
+const (
+	SeverityUnspecified SeverityNumber = 0
+	SeverityTrace       SeverityNumber = 1
+	SeverityDebug       SeverityNumber = 5
+	SeverityInfo        SeverityNumber = 9
+	SeverityInfo2       SeverityNumber = 10
+	SeverityWarn        SeverityNumber = 13
+	SeverityError       SeverityNumber = 17
+	SeverityError2      SeverityNumber = 18
+	SeverityFatal       SeverityNumber = 21
+	SeverityFatal2      SeverityNumber = 22
+)
+
+// Severity maps a logiface level to the OpenTelemetry severity number.
+// Notice, critical, and emergency are mapped to the second severity within
+// the info, error, and fatal ranges, respectively. Custom levels are
+// unspecified.
+func Severity(level logiface.Level) SeverityNumber {
+	switch level {
+	case logiface.LevelEmergency:
+		return SeverityFatal2
+	case logiface.LevelAlert:
+		return SeverityFatal
+	case logiface.LevelCritical:
+		return SeverityError2
+	case logiface.LevelError:
+		return SeverityError
+	case logiface.LevelWarning:
+		return SeverityWarn
+	case logiface.LevelNotice:
+		return SeverityInfo2
+	case logiface.LevelInformational:
+		return SeverityInfo
+	case logiface.LevelDebug:
+		return SeverityDebug
+	case logiface.LevelTrace:
+		return SeverityTrace
+	default:
+		return SeverityUnspecified
+	}
+}
+
+// StringValue returns an AnyValue containing a string.
+func StringValue(v string) AnyValue { return AnyValue{StringValue: &v} }
+
+// BoolValue returns an AnyValue containing a bool.
+func BoolValue(v bool) AnyValue { return AnyValue{BoolValue: &v} }
+
+// IntValue returns an AnyValue containing an int64.
+func IntValue(v int64) AnyValue { return AnyValue{IntValue: &v} }
+
+// DoubleValue returns an AnyValue containing a float64, or, as non-finite
+// values cannot be encoded, a string.
+func DoubleValue(v float64) AnyValue {
+	switch {
+	case math.IsNaN(v):
+		return StringValue(`NaN`)
+	case math.IsInf(v, 1):
+		return StringValue(`+Inf`)
+	case math.IsInf(v, -1):
+		return StringValue(`-Inf`)
+	}
+	return AnyValue{DoubleValue: &v}
+}
+
+// BytesValue returns an AnyValue containing bytes.
+func BytesValue(v []byte) AnyValue {
+	if v == nil {
+		v = []byte{}
+	}
+	return AnyValue{BytesValue: v}
+}
+
+// Value converts an arbitrary value to an AnyValue. Maps with string keys
+// (sorted, for determinism) are converted to kvlist values, slices (other
+// than []byte) to array values, and values of unsupported types are converted
+// via their JSON representation. Nil values are converted to an empty
+// AnyValue.
+func Value(val any) AnyValue {
+	switch val := val.(type) {
+	case nil:
+		return AnyValue{}
+	case AnyValue:
+		return val
+	case string:
+		return StringValue(val)
+	case bool:
+		return BoolValue(val)
+	case int:
+		return IntValue(int64(val))
+	case int8:
+		return IntValue(int64(val))
+	case int16:
+		return IntValue(int64(val))
+	case int32:
+		return IntValue(int64(val))
+	case int64:
+		return IntValue(val)
+	case uint:
+		return uint64Value(uint64(val))
+	case uint8:
+		return IntValue(int64(val))
+	case uint16:
+		return IntValue(int64(val))
+	case uint32:
+		return IntValue(int64(val))
+	case uint64:
+		return uint64Value(val)
+	case float32:
+		return DoubleValue(float64(val))
+	case float64:
+		return DoubleValue(val)
+	case []byte:
+		return BytesValue(val)
+	case error:
+		return StringValue(fmt.Sprint(val))
+	case time.Time:
+		return StringValue(pbtime.FormatTimestamp(val))
+	case time.Duration:
+		return StringValue(pbtime.FormatDuration(val))
+	case json.RawMessage:
+		return rawJSONValue(val)
+	case json.Number:
+		if v, err := val.Int64(); err == nil {
+			return IntValue(v)
+		}
+		if v, err := val.Float64(); err == nil {
+			return DoubleValue(v)
+		}
+		return StringValue(string(val))
+	case []any:
+		arr := ArrayValue{Values: make([]AnyValue, len(val))}
+		for i, v := range val {
+			arr.Values[i] = Value(v)
+		}
+		return AnyValue{ArrayValue: &arr}
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		kvs := KeyValueList{Values: make([]KeyValue, len(keys))}
+		for i, k := range keys {
+			kvs.Values[i] = KeyValue{Key: k, Value: Value(val[k])}
+		}
+		return AnyValue{KvlistValue: &kvs}
+	case fmt.Stringer:
+		if v := reflect.ValueOf(val); v.Kind() == reflect.Pointer && v.IsNil() {
+			return AnyValue{}
+		}
+		return StringValue(val.String())
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return StringValue(fmt.Sprintf("marshaling error: %v", err))
+	}
+	return rawJSONValue(b)
+}
+
+func uint64Value(v uint64) AnyValue {
+	if v > math.MaxInt64 {
+		return StringValue(strconv.FormatUint(v, 10))
+	}
+	return IntValue(int64(v))
+}
+
+func rawJSONValue(b json.RawMessage) AnyValue {
+	if len(b) == 0 {
+		return AnyValue{}
+	}
+	var v any
+	if err := jsonUnmarshalUseNumber(b, &v); err != nil {
+		return StringValue(string(b))
+	}
+	return Value(v)
+}
+
+func jsonUnmarshalUseNumber(b []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
@@ -8,6 +8,9 @@ type (
 	refPoolItem struct {
 		a any
 		b any
+		// schema is resolved once, for object and array builders, as it is
+		// needed by each field
+		schema *FieldSchema
 	}
 )
 
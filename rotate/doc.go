@@ -0,0 +1,13 @@
+// Package rotate implements an [io.Writer], writing to a file, which is
+// rotated by size and/or interval, for use with any logiface implementation
+// that writes to an [io.Writer], e.g. package jsonl.
+//
+// Rotated files (backups) are renamed, in the same directory, with a UTC
+// timestamp inserted before the extension, e.g. app.log is rotated to
+// app-2006-01-02T15-04-05.000.log, and may be gzip compressed, in the
+// background. Backups may be removed, in the background, based on their
+// age, and/or count.
+//
+// The file may also be reopened, without rotation, e.g. after it has been
+// moved by logrotate, see [Writer.Reopen], and [WithReopenSignal].
+package rotate
@@ -0,0 +1,23 @@
+package rotate_test
+
+import (
+	"github.com/joeycumines/logiface/jsonl"
+	"github.com/joeycumines/logiface/rotate"
+	"time"
+)
+
+func ExampleNewWriter() {
+	w := rotate.NewWriter(`/var/log/app/app.log`,
+		rotate.WithMaxSize(100<<20),
+		rotate.WithInterval(24*time.Hour),
+		rotate.WithCompress(true),
+		rotate.WithMaxAge(30*24*time.Hour),
+		rotate.WithMaxBackups(10),
+		rotate.WithReopenSignal(),
+	)
+	defer w.Close()
+
+	logger := jsonl.L.New(jsonl.L.WithJSONL(jsonl.WithWriter(w)))
+
+	logger.Info().Log(`hello`)
+}
@@ -0,0 +1,118 @@
+package rotate
+
+import (
+	"os"
+	"time"
+)
+
+type (
+	// Option models a configuration option for [NewWriter].
+	Option interface {
+		apply(c *writerConfig)
+	}
+
+	optionFunc func(c *writerConfig)
+
+	writerConfig struct {
+		maxSize       int64
+		interval      time.Duration
+		compress      bool
+		maxAge        time.Duration
+		maxBackups    int
+		fileMode      os.FileMode
+		reopenSignals []os.Signal
+		errorHandler  func(err error)
+	}
+)
+
+const (
+	// DefaultFileMode is the default permissions, used when creating files.
+	DefaultFileMode os.FileMode = 0644
+
+	// backupTimeFormat is inserted into the name of rotated files
+	backupTimeFormat = `2006-01-02T15-04-05.000`
+
+	compressSuffix = `.gz`
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+func (x optionFunc) apply(c *writerConfig) { x(c) }
+
+// WithMaxSize configures the maximum size, in bytes, of the file, before it
+// is rotated. A write larger than the maximum size will still be written, to
+// an empty file. Disabled by default, or if size is non-positive.
+func WithMaxSize(size int64) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.maxSize = size
+	})
+}
+
+// WithInterval configures the file to be rotated on the first write after
+// each interval boundary, e.g. time.Hour will rotate on the first write of
+// each (UTC) hour. Disabled by default, or if interval is non-positive.
+func WithInterval(interval time.Duration) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.interval = interval
+	})
+}
+
+// WithCompress enables gzip compression of backups, which is performed in
+// the background. Disabled by default.
+func WithCompress(compress bool) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.compress = compress
+	})
+}
+
+// WithMaxAge configures backups to be removed once they are older than
+// maxAge, based on the timestamp in their name. Disabled by default, or if
+// maxAge is non-positive.
+func WithMaxAge(maxAge time.Duration) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.maxAge = maxAge
+	})
+}
+
+// WithMaxBackups configures the maximum number of backups that will be
+// retained, removing the oldest. Disabled by default, or if count is
+// non-positive.
+func WithMaxBackups(count int) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.maxBackups = count
+	})
+}
+
+// WithFileMode configures the permissions used when creating files, which
+// defaults to [DefaultFileMode].
+func WithFileMode(mode os.FileMode) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.fileMode = mode
+	})
+}
+
+// WithReopenSignal configures the writer to reopen the file, on receipt of
+// any of the given signals, for compatibility with tools like logrotate.
+// If no signals are provided, SIGHUP will be used, on platforms that support
+// it. See also [Writer.Reopen].
+func WithReopenSignal(signals ...os.Signal) Option {
+	return optionFunc(func(c *writerConfig) {
+		if len(signals) == 0 {
+			signals = defaultReopenSignals
+		}
+		c.reopenSignals = append(c.reopenSignals, signals...)
+	})
+}
+
+// WithErrorHandler configures a function to be called with any errors that
+// occur in the background, e.g. while compressing, or removing backups, or
+// reopening on signal. Such errors are otherwise discarded.
+func WithErrorHandler(handler func(err error)) Option {
+	return optionFunc(func(c *writerConfig) {
+		c.errorHandler = handler
+	})
+}
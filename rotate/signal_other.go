@@ -0,0 +1,9 @@
+//go:build !unix
+
+package rotate
+
+import (
+	"os"
+)
+
+var defaultReopenSignals []os.Signal
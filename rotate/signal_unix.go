@@ -0,0 +1,10 @@
+//go:build unix
+
+package rotate
+
+import (
+	"os"
+	"syscall"
+)
+
+var defaultReopenSignals = []os.Signal{syscall.SIGHUP}
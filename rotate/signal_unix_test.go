@@ -0,0 +1,37 @@
+//go:build unix
+
+package rotate
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWithReopenSignal(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, `app.log`)
+	w := NewWriter(name, WithReopenSignal())
+	defer w.Close()
+	mustWrite(t, w, "a\n")
+	if err := os.Rename(name, name+`.1`); err != nil {
+		t.Fatal(err)
+	}
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !exists(name) {
+		if time.Now().After(deadline) {
+			t.Fatal(`expected file to be reopened`)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	mustWrite(t, w, "b\n")
+	files := readDir(t, dir)
+	if len(files) != 2 || files[`app.log.1`] != "a\n" || files[`app.log`] != "b\n" {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
@@ -0,0 +1,446 @@
+package rotate
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Writer is an [io.Writer], writing to a file, which is rotated, see the
+	// package documentation. Writer is safe for concurrent use, and each
+	// call to Write will be written to a single file.
+	Writer struct {
+		filename   string
+		maxSize    int64
+		interval   time.Duration
+		compress   bool
+		maxAge     time.Duration
+		maxBackups int
+		fileMode   os.FileMode
+		onError    func(err error)
+
+		mu     sync.Mutex
+		file   *os.File
+		size   int64
+		next   time.Time
+		closed bool
+
+		mill    chan struct{}
+		done    chan struct{}
+		wg      sync.WaitGroup
+		stopped sync.Once
+	}
+
+	// backup is a rotated file, which may exist in uncompressed and/or
+	// compressed form (the former, if compression was interrupted)
+	backup struct {
+		name       string
+		time       time.Time
+		plain      bool
+		compressed bool
+	}
+)
+
+var (
+	// ErrClosed is returned by [Writer.Write], after [Writer.Close].
+	ErrClosed = errors.New(`rotate: writer closed`)
+
+	// compile time assertions
+
+	_ io.WriteCloser = (*Writer)(nil)
+)
+
+var (
+	timeNow = time.Now
+)
+
+// NewWriter initializes a new [Writer], which will write to filename,
+// creating it, and any parent directories, on first write.
+//
+// If any background behavior is configured, e.g. via [WithCompress], or
+// [WithReopenSignal], goroutines are started, which will run until
+// [Writer.Close] is called.
+func NewWriter(filename string, options ...Option) *Writer {
+	c := writerConfig{
+		fileMode: DefaultFileMode,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	w := Writer{
+		filename:   filename,
+		maxSize:    c.maxSize,
+		interval:   c.interval,
+		compress:   c.compress,
+		maxAge:     c.maxAge,
+		maxBackups: c.maxBackups,
+		fileMode:   c.fileMode,
+		onError:    c.errorHandler,
+		done:       make(chan struct{}),
+	}
+
+	if w.compress || w.maxAge > 0 || w.maxBackups > 0 {
+		w.mill = make(chan struct{}, 1)
+		w.wg.Add(1)
+		go w.runMill()
+	}
+
+	if len(c.reopenSignals) != 0 {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, c.reopenSignals...)
+		w.wg.Add(1)
+		go w.runSignals(ch)
+	}
+
+	return &w
+}
+
+// Filename returns the name of the file being written to.
+func (x *Writer) Filename() string {
+	return x.filename
+}
+
+// Write writes p to the file, rotating it first, if necessary.
+func (x *Writer) Write(p []byte) (n int, err error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.closed {
+		return 0, ErrClosed
+	}
+
+	if x.file == nil {
+		if err = x.open(); err != nil {
+			return
+		}
+	}
+
+	if x.shouldRotate(len(p)) {
+		if err = x.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, err = x.file.Write(p)
+	x.size += int64(n)
+	return
+}
+
+// Rotate closes the current file, if any, renames it to a backup, then opens
+// a new file. It may be used to implement custom rotation behavior.
+func (x *Writer) Rotate() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.closed {
+		return ErrClosed
+	}
+	return x.rotate()
+}
+
+// Reopen closes, then reopens the file, without rotating it. This is
+// necessary after the file has been moved, or removed, e.g. by logrotate.
+// See also [WithReopenSignal].
+func (x *Writer) Reopen() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.closed {
+		return ErrClosed
+	}
+	if err := x.closeFile(); err != nil {
+		return err
+	}
+	return x.open()
+}
+
+// Close closes the file, and stops any background behavior, waiting for any
+// pending compression, and removal of backups.
+func (x *Writer) Close() (err error) {
+	x.mu.Lock()
+	if !x.closed {
+		x.closed = true
+		err = x.closeFile()
+	}
+	x.mu.Unlock()
+	x.stopped.Do(func() { close(x.done) })
+	x.wg.Wait()
+	return
+}
+
+func (x *Writer) shouldRotate(n int) bool {
+	if x.maxSize > 0 && x.size > 0 && x.size+int64(n) > x.maxSize {
+		return true
+	}
+	if x.interval > 0 && !timeNow().Before(x.next) {
+		return true
+	}
+	return false
+}
+
+func (x *Writer) open() error {
+	if err := os.MkdirAll(filepath.Dir(x.filename), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(x.filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, x.fileMode)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	x.file = f
+	x.size = info.Size()
+	if x.interval > 0 {
+		// existing content is attributed to the interval it was last modified
+		start := timeNow()
+		if x.size != 0 {
+			start = info.ModTime()
+		}
+		x.next = start.Truncate(x.interval).Add(x.interval)
+	}
+	x.triggerMill()
+	return nil
+}
+
+func (x *Writer) closeFile() error {
+	if x.file == nil {
+		return nil
+	}
+	err := x.file.Close()
+	x.file = nil
+	return err
+}
+
+func (x *Writer) rotate() error {
+	if err := x.closeFile(); err != nil {
+		return err
+	}
+	if _, err := os.Lstat(x.filename); err == nil {
+		if err := os.Rename(x.filename, x.backupName(timeNow())); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return x.open()
+}
+
+// backupName returns an unused name, for a backup, rotated at t
+func (x *Writer) backupName(t time.Time) string {
+	dir, prefix, ext := x.nameParts()
+	t = t.UTC().Truncate(time.Millisecond)
+	for {
+		name := filepath.Join(dir, prefix+t.Format(backupTimeFormat)+ext)
+		if !exists(name) && !exists(name+compressSuffix) {
+			return name
+		}
+		t = t.Add(time.Millisecond)
+	}
+}
+
+func (x *Writer) nameParts() (dir, prefix, ext string) {
+	dir = filepath.Dir(x.filename)
+	base := filepath.Base(x.filename)
+	ext = filepath.Ext(base)
+	prefix = base[:len(base)-len(ext)] + `-`
+	return
+}
+
+func (x *Writer) triggerMill() {
+	if x.mill != nil {
+		select {
+		case x.mill <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (x *Writer) runMill() {
+	defer x.wg.Done()
+	for {
+		select {
+		case <-x.done:
+			// finish any pending work, e.g. from a final rotation
+			select {
+			case <-x.mill:
+				x.millOnce()
+			default:
+			}
+			return
+		case <-x.mill:
+			x.millOnce()
+		}
+	}
+}
+
+func (x *Writer) runSignals(ch chan os.Signal) {
+	defer x.wg.Done()
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-x.done:
+			return
+		case <-ch:
+			if err := x.Reopen(); err != nil && err != ErrClosed {
+				x.handleError(err)
+			}
+		}
+	}
+}
+
+func (x *Writer) handleError(err error) {
+	if x.onError != nil {
+		x.onError(err)
+	}
+}
+
+// millOnce removes, then compresses backups, as configured
+func (x *Writer) millOnce() {
+	backups, err := x.backups()
+	if err != nil {
+		x.handleError(err)
+		return
+	}
+
+	var cutoff time.Time
+	if x.maxAge > 0 {
+		cutoff = timeNow().Add(-x.maxAge)
+	}
+
+	for i, b := range backups {
+		if (x.maxBackups > 0 && i >= x.maxBackups) || (x.maxAge > 0 && b.time.Before(cutoff)) {
+			if b.plain {
+				x.remove(b.name)
+			}
+			if b.compressed {
+				x.remove(b.name + compressSuffix)
+			}
+			continue
+		}
+		if !b.plain {
+			continue
+		}
+		if b.compressed {
+			// compression was interrupted, and must be redone
+			x.remove(b.name + compressSuffix)
+		}
+		if x.compress {
+			if err := compressFile(b.name, b.name+compressSuffix); err != nil {
+				x.handleError(err)
+			}
+		}
+	}
+}
+
+func (x *Writer) remove(name string) {
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		x.handleError(err)
+	}
+}
+
+// backups returns the backups, sorted newest first
+func (x *Writer) backups() ([]backup, error) {
+	dir, prefix, ext := x.nameParts()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	var backups []backup
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		name := entry.Name()
+		compressed := strings.HasSuffix(name, compressSuffix)
+		if compressed {
+			name = name[:len(name)-len(compressSuffix)]
+		}
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, name[len(prefix):len(name)-len(ext)])
+		if err != nil {
+			continue
+		}
+		name = filepath.Join(dir, name)
+		i, ok := index[name]
+		if !ok {
+			i = len(backups)
+			index[name] = i
+			backups = append(backups, backup{name: name, time: t})
+		}
+		if compressed {
+			backups[i].compressed = true
+		} else {
+			backups[i].plain = true
+		}
+	}
+	slices.SortFunc(backups, func(a, b backup) int {
+		if c := b.time.Compare(a.time); c != 0 {
+			return c
+		}
+		return strings.Compare(b.name, a.name)
+	})
+	return backups, nil
+}
+
+// compressFile gzips src to dst, via a temporary file, removing src
+func compressFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := dst + `.tmp`
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = out.Close()
+			_ = os.Remove(tmp)
+		}
+	}()
+
+	gz := gzip.NewWriter(out)
+	gz.Name = filepath.Base(src)
+	gz.ModTime = info.ModTime()
+	if _, err = io.Copy(gz, in); err != nil {
+		return
+	}
+	if err = gz.Close(); err != nil {
+		return
+	}
+	if err = out.Close(); err != nil {
+		return
+	}
+	if err = os.Rename(tmp, dst); err != nil {
+		return
+	}
+	_ = in.Close()
+	return os.Remove(src)
+}
+
+func exists(name string) bool {
+	_, err := os.Lstat(name)
+	return err == nil
+}
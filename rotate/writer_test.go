@@ -0,0 +1,290 @@
+package rotate
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock replaces timeNow, returning the current value of the clock
+func fakeClock(t *testing.T, now time.Time) *time.Time {
+	var mu sync.Mutex
+	old := timeNow
+	t.Cleanup(func() { timeNow = old })
+	timeNow = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	return &now
+}
+
+func readDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, entry := range entries {
+		name := filepath.Join(dir, entry.Name())
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r io.Reader = f
+		if strings.HasSuffix(name, compressSuffix) {
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = gz
+		}
+		b, err := io.ReadAll(r)
+		_ = f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[entry.Name()] = string(b)
+	}
+	return files
+}
+
+func mustWrite(t *testing.T, w io.Writer, s string) {
+	t.Helper()
+	if n, err := w.Write([]byte(s)); err != nil || n != len(s) {
+		t.Fatal(n, err)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func TestWriter_maxSize(t *testing.T) {
+	fakeClock(t, time.Date(2023, 4, 5, 11, 21, 19, 496235772, time.UTC))
+	dir := t.TempDir()
+	w := NewWriter(filepath.Join(dir, `sub`, `app.log`), WithMaxSize(10))
+	mustWrite(t, w, "line 1\n")
+	mustWrite(t, w, "line 2\n")
+	mustWrite(t, w, "line 3\n")
+	// larger than the max size, but still written
+	mustWrite(t, w, "a very long line\n")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files := readDir(t, filepath.Join(dir, `sub`))
+	expected := map[string]string{
+		`app.log`:                         "a very long line\n",
+		`app-2023-04-05T11-21-19.496.log`: "line 1\n",
+		`app-2023-04-05T11-21-19.497.log`: "line 2\n",
+		`app-2023-04-05T11-21-19.498.log`: "line 3\n",
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("unexpected files: %q", files)
+	}
+	for k, v := range expected {
+		if files[k] != v {
+			t.Errorf("unexpected %s: %q", k, files[k])
+		}
+	}
+	if _, err := w.Write(nil); err != ErrClosed {
+		t.Error(err)
+	}
+}
+
+func TestWriter_existingFile(t *testing.T) {
+	fakeClock(t, time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC))
+	dir := t.TempDir()
+	name := filepath.Join(dir, `app`)
+	if err := os.WriteFile(name, []byte("existing\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	w := NewWriter(name, WithMaxSize(12))
+	defer w.Close()
+	mustWrite(t, w, "new\n")
+	files := readDir(t, dir)
+	if len(files) != 2 || files[`app`] != "new\n" || files[`app-2023-04-05T00-00-00.000`] != "existing\n" {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_interval(t *testing.T) {
+	now := fakeClock(t, time.Date(2023, 4, 5, 10, 59, 0, 0, time.UTC))
+	dir := t.TempDir()
+	w := NewWriter(filepath.Join(dir, `app.log`), WithInterval(time.Hour))
+	mustWrite(t, w, "a\n")
+	*now = now.Add(30 * time.Second)
+	mustWrite(t, w, "b\n")
+	*now = now.Add(30 * time.Second)
+	mustWrite(t, w, "c\n")
+	*now = now.Add(2 * time.Hour)
+	mustWrite(t, w, "d\n")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files := readDir(t, dir)
+	if keys := sortedKeys(files); !slices.Equal(keys, []string{
+		`app-2023-04-05T11-00-00.000.log`,
+		`app-2023-04-05T13-00-00.000.log`,
+		`app.log`,
+	}) {
+		t.Fatalf("unexpected files: %q", keys)
+	}
+	if files[`app-2023-04-05T11-00-00.000.log`] != "a\nb\n" ||
+		files[`app-2023-04-05T13-00-00.000.log`] != "c\n" ||
+		files[`app.log`] != "d\n" {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_compressAndRetention(t *testing.T) {
+	now := fakeClock(t, time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC))
+	dir := t.TempDir()
+	name := filepath.Join(dir, `app.log`)
+
+	// old backups, one of which is unrelated, and one of which has an interrupted compression
+	for _, v := range [...]string{
+		`app-2023-04-01T00-00-00.000.log`,
+		`app-2023-04-04T00-00-00.000.log`,
+		`app-2023-04-04T00-00-00.000.log.gz`,
+		`other-2023-04-01T00-00-00.000.log`,
+		`app-invalid.log`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, v), []byte(v), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var errs []error
+	w := NewWriter(name,
+		WithMaxSize(1),
+		WithCompress(true),
+		WithMaxAge(48*time.Hour),
+		WithMaxBackups(3),
+		WithErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+	for i := range 4 {
+		*now = now.Add(time.Second)
+		mustWrite(t, w, string(rune('a'+i)))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if errs != nil {
+		t.Fatal(errs)
+	}
+
+	files := readDir(t, dir)
+	if keys := sortedKeys(files); !slices.Equal(keys, []string{
+		`app-2023-04-05T00-00-02.000.log.gz`,
+		`app-2023-04-05T00-00-03.000.log.gz`,
+		`app-2023-04-05T00-00-04.000.log.gz`,
+		`app-invalid.log`,
+		`app.log`,
+		`other-2023-04-01T00-00-00.000.log`,
+	}) {
+		t.Fatalf("unexpected files: %q", keys)
+	}
+	if files[`app-2023-04-05T00-00-02.000.log.gz`] != `a` ||
+		files[`app-2023-04-05T00-00-04.000.log.gz`] != `c` ||
+		files[`app.log`] != `d` {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_compressInterrupted(t *testing.T) {
+	fakeClock(t, time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC))
+	dir := t.TempDir()
+	for _, v := range [...]string{
+		`app-2023-04-04T00-00-00.000.log`,
+		`app-2023-04-04T00-00-00.000.log.gz`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, v), []byte(`content`), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w := NewWriter(filepath.Join(dir, `app.log`), WithCompress(true))
+	mustWrite(t, w, `x`)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files := readDir(t, dir)
+	if len(files) != 2 || files[`app-2023-04-04T00-00-00.000.log.gz`] != `content` {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, `app.log`)
+	w := NewWriter(name)
+	defer w.Close()
+	mustWrite(t, w, "a\n")
+	// e.g. logrotate
+	if err := os.Rename(name, name+`.1`); err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, "b\n")
+	if err := w.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, "c\n")
+	files := readDir(t, dir)
+	if len(files) != 2 || files[`app.log.1`] != "a\nb\n" || files[`app.log`] != "c\n" {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_Rotate(t *testing.T) {
+	fakeClock(t, time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC))
+	dir := t.TempDir()
+	w := NewWriter(filepath.Join(dir, `app.log`))
+	// nothing to rotate
+	if err := w.Rotate(); err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, "a\n")
+	if err := w.Rotate(); err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, "b\n")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Rotate(); err != ErrClosed {
+		t.Error(err)
+	}
+	if err := w.Reopen(); err != ErrClosed {
+		t.Error(err)
+	}
+	files := readDir(t, dir)
+	if len(files) != 2 || files[`app-2023-04-05T00-00-00.000.log`] != "a\n" || files[`app.log`] != "b\n" {
+		t.Errorf("unexpected files: %q", files)
+	}
+}
+
+func TestWriter_openError(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, `file`)
+	if err := os.WriteFile(file, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	w := NewWriter(filepath.Join(file, `app.log`))
+	defer w.Close()
+	if _, err := w.Write([]byte(`a`)); err == nil || errors.Is(err, ErrClosed) {
+		t.Error(err)
+	}
+}
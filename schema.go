@@ -0,0 +1,279 @@
+package logiface
+
+import (
+	"strconv"
+	"time"
+)
+
+type (
+	// FieldSchema configures the keys and encodings used by the fallback
+	// behavior, i.e. where the Event does not implement the relevant optional
+	// method (e.g. Event.AddMessage, Event.AddTime). The zero value of each
+	// field is the default, which is documented per field.
+	//
+	// See also [WithFieldSchema], [ECSFieldSchema], and [OTelFieldSchema].
+	FieldSchema struct {
+		// MessageKey is the key used for the log message, defaulting to "msg".
+		MessageKey string
+
+		// ErrorKey is the key used by [Builder.Err], defaulting to "err". The
+		// value is the error message, per [Builder.Str].
+		ErrorKey string
+
+		// LimitedKey is the key of the object added to events that have
+		// triggered a rate limit, see [Builder.Limit], defaulting to
+		// "_limited".
+		LimitedKey string
+
+		// LimitedCategoryKey is the key, within the LimitedKey object, of the
+		// object identifying the rate limit category, defaulting to
+		// "category".
+		LimitedCategoryKey string
+
+		// LimitedNextKey is the key, within the LimitedKey object, of the time
+		// that the next event will be allowed, defaulting to "next".
+		LimitedNextKey string
+
+		// LimitedUntilKey is the key, within the LimitedKey object, of the
+		// duration until the next event will be allowed, defaulting to
+		// "until".
+		LimitedUntilKey string
+
+		// TimeFormat is the encoding used for time.Time values.
+		TimeFormat TimeFormat
+
+		// DurationFormat is the encoding used for time.Duration values.
+		DurationFormat DurationFormat
+
+		// Int64Format is the encoding used for int64 and uint64 values.
+		Int64Format Int64Format
+	}
+
+	// TimeFormat models the fallback encoding of time.Time values, see
+	// [FieldSchema].
+	TimeFormat int
+
+	// DurationFormat models the fallback encoding of time.Duration values,
+	// see [FieldSchema].
+	DurationFormat int
+
+	// Int64Format models the fallback encoding of int64 and uint64 values,
+	// see [FieldSchema].
+	Int64Format int
+)
+
+const (
+	// TimeFormatDefault encodes as a string, in the same manner as
+	// Protobuf's JSON encoding, i.e. RFC 3339, normalized to UTC, with 0, 3,
+	// 6, or 9 fractional digits.
+	TimeFormatDefault TimeFormat = iota
+	// TimeFormatRFC3339Nano encodes as a string, per time.RFC3339Nano,
+	// preserving the time zone offset.
+	TimeFormatRFC3339Nano
+	// TimeFormatUnix encodes as the number of seconds since the Unix epoch,
+	// per Int64Format.
+	TimeFormatUnix
+	// TimeFormatUnixMilli encodes as the number of milliseconds since the
+	// Unix epoch, per Int64Format.
+	TimeFormatUnixMilli
+	// TimeFormatUnixNano encodes as the number of nanoseconds since the Unix
+	// epoch, per Int64Format.
+	TimeFormatUnixNano
+)
+
+const (
+	// DurationFormatDefault encodes as a string, in the same manner as
+	// Protobuf's JSON encoding, e.g. "1.5s".
+	DurationFormatDefault DurationFormat = iota
+	// DurationFormatNanoseconds encodes as an integer number of nanoseconds,
+	// per Int64Format.
+	DurationFormatNanoseconds
+	// DurationFormatSeconds encodes as a (floating point) number of seconds.
+	DurationFormatSeconds
+)
+
+const (
+	// Int64FormatDefault encodes as a decimal string, in the same manner as
+	// Protobuf's JSON encoding, which avoids loss of precision in consumers
+	// that use float64 for all numbers.
+	Int64FormatDefault Int64Format = iota
+	// Int64FormatNumber passes the value through to Event.AddField.
+	Int64FormatNumber
+)
+
+// WithFieldSchema configures the keys and encodings used by the logger, when
+// falling back to the default behavior, see [FieldSchema].
+//
+// See also LoggerFactory.WithFieldSchema and L (an instance of
+// LoggerFactory[Event]{}).
+func WithFieldSchema[E Event](schema FieldSchema) Option[E] {
+	return optionFunc[E](func(c *loggerConfig[E]) {
+		if schema == (FieldSchema{}) {
+			c.schema = nil
+		} else {
+			c.schema = &schema
+		}
+	})
+}
+
+// WithFieldSchema is an alias of the package function of the same name.
+func (LoggerFactory[E]) WithFieldSchema(schema FieldSchema) Option[E] {
+	return WithFieldSchema[E](schema)
+}
+
+// ECSFieldSchema returns a [FieldSchema] preset, following the Elastic Common
+// Schema (ECS), e.g. "message", "error.message", and durations in
+// nanoseconds, as per "event.duration". The rate limit warning, which ECS
+// doesn't define, is nested under "log", with the category as "origin", per
+// "log.origin".
+func ECSFieldSchema() FieldSchema {
+	return FieldSchema{
+		MessageKey:         `message`,
+		ErrorKey:           `error.message`,
+		LimitedKey:         `log.rate_limit`,
+		LimitedCategoryKey: `origin`,
+		LimitedNextKey:     `next`,
+		LimitedUntilKey:    `until`,
+		TimeFormat:         TimeFormatRFC3339Nano,
+		DurationFormat:     DurationFormatNanoseconds,
+		Int64Format:        Int64FormatNumber,
+	}
+}
+
+// OTelFieldSchema returns a [FieldSchema] preset, following the OpenTelemetry
+// semantic conventions, e.g. "body", "exception.message", and durations in
+// (floating point) seconds. The rate limit warning, which the conventions
+// don't define, is nested under "log", with the category as "code", per the
+// "code.*" attributes.
+func OTelFieldSchema() FieldSchema {
+	return FieldSchema{
+		MessageKey:         `body`,
+		ErrorKey:           `exception.message`,
+		LimitedKey:         `log.rate_limit`,
+		LimitedCategoryKey: `code`,
+		LimitedNextKey:     `next`,
+		LimitedUntilKey:    `until`,
+		TimeFormat:         TimeFormatRFC3339Nano,
+		DurationFormat:     DurationFormatSeconds,
+		Int64Format:        Int64FormatNumber,
+	}
+}
+
+// fieldSchema returns the configured schema, which may be nil
+func (x *Logger[E]) fieldSchema() *FieldSchema {
+	if x != nil && x.shared != nil {
+		return x.shared.schema
+	}
+	return nil
+}
+
+// the below methods are nil-safe, returning the default values
+
+func (x *FieldSchema) messageKey() string {
+	if x != nil && x.MessageKey != `` {
+		return x.MessageKey
+	}
+	return `msg`
+}
+
+func (x *FieldSchema) errorKey() string {
+	if x != nil && x.ErrorKey != `` {
+		return x.ErrorKey
+	}
+	return `err`
+}
+
+func (x *FieldSchema) limitedKey() string {
+	if x != nil && x.LimitedKey != `` {
+		return x.LimitedKey
+	}
+	return `_limited`
+}
+
+func (x *FieldSchema) limitedCategoryKey() string {
+	if x != nil && x.LimitedCategoryKey != `` {
+		return x.LimitedCategoryKey
+	}
+	return `category`
+}
+
+func (x *FieldSchema) limitedNextKey() string {
+	if x != nil && x.LimitedNextKey != `` {
+		return x.LimitedNextKey
+	}
+	return `next`
+}
+
+func (x *FieldSchema) limitedUntilKey() string {
+	if x != nil && x.LimitedUntilKey != `` {
+		return x.LimitedUntilKey
+	}
+	return `until`
+}
+
+func (x *FieldSchema) timeFormat() TimeFormat {
+	if x != nil {
+		return x.TimeFormat
+	}
+	return TimeFormatDefault
+}
+
+func (x *FieldSchema) durationFormat() DurationFormat {
+	if x != nil {
+		return x.DurationFormat
+	}
+	return DurationFormatDefault
+}
+
+func (x *FieldSchema) int64Format() Int64Format {
+	if x != nil {
+		return x.Int64Format
+	}
+	return Int64FormatDefault
+}
+
+// fallbackTime adds val per FieldSchema.TimeFormat
+func (x modifierMethods[E]) fallbackTime(event E, key string, val time.Time) {
+	switch x.schema.timeFormat() {
+	case TimeFormatRFC3339Nano:
+		x.str(event, key, val.Format(time.RFC3339Nano))
+	case TimeFormatUnix:
+		x.int64(event, key, val.Unix())
+	case TimeFormatUnixMilli:
+		x.int64(event, key, val.UnixMilli())
+	case TimeFormatUnixNano:
+		x.int64(event, key, val.UnixNano())
+	default:
+		x.str(event, key, formatTimestamp(val))
+	}
+}
+
+// fallbackDur adds val per FieldSchema.DurationFormat
+func (x modifierMethods[E]) fallbackDur(event E, key string, val time.Duration) {
+	switch x.schema.durationFormat() {
+	case DurationFormatNanoseconds:
+		x.int64(event, key, int64(val))
+	case DurationFormatSeconds:
+		x.float64(event, key, val.Seconds())
+	default:
+		x.str(event, key, formatDuration(val))
+	}
+}
+
+// fallbackInt64 adds val per FieldSchema.Int64Format
+func (x modifierMethods[E]) fallbackInt64(event E, key string, val int64) {
+	if x.schema.int64Format() == Int64FormatNumber {
+		event.AddField(key, val)
+	} else {
+		x.str(event, key, strconv.FormatInt(val, 10))
+	}
+}
+
+// fallbackUint64 adds val per FieldSchema.Int64Format
+func (x modifierMethods[E]) fallbackUint64(event E, key string, val uint64) {
+	if x.schema.int64Format() == Int64FormatNumber {
+		event.AddField(key, val)
+	} else {
+		x.str(event, key, strconv.FormatUint(val, 10))
+	}
+}
@@ -0,0 +1,113 @@
+package logiface
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWithFieldSchema(t *testing.T) {
+	ts := time.Unix(0, 1616592449876543213).In(time.FixedZone(`AEST`, 10*60*60))
+
+	for _, tc := range [...]struct {
+		name   string
+		schema FieldSchema
+		output string
+	}{
+		{
+			name:   `default`,
+			output: "[info] ts=(string)2021-03-24T13:27:29.876543213Z ctx=(string)1s a=[(string)1s] err=(string)some error i=(string)-5 u=(string)6 d=(string)1.500s o=(map[string]interface {})map[t:2021-03-24T13:27:29.876543213Z] msg=(string)some message\n",
+		},
+		{
+			name:   `ecs`,
+			schema: ECSFieldSchema(),
+			output: "[info] ts=(string)2021-03-24T23:27:29.876543213+10:00 ctx=(int64)1000000000 a=[(int64)1000000000] error.message=(string)some error i=(int64)-5 u=(uint64)6 d=(int64)1500000000 o=(map[string]interface {})map[t:2021-03-24T23:27:29.876543213+10:00] message=(string)some message\n",
+		},
+		{
+			name:   `otel`,
+			schema: OTelFieldSchema(),
+			output: "[info] ts=(string)2021-03-24T23:27:29.876543213+10:00 ctx=(float64)1 a=[(float64)1] exception.message=(string)some error i=(int64)-5 u=(uint64)6 d=(float64)1.5 o=(map[string]interface {})map[t:2021-03-24T23:27:29.876543213+10:00] body=(string)some message\n",
+		},
+		{
+			name: `unix millis`,
+			schema: FieldSchema{
+				TimeFormat: TimeFormatUnixMilli,
+			},
+			output: "[info] ts=(string)1616592449876 ctx=(string)1s a=[(string)1s] err=(string)some error i=(string)-5 u=(string)6 d=(string)1.500s o=(map[string]interface {})map[t:1616592449876] msg=(string)some message\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := New(
+				mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+				mockL.WithWriter(&mockSimpleWriter{Writer: &buf, Type: true}),
+				mockL.WithFieldSchema(tc.schema),
+				mockL.WithTimestamp(`ts`),
+				mockL.WithClock(func() time.Time { return ts }),
+			)
+			l.Clone().Dur(`ctx`, time.Second).Array().Dur(time.Second).As(`a`).End().Logger().Info().
+				Err(errors.New(`some error`)).
+				Int64(`i`, -5).
+				Uint64(`u`, 6).
+				Dur(`d`, time.Millisecond*1500).
+				ObjectFunc(`o`, func(b *ObjectBuilder[*mockSimpleEvent, *Chain[*mockSimpleEvent, *Builder[*mockSimpleEvent]]]) {
+					b.Time(`t`, ts)
+				}).
+				Log(`some message`)
+			if s := buf.String(); s != tc.output {
+				t.Errorf("unexpected output: %q\n%s", s, s)
+			}
+		})
+	}
+}
+
+func TestFieldSchema_presets(t *testing.T) {
+	for name, schema := range map[string]FieldSchema{
+		`ecs`:  ECSFieldSchema(),
+		`otel`: OTelFieldSchema(),
+	} {
+		v := reflect.ValueOf(schema)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).IsZero() {
+				t.Errorf(`%s: %s is unset`, name, v.Type().Field(i).Name)
+			}
+		}
+	}
+}
+
+func TestWithFieldSchema_limited(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	w := mockComplexWriter{}
+	logger := New[*mockComplexEvent](
+		WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+		WithWriter[*mockComplexEvent](&w),
+		WithCategoryRateLimits[*mockComplexEvent](map[time.Duration]int{time.Hour: 1}),
+		WithClock[*mockComplexEvent](func() time.Time { return epoch }),
+		WithFieldSchema[*mockComplexEvent](FieldSchema{
+			LimitedKey:         `rate_limited`,
+			LimitedCategoryKey: `caller`,
+			LimitedNextKey:     `resume_at`,
+			LimitedUntilKey:    `resume_in`,
+		}),
+	)
+	logger.Info().Limit().Log(`test`)
+	if len(w.events) != 1 {
+		t.Fatal(len(w.events))
+	}
+	var limited map[string]any
+	for _, field := range w.events[0].FieldValues {
+		if field.Key == `rate_limited` {
+			limited, _ = field.Value.(map[string]any)
+		}
+	}
+	if len(limited) != 3 {
+		t.Fatalf(`unexpected limited: %#v`, limited)
+	}
+	for _, key := range [...]string{`caller`, `resume_at`, `resume_in`} {
+		if _, ok := limited[key]; !ok {
+			t.Errorf(`missing key %q: %#v`, key, limited)
+		}
+	}
+}
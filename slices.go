@@ -0,0 +1,210 @@
+package logiface
+
+import (
+	"slices"
+	"time"
+)
+
+// Implementations of the typed slice field methods (e.g. Strs), which are
+// shared between Context, Builder, and ObjectBuilder.
+//
+// Each will use the relevant Event method (e.g. Event.AddStrings), if
+// available, otherwise falling back to adding an array (see ArrayBuilder),
+// using the equivalent (single value) method, for each element.
+
+// sliceArray adds val as an array, to parent, calling elem for each element
+func sliceArray[E Event, P Parent[E], V any](parent P, key string, val []V, elem func(b *ArrayBuilder[E, P], v V) *ArrayBuilder[E, P]) {
+	b := ArrayWithKey[E](parent, key)
+	for _, v := range val {
+		elem(b, v)
+	}
+	b.As(key)
+}
+
+func builderSliceField[E Event, V any](x *Builder[E], key string, val []V, add func(event E, key string, val []V) bool, elem func(b *ArrayBuilder[E, *Builder[E]], v V) *ArrayBuilder[E, *Builder[E]]) *Builder[E] {
+	if x.Enabled() && x.Event.Level().Enabled() && !add(x.Event, key, val) {
+		sliceArray(x, key, val, elem)
+	}
+	return x
+}
+
+func contextSliceField[E Event, V any](x *Context[E], key string, val []V, add func(event E, key string, val []V) bool, elem func(b *ArrayBuilder[E, *Context[E]], v V) *ArrayBuilder[E, *Context[E]]) *Context[E] {
+	if x.Enabled() {
+		// snapshot val, so the native and fallback paths see the same data,
+		// even if the caller modifies it
+		val = slices.Clone(val)
+		// the fallback array is built once, and is only used if the event
+		// doesn't support the slice type
+		b := ArrayWithKey[E](x, key)
+		for _, v := range val {
+			elem(b, v)
+		}
+		arr := b.b.(*contextFieldData[E])
+		arr.shared = x.logger.shared
+		refPoolPut((*refPoolItem)(b))
+		x.add(func(event E) error {
+			if !event.Level().Enabled() {
+				return ErrDisabled
+			}
+			if add(event, key, val) {
+				return nil
+			}
+			return arr.array(event)
+		})
+	}
+	return x
+}
+
+// objectSliceField uses Parent[E] as the parent type of the array, as using
+// the receiver's type would be an instantiation cycle
+func objectSliceField[E Event, P Parent[E], V any](x *ObjectBuilder[E, P], key string, val []V, elem func(b *ArrayBuilder[E, Parent[E]], v V) *ArrayBuilder[E, Parent[E]]) *ObjectBuilder[E, P] {
+	if x.Enabled() {
+		sliceArray[E, Parent[E]](x, key, val, elem)
+	}
+	return x
+}
+
+// Strs adds a []string as a structured log field, using Event.AddStrings if
+// available, otherwise falling back to an array, per [Context.Str].
+func (x *Context[E]) Strs(key string, val []string) *Context[E] {
+	return contextSliceField(x, key, val, E.AddStrings, (*ArrayBuilder[E, *Context[E]]).Str)
+}
+
+// Strs adds a []string as a structured log field, using Event.AddStrings if
+// available, otherwise falling back to an array, per [Builder.Str].
+func (x *Builder[E]) Strs(key string, val []string) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddStrings, (*ArrayBuilder[E, *Builder[E]]).Str)
+}
+
+// Strs adds a []string as an array, to the object, per [ArrayBuilder.Str].
+func (x *ObjectBuilder[E, P]) Strs(key string, val []string) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Str)
+}
+
+// Ints adds a []int as a structured log field, using Event.AddInts if
+// available, otherwise falling back to an array, per [Context.Int].
+func (x *Context[E]) Ints(key string, val []int) *Context[E] {
+	return contextSliceField(x, key, val, E.AddInts, (*ArrayBuilder[E, *Context[E]]).Int)
+}
+
+// Ints adds a []int as a structured log field, using Event.AddInts if
+// available, otherwise falling back to an array, per [Builder.Int].
+func (x *Builder[E]) Ints(key string, val []int) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddInts, (*ArrayBuilder[E, *Builder[E]]).Int)
+}
+
+// Ints adds a []int as an array, to the object, per [ArrayBuilder.Int].
+func (x *ObjectBuilder[E, P]) Ints(key string, val []int) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Int)
+}
+
+// Int64s adds a []int64 as a structured log field, using Event.AddInt64s if
+// available, otherwise falling back to an array, per [Context.Int64].
+func (x *Context[E]) Int64s(key string, val []int64) *Context[E] {
+	return contextSliceField(x, key, val, E.AddInt64s, (*ArrayBuilder[E, *Context[E]]).Int64)
+}
+
+// Int64s adds a []int64 as a structured log field, using Event.AddInt64s if
+// available, otherwise falling back to an array, per [Builder.Int64].
+func (x *Builder[E]) Int64s(key string, val []int64) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddInt64s, (*ArrayBuilder[E, *Builder[E]]).Int64)
+}
+
+// Int64s adds a []int64 as an array, to the object, per [ArrayBuilder.Int64].
+func (x *ObjectBuilder[E, P]) Int64s(key string, val []int64) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Int64)
+}
+
+// Float64s adds a []float64 as a structured log field, using
+// Event.AddFloat64s if available, otherwise falling back to an array, per
+// [Context.Float64].
+func (x *Context[E]) Float64s(key string, val []float64) *Context[E] {
+	return contextSliceField(x, key, val, E.AddFloat64s, (*ArrayBuilder[E, *Context[E]]).Float64)
+}
+
+// Float64s adds a []float64 as a structured log field, using
+// Event.AddFloat64s if available, otherwise falling back to an array, per
+// [Builder.Float64].
+func (x *Builder[E]) Float64s(key string, val []float64) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddFloat64s, (*ArrayBuilder[E, *Builder[E]]).Float64)
+}
+
+// Float64s adds a []float64 as an array, to the object, per
+// [ArrayBuilder.Float64].
+func (x *ObjectBuilder[E, P]) Float64s(key string, val []float64) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Float64)
+}
+
+// Bools adds a []bool as a structured log field, using Event.AddBools if
+// available, otherwise falling back to an array, per [Context.Bool].
+func (x *Context[E]) Bools(key string, val []bool) *Context[E] {
+	return contextSliceField(x, key, val, E.AddBools, (*ArrayBuilder[E, *Context[E]]).Bool)
+}
+
+// Bools adds a []bool as a structured log field, using Event.AddBools if
+// available, otherwise falling back to an array, per [Builder.Bool].
+func (x *Builder[E]) Bools(key string, val []bool) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddBools, (*ArrayBuilder[E, *Builder[E]]).Bool)
+}
+
+// Bools adds a []bool as an array, to the object, per [ArrayBuilder.Bool].
+func (x *ObjectBuilder[E, P]) Bools(key string, val []bool) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Bool)
+}
+
+// Durs adds a []time.Duration as a structured log field, using
+// Event.AddDurations if available, otherwise falling back to an array, per
+// [Context.Dur].
+func (x *Context[E]) Durs(key string, val []time.Duration) *Context[E] {
+	return contextSliceField(x, key, val, E.AddDurations, (*ArrayBuilder[E, *Context[E]]).Dur)
+}
+
+// Durs adds a []time.Duration as a structured log field, using
+// Event.AddDurations if available, otherwise falling back to an array, per
+// [Builder.Dur].
+func (x *Builder[E]) Durs(key string, val []time.Duration) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddDurations, (*ArrayBuilder[E, *Builder[E]]).Dur)
+}
+
+// Durs adds a []time.Duration as an array, to the object, per
+// [ArrayBuilder.Dur].
+func (x *ObjectBuilder[E, P]) Durs(key string, val []time.Duration) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Dur)
+}
+
+// Times adds a []time.Time as a structured log field, using Event.AddTimes
+// if available, otherwise falling back to an array, per [Context.Time].
+func (x *Context[E]) Times(key string, val []time.Time) *Context[E] {
+	return contextSliceField(x, key, val, E.AddTimes, (*ArrayBuilder[E, *Context[E]]).Time)
+}
+
+// Times adds a []time.Time as a structured log field, using Event.AddTimes
+// if available, otherwise falling back to an array, per [Builder.Time].
+func (x *Builder[E]) Times(key string, val []time.Time) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddTimes, (*ArrayBuilder[E, *Builder[E]]).Time)
+}
+
+// Times adds a []time.Time as an array, to the object, per
+// [ArrayBuilder.Time].
+func (x *ObjectBuilder[E, P]) Times(key string, val []time.Time) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Time)
+}
+
+// Errs adds a []error as a structured log field, using Event.AddErrors if
+// available, otherwise falling back to an array, per [ArrayBuilder.Err].
+// Unlike [Context.Err], the key is not determined by the implementation.
+func (x *Context[E]) Errs(key string, val []error) *Context[E] {
+	return contextSliceField(x, key, val, E.AddErrors, (*ArrayBuilder[E, *Context[E]]).Err)
+}
+
+// Errs adds a []error as a structured log field, using Event.AddErrors if
+// available, otherwise falling back to an array, per [ArrayBuilder.Err].
+// Unlike [Builder.Err], the key is not determined by the implementation.
+func (x *Builder[E]) Errs(key string, val []error) *Builder[E] {
+	return builderSliceField(x, key, val, E.AddErrors, (*ArrayBuilder[E, *Builder[E]]).Err)
+}
+
+// Errs adds a []error as an array, to the object, per [ArrayBuilder.Err].
+func (x *ObjectBuilder[E, P]) Errs(key string, val []error) *ObjectBuilder[E, P] {
+	return objectSliceField(x, key, val, (*ArrayBuilder[E, Parent[E]]).Err)
+}
@@ -0,0 +1,165 @@
+package logiface
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func ExampleBuilder_Strs() {
+	type E = *mockSimpleEvent
+	var logger *Logger[E] = mockL.New(
+		mockL.WithEventFactory(NewEventFactoryFunc(mockSimpleEventFactory)),
+		mockL.WithWriter(&mockSimpleWriter{Writer: os.Stdout, MultiLine: true, JSON: true}),
+	)
+
+	// the event doesn't implement AddStrings etc., so the fallback is used
+	logger = logger.Clone().
+		Ints(`ints`, []int{1, 2, 3}).
+		Logger()
+
+	logger.Info().
+		Strs(`strs`, []string{`a`, `b`}).
+		Durs(`durs`, []time.Duration{time.Second, time.Millisecond * 1500}).
+		Object().
+		Bools(`bools`, []bool{true, false}).
+		Times(`times`, []time.Time{time.Unix(0, 0).UTC()}).
+		As(`obj`).
+		End().
+		Log(`msg 1`)
+
+	// the context's fallback array is reused, for each event
+	logger.Info().Log(`msg 2`)
+
+	//output:
+	//[info]
+	//ints=[1,2,3]
+	//strs=["a","b"]
+	//durs=["1s","1.500s"]
+	//obj={"bools":[true,false],"times":["1970-01-01T00:00:00Z"]}
+	//msg="msg 1"
+	//[info]
+	//ints=[1,2,3]
+	//msg="msg 2"
+}
+
+func TestContext_slices_snapshot(t *testing.T) {
+	strs := []string{`a`, `b`}
+	ints := []int{1, 2}
+
+	var buf bytes.Buffer
+	simple := newSimpleLoggerPrintTypes(&buf, false).Clone().
+		Strs(`strs`, strs).
+		Ints(`ints`, ints).
+		Logger()
+
+	var w mockComplexWriter
+	complex := New[*mockComplexEvent](
+		WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+		WithWriter[*mockComplexEvent](&w),
+	).Clone().
+		Strs(`strs`, strs).
+		Ints(`ints`, ints).
+		Logger()
+
+	// both the native and fallback paths must be unaffected
+	strs[0] = `modified`
+	ints[0] = -1
+
+	simple.Info().Log(``)
+	if s := buf.String(); s != "[info] strs=[(string)a (string)b] ints=[(int)1 (int)2]\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+
+	complex.Info().Log(``)
+	if len(w.events) != 1 {
+		t.Fatal(w.events)
+	}
+	if v := w.events[0].FieldValues; !reflect.DeepEqual(v, []mockComplexEventField{
+		{Type: `AddStrings`, Key: `strs`, Value: []string{`a`, `b`}},
+		{Type: `AddInts`, Key: `ints`, Value: []int{1, 2}},
+	}) {
+		t.Errorf("unexpected fields: %#v", v)
+	}
+}
+
+// logSlices logs one of each slice type, including nil and empty slices
+func logSlices[E Event](b *Builder[E]) {
+	b.Strs(`strs`, []string{`a`}).
+		Ints(`ints`, []int{1}).
+		Int64s(`int64s`, []int64{2}).
+		Float64s(`float64s`, []float64{0.5}).
+		Bools(`bools`, []bool{true}).
+		Durs(`durs`, []time.Duration{time.Second}).
+		Times(`times`, []time.Time{time.Unix(0, 0).UTC()}).
+		Errs(`errs`, []error{errors.New(`some error`), nil}).
+		Strs(`nil`, nil).
+		Ints(`empty`, []int{}).
+		Log(``)
+}
+
+func TestBuilder_slices(t *testing.T) {
+	t.Run(`native`, func(t *testing.T) {
+		var w mockComplexWriter
+		logSlices(New[*mockComplexEvent](
+			WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+			WithWriter[*mockComplexEvent](&w),
+		).Info())
+		if len(w.events) != 1 {
+			t.Fatal(w.events)
+		}
+		if v := w.events[0].FieldValues; !reflect.DeepEqual(v, []mockComplexEventField{
+			{Type: `AddStrings`, Key: `strs`, Value: []string{`a`}},
+			{Type: `AddInts`, Key: `ints`, Value: []int{1}},
+			{Type: `AddInt64s`, Key: `int64s`, Value: []int64{2}},
+			{Type: `AddFloat64s`, Key: `float64s`, Value: []float64{0.5}},
+			{Type: `AddBools`, Key: `bools`, Value: []bool{true}},
+			{Type: `AddDurations`, Key: `durs`, Value: []time.Duration{time.Second}},
+			{Type: `AddTimes`, Key: `times`, Value: []time.Time{time.Unix(0, 0).UTC()}},
+			{Type: `AddErrors`, Key: `errs`, Value: []error{errors.New(`some error`), nil}},
+			{Type: `AddStrings`, Key: `nil`, Value: []string(nil)},
+			{Type: `AddInts`, Key: `empty`, Value: []int{}},
+		}) {
+			t.Errorf("unexpected fields: %#v", v)
+		}
+	})
+
+	t.Run(`fallback`, func(t *testing.T) {
+		var buf bytes.Buffer
+		logSlices(newSimpleLoggerPrintTypes(&buf, false).Info())
+		if s := buf.String(); s != "[info] strs=[(string)a] ints=[(int)1] int64s=[(string)2] float64s=[(float64)0.5] bools=[(bool)true] durs=[(string)1s] times=[(string)1970-01-01T00:00:00Z] errs=[(string)some error (<nil>)<nil>] nil=[] empty=[]\n" {
+			t.Errorf("unexpected output: %q\n%s", s, s)
+		}
+	})
+}
+
+func TestObjectBuilder_slices(t *testing.T) {
+	// objects always use the fallback, even if the event supports the type
+	var w mockComplexWriter
+	New[*mockComplexEvent](
+		WithEventFactory[*mockComplexEvent](EventFactoryFunc[*mockComplexEvent](mockComplexEventFactory)),
+		WithWriter[*mockComplexEvent](&w),
+	).Info().
+		Object().
+		Strs(`strs`, []string{`a`, `b`}).
+		Ints(`nil`, nil).
+		Bools(`empty`, []bool{}).
+		As(`obj`).
+		End().
+		Log(``)
+	if len(w.events) != 1 {
+		t.Fatal(w.events)
+	}
+	if v := w.events[0].FieldValues; !reflect.DeepEqual(v, []mockComplexEventField{
+		{Type: `AddField`, Key: `obj`, Value: map[string]any{
+			`strs`:  []any{`a`, `b`},
+			`nil`:   []any{},
+			`empty`: []any{},
+		}},
+	}) {
+		t.Errorf("unexpected fields: %#v", v)
+	}
+}
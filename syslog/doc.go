@@ -0,0 +1,17 @@
+// Package syslog implements a logiface logger, which writes events to a
+// syslog daemon or collector, without any dependencies outside this module.
+//
+// Events may be formatted per RFC 5424 (the default), in which case fields are
+// written as STRUCTURED-DATA, or per RFC 3164 (BSD syslog), in which case
+// fields are appended to the message, using the same SD-PARAM syntax, i.e.
+// key="value". As logiface levels are the syslog severities, they map
+// directly, with the exception of [logiface.LevelTrace], and custom levels,
+// which are written with the debug severity.
+//
+// Supported transports are unix sockets (e.g. /dev/log, the default), UDP, and
+// TCP, see [WithDial]. Messages written to TCP use octet-counting framing,
+// per RFC 6587, while messages written to unix stream sockets are terminated
+// by a newline, as expected by local syslog daemons. The connection is
+// established on
+// first write, and re-established on failure, see [Logger.Write].
+package syslog
@@ -0,0 +1,187 @@
+package syslog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/joeycumines/logiface"
+	"github.com/joeycumines/logiface/internal/pbtime"
+	"strconv"
+	"time"
+)
+
+type (
+	// Event is the [logiface.Event] implementation for this package, see also
+	// [Logger].
+	Event struct {
+		//lint:ignore U1000 embedded for it's methods
+		unimplementedEvent
+
+		// WARNING: if adding fields consider if they may need to be reset when added back to the pool
+		// (e.g. the reference to the logger - slices which are reused and are reset on init are fine)
+
+		// params are the encoded SD-PARAM values, each prefixed by a space
+		params []byte
+		// group is the (encoded) param name prefix, see AddGroup
+		group []byte
+		msg   string
+		time  time.Time
+		lvl   logiface.Level
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	unimplementedEvent = logiface.UnimplementedEvent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.Event = (*Event)(nil)
+)
+
+// Level returns the level of the event, or [logiface.LevelDisabled] if the
+// receiver is nil.
+func (x *Event) Level() logiface.Level {
+	if x == nil {
+		return logiface.LevelDisabled
+	}
+	return x.lvl
+}
+
+func (x *Event) AddField(key string, val any) {
+	var s string
+	switch val := val.(type) {
+	case string:
+		s = val
+	case error:
+		s = fmt.Sprint(val)
+	default:
+		if b, err := json.Marshal(val); err != nil {
+			s = fmt.Sprintf("marshaling error: %v", err)
+		} else {
+			s = string(b)
+		}
+	}
+	x.AddString(key, s)
+}
+
+func (x *Event) AddMessage(msg string) bool {
+	x.msg = msg
+	return true
+}
+
+func (x *Event) AddError(err error) bool {
+	if err != nil {
+		x.AddString(`err`, fmt.Sprint(err))
+	}
+	return true
+}
+
+func (x *Event) AddString(key string, val string) bool {
+	x.appendParamName(key)
+	x.params = appendParamValue(x.params, val)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddInt(key string, val int) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendInt(x.params, int64(val), 10)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddFloat32(key string, val float32) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendFloat(x.params, float64(val), 'g', -1, 32)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddTime(key string, val time.Time) bool {
+	x.appendParamName(key)
+	x.params = pbtime.AppendTimestamp(x.params, val)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddDuration(key string, val time.Duration) bool {
+	x.appendParamName(key)
+	x.params = pbtime.AppendDuration(x.params, val)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddBase64Bytes(key string, val []byte, enc *base64.Encoding) bool {
+	x.appendParamName(key)
+	start := len(x.params)
+	x.params = enc.AppendEncode(x.params, val)
+	if bytes.ContainsAny(x.params[start:], `"\]`) {
+		// custom alphabets may contain characters that must be escaped
+		x.params = appendParamValue(x.params[:start], string(x.params[start:]))
+	}
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddBool(key string, val bool) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendBool(x.params, val)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddFloat64(key string, val float64) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendFloat(x.params, val, 'g', -1, 64)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddInt64(key string, val int64) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendInt(x.params, val, 10)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddUint64(key string, val uint64) bool {
+	x.appendParamName(key)
+	x.params = strconv.AppendUint(x.params, val, 10)
+	x.params = append(x.params, '"')
+	return true
+}
+
+func (x *Event) AddRawJSON(key string, val json.RawMessage) bool {
+	var buf bytes.Buffer
+	if len(val) == 0 {
+		buf.WriteString(`null`)
+	} else if err := json.Compact(&buf, val); err != nil {
+		buf.Reset()
+		buf.Write(val)
+	}
+	return x.AddString(key, buf.String())
+}
+
+// AddGroup prefixes the names of all subsequent params with the group name,
+// and a period.
+func (x *Event) AddGroup(name string) bool {
+	x.group = appendParamName(x.group, name)
+	x.group = append(x.group, '.')
+	return true
+}
+
+// appendParamName appends the param name, and the opening quote of the
+// value.
+func (x *Event) appendParamName(key string) {
+	x.params = append(x.params, ' ')
+	start := len(x.params)
+	x.params = append(x.params, x.group...)
+	x.params = appendParamName(x.params, key)
+	if len(x.params)-start > 32 {
+		// PARAM-NAME is limited to 32 characters
+		x.params = x.params[:start+32]
+	}
+	x.params = append(x.params, '=', '"')
+}
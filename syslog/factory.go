@@ -0,0 +1,258 @@
+package syslog
+
+import (
+	"github.com/joeycumines/logiface"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+type (
+	// LoggerFactory is provided as a convenience, embedding
+	// logiface.LoggerFactory[*Event], and aliasing the (logiface) option
+	// functions implemented within this package.
+	LoggerFactory struct {
+		//lint:ignore U1000 embedded for it's methods
+		baseLoggerFactory
+	}
+
+	//lint:ignore U1000 used to embed without exporting
+	baseLoggerFactory = logiface.LoggerFactory[*Event]
+
+	// Option models a configuration option for this package's logger, see also
+	// the package level functions, returning values of this type.
+	Option interface {
+		apply(c *loggerConfig)
+	}
+
+	optionFunc func(c *loggerConfig)
+
+	loggerConfig struct {
+		network  string
+		address  string
+		format   Format
+		facility Facility
+		hostname *string
+		appName  *string
+		procID   *string
+		msgID    string
+		sdID     *string
+		timeout  time.Duration
+	}
+
+	// Format is the syslog message format, see [WithFormat].
+	Format int
+
+	// Facility is the syslog facility, see [WithFacility].
+	Facility int
+)
+
+const (
+	// RFC5424 is the format defined by RFC 5424, and is the default.
+	RFC5424 Format = iota
+	// RFC3164 is the (legacy) BSD syslog format, defined by RFC 3164.
+	RFC3164
+)
+
+// Facility values, as defined by RFC 5424.
+const (
+	Kern Facility = iota
+	User
+	Mail
+	Daemon
+	Auth
+	Syslog
+	LPR
+	News
+	UUCP
+	Cron
+	AuthPriv
+	FTP
+	NTP
+	Audit
+	Alert
+	Clock
+	Local0
+	Local1
+	Local2
+	Local3
+	Local4
+	Local5
+	Local6
+	Local7
+)
+
+const (
+	// DefaultStructuredDataID is the default SD-ID, used to write fields as
+	// RFC 5424 STRUCTURED-DATA. It uses the private enterprise number
+	// reserved for documentation purposes, by RFC 5612.
+	DefaultStructuredDataID = `fields@32473`
+
+	// DefaultTimeout is the default timeout for dialing, and writing.
+	DefaultTimeout = 10 * time.Second
+)
+
+var (
+	// compile time assertions
+
+	_ Option = optionFunc(nil)
+)
+
+var (
+	// L is a LoggerFactory, and may be used to configure a
+	// logiface.Logger[*Event], using the implementations provided by this
+	// package.
+	L = LoggerFactory{}
+)
+
+func (x optionFunc) apply(c *loggerConfig) { x(c) }
+
+// WithSyslog configures a logiface logger to write to syslog, using the
+// implementations provided by this package.
+//
+// By default, events are written to the local syslog daemon, via /dev/log,
+// with the [User] facility, formatted per RFC 5424.
+func WithSyslog(options ...Option) logiface.Option[*Event] {
+	l := NewLogger(options...)
+	return L.WithOptions(
+		L.WithWriter(l),
+		L.WithEventFactory(l),
+		L.WithEventReleaser(l),
+	)
+}
+
+// WithSyslog is an alias of the package function of the same name.
+func (LoggerFactory) WithSyslog(options ...Option) logiface.Option[*Event] {
+	return WithSyslog(options...)
+}
+
+// NewLogger initializes a new [Logger], which implements the
+// [logiface.EventFactory], [logiface.EventReleaser], and [logiface.Writer]
+// interfaces. Most users should prefer [WithSyslog].
+//
+// The connection will be established on first write, see also [Logger.Close].
+func NewLogger(options ...Option) *Logger {
+	c := loggerConfig{
+		facility: User,
+		timeout:  DefaultTimeout,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+
+	l := Logger{
+		network:  c.network,
+		address:  c.address,
+		format:   c.format,
+		facility: c.facility,
+		msgID:    sanitizeHeader(c.msgID, 32),
+		timeout:  c.timeout,
+	}
+
+	if c.hostname != nil {
+		l.hostname = *c.hostname
+	} else {
+		l.hostname, _ = os.Hostname()
+	}
+	l.hostname = sanitizeHeader(l.hostname, 255)
+
+	if c.appName != nil {
+		l.appName = *c.appName
+	} else if len(os.Args) != 0 {
+		l.appName = filepath.Base(os.Args[0])
+	}
+	l.appName = sanitizeHeader(l.appName, 48)
+
+	if c.procID != nil {
+		l.procID = *c.procID
+	} else {
+		l.procID = strconv.Itoa(os.Getpid())
+	}
+	l.procID = sanitizeHeader(l.procID, 128)
+
+	if c.sdID != nil {
+		l.sdID = *c.sdID
+	} else {
+		l.sdID = DefaultStructuredDataID
+	}
+	l.sdID = string(appendParamName(nil, l.sdID))
+
+	return &l
+}
+
+// WithDial configures the network and address of the syslog daemon or
+// collector, as accepted by [net.Dial]. Supported networks are "unix",
+// "unixgram", "udp", "udp4", "udp6", "tcp", "tcp4", and "tcp6".
+//
+// If unset, the logger will connect to the first of /dev/log,
+// /var/run/syslog, or /var/run/log, that accepts a unix datagram, or stream,
+// connection.
+func WithDial(network, address string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.network = network
+		c.address = address
+	})
+}
+
+// WithFormat configures the message format, which defaults to [RFC5424].
+func WithFormat(format Format) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.format = format
+	})
+}
+
+// WithFacility configures the facility, which defaults to [User].
+func WithFacility(facility Facility) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.facility = facility
+	})
+}
+
+// WithHostname configures the HOSTNAME, which defaults to [os.Hostname].
+func WithHostname(hostname string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.hostname = &hostname
+	})
+}
+
+// WithAppName configures the APP-NAME (the TAG, for RFC 3164), which
+// defaults to the base name of the executable, per [os.Args].
+func WithAppName(appName string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.appName = &appName
+	})
+}
+
+// WithProcID configures the PROCID, which defaults to the process ID.
+func WithProcID(procID string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.procID = &procID
+	})
+}
+
+// WithMsgID configures the MSGID, which is unset by default.
+// Not used by RFC 3164.
+func WithMsgID(msgID string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.msgID = msgID
+	})
+}
+
+// WithStructuredDataID configures the SD-ID, used to write fields as
+// STRUCTURED-DATA, which defaults to [DefaultStructuredDataID]. Invalid
+// characters are replaced with `_`, and it's truncated to 32 characters.
+// Not used by RFC 3164.
+func WithStructuredDataID(id string) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.sdID = &id
+	})
+}
+
+// WithTimeout configures the timeout for dialing, and each write, which
+// defaults to [DefaultTimeout]. A value <= 0 disables the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(c *loggerConfig) {
+		c.timeout = timeout
+	})
+}
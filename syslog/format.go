@@ -0,0 +1,145 @@
+package syslog
+
+import (
+	"github.com/joeycumines/logiface"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// rfc5424Time is the RFC 5424 TIMESTAMP format, with microsecond
+	// precision, as recommended
+	rfc5424Time = `2006-01-02T15:04:05.000000Z07:00`
+	rfc3164Time = time.Stamp
+)
+
+// severity maps the logiface level to the syslog severity, which is the
+// same value, except for levels above debug.
+func severity(level logiface.Level) int {
+	if level > logiface.LevelDebug {
+		return int(logiface.LevelDebug)
+	}
+	return int(level)
+}
+
+// appendMessage appends the formatted syslog message, without any
+// transport-specific framing.
+func (x *Logger) appendMessage(dst []byte, e *Event) []byte {
+	dst = append(dst, '<')
+	dst = strconv.AppendInt(dst, int64(int(x.facility)*8+severity(e.lvl)), 10)
+	dst = append(dst, '>')
+
+	if x.format == RFC3164 {
+		dst = e.time.AppendFormat(dst, rfc3164Time)
+		dst = append(dst, ' ')
+		dst = appendHeader(dst, x.hostname)
+		dst = append(dst, ' ')
+		dst = append(dst, x.appName...)
+		if x.procID != `` {
+			dst = append(dst, '[')
+			dst = append(dst, x.procID...)
+			dst = append(dst, ']')
+		}
+		dst = append(dst, ':')
+		if e.msg != `` {
+			dst = append(dst, ' ')
+			dst = append(dst, e.msg...)
+		}
+		return append(dst, e.params...)
+	}
+
+	dst = append(dst, '1', ' ')
+	dst = e.time.AppendFormat(dst, rfc5424Time)
+	dst = append(dst, ' ')
+	dst = appendHeader(dst, x.hostname)
+	dst = append(dst, ' ')
+	dst = appendHeader(dst, x.appName)
+	dst = append(dst, ' ')
+	dst = appendHeader(dst, x.procID)
+	dst = append(dst, ' ')
+	dst = appendHeader(dst, x.msgID)
+	dst = append(dst, ' ')
+	if len(e.params) == 0 {
+		dst = append(dst, '-')
+	} else {
+		dst = append(dst, '[')
+		dst = append(dst, x.sdID...)
+		dst = append(dst, e.params...)
+		dst = append(dst, ']')
+	}
+	if e.msg != `` {
+		dst = append(dst, ' ')
+		dst = append(dst, e.msg...)
+	}
+	return dst
+}
+
+// appendHeader appends a (sanitized) header field, or the NILVALUE, if
+// empty.
+func appendHeader(dst []byte, val string) []byte {
+	if val == `` {
+		return append(dst, '-')
+	}
+	return append(dst, val...)
+}
+
+// sanitizeHeader restricts val to PRINTUSASCII, replacing other characters
+// with `_`, and truncates it to at most n characters.
+func sanitizeHeader(val string, n int) string {
+	var b strings.Builder
+	for i := 0; i < len(val) && b.Len() < n; i++ {
+		if c := val[i]; c >= 33 && c <= 126 {
+			b.WriteByte(c)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// appendParamName appends val as an SD-NAME, replacing invalid characters
+// with `_`, and truncating it to 32 characters, as per RFC 5424, see also
+// Event.appendParamName.
+func appendParamName(dst []byte, val string) []byte {
+	if val == `` {
+		return append(dst, '_')
+	}
+	if len(val) > 32 {
+		val = val[:32]
+	}
+	for i := 0; i < len(val); i++ {
+		if c := val[i]; c >= 33 && c <= 126 && c != '=' && c != ']' && c != '"' {
+			dst = append(dst, c)
+		} else {
+			dst = append(dst, '_')
+		}
+	}
+	return dst
+}
+
+// appendParamValue appends val as the (escaped) content of a PARAM-VALUE,
+// replacing invalid UTF-8 with U+FFFD.
+func appendParamValue(dst []byte, val string) []byte {
+	for i := 0; i < len(val); {
+		c := val[i]
+		if c < utf8.RuneSelf {
+			switch c {
+			case '"', '\\', ']':
+				dst = append(dst, '\\')
+			}
+			dst = append(dst, c)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(val[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+		} else {
+			dst = append(dst, val[i:i+size]...)
+		}
+		i += size
+	}
+	return dst
+}
@@ -0,0 +1,208 @@
+package syslog
+
+import (
+	"errors"
+	"github.com/joeycumines/logiface"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// Logger implements [logiface.EventFactory], [logiface.EventReleaser], and
+	// [logiface.Writer], for [Event].
+	// See also [NewLogger], and [WithSyslog].
+	Logger struct {
+		network  string
+		address  string
+		hostname string
+		appName  string
+		procID   string
+		msgID    string
+		sdID     string
+		format   Format
+		facility Facility
+		timeout  time.Duration
+
+		mu      sync.Mutex
+		conn    net.Conn
+		framing framing
+		buf     []byte
+		frame   []byte
+	}
+
+	// framing models how messages are delimited, on the current connection
+	framing int
+)
+
+const (
+	// framingNone is used for datagram transports, one message per datagram
+	framingNone framing = iota
+	// framingOctetCounting is used for TCP, per RFC 6587
+	framingOctetCounting
+	// framingNonTransparent is used for local (unix) stream sockets, which
+	// expect each message to be terminated by a newline
+	framingNonTransparent
+)
+
+var (
+	// compile time assertions
+
+	_ logiface.EventFactory[*Event]  = (*Logger)(nil)
+	_ logiface.EventReleaser[*Event] = (*Logger)(nil)
+	_ logiface.Writer[*Event]        = (*Logger)(nil)
+)
+
+var (
+	eventPool = sync.Pool{New: func() any {
+		return &Event{
+			params: make([]byte, 0, 1<<10),
+		}
+	}}
+	timeNow = time.Now
+
+	// unixPaths are the default paths to the local syslog daemon
+	unixPaths = []string{`/dev/log`, `/var/run/syslog`, `/var/run/log`}
+)
+
+func (x *Logger) NewEvent(level logiface.Level) *Event {
+	e := eventPool.Get().(*Event)
+	e.lvl = level
+	e.time = timeNow()
+	e.msg = ``
+	// note: params and group aren't reset when added back to the pool
+	e.params = e.params[:0]
+	e.group = e.group[:0]
+	return e
+}
+
+// Write formats, then writes the event, establishing the connection, if
+// necessary. If the write fails, the connection will be re-established, and
+// the write retried, once. Note that, as with any network logger, a
+// successful write does not guarantee delivery.
+func (x *Logger) Write(event *Event) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.buf = x.appendMessage(x.buf[:0], event)
+
+	err := x.write(x.buf)
+	if err != nil {
+		err = x.write(x.buf)
+	}
+
+	if cap(x.buf) > 1<<16 {
+		x.buf = nil
+	}
+	if cap(x.frame) > 1<<16 {
+		x.frame = nil
+	}
+
+	return err
+}
+
+func (x *Logger) ReleaseEvent(e *Event) {
+	// sync.Pool depends on each item consuming roughly the same amount of memory
+	if cap(e.params) <= 1<<16 && cap(e.group) <= 1<<10 {
+		// clear references that might need to be garbage collected
+		e.msg = ``
+
+		eventPool.Put(e)
+	}
+}
+
+// Close closes the current connection, if any. The logger may still be
+// used, and will reconnect, on the next write.
+func (x *Logger) Close() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.conn == nil {
+		return nil
+	}
+	err := x.conn.Close()
+	x.conn = nil
+	return err
+}
+
+func (x *Logger) write(msg []byte) (err error) {
+	if x.conn == nil {
+		if err = x.connect(); err != nil {
+			return
+		}
+	}
+
+	if x.timeout > 0 {
+		if err = x.conn.SetWriteDeadline(time.Now().Add(x.timeout)); err != nil {
+			return
+		}
+	}
+
+	switch x.framing {
+	case framingOctetCounting:
+		// octet-counting framing, per RFC 6587
+		x.frame = strconv.AppendInt(x.frame[:0], int64(len(msg)), 10)
+		x.frame = append(x.frame, ' ')
+		x.frame = append(x.frame, msg...)
+		_, err = x.conn.Write(x.frame)
+	case framingNonTransparent:
+		// non-transparent framing, as expected by local syslog daemons, note
+		// that (as with the standard library) newlines aren't escaped
+		x.frame = append(x.frame[:0], msg...)
+		x.frame = append(x.frame, '\n')
+		_, err = x.conn.Write(x.frame)
+	default:
+		_, err = x.conn.Write(msg)
+	}
+
+	if err != nil {
+		_ = x.conn.Close()
+		x.conn = nil
+	}
+
+	return
+}
+
+func (x *Logger) connect() error {
+	if x.network != `` {
+		conn, err := x.dial(x.network, x.address)
+		if err != nil {
+			return err
+		}
+		x.setConn(conn, x.network)
+		return nil
+	}
+
+	var errs []error
+	for _, path := range unixPaths {
+		for _, network := range [...]string{`unixgram`, `unix`} {
+			conn, err := x.dial(network, path)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			x.setConn(conn, network)
+			return nil
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (x *Logger) dial(network, address string) (net.Conn, error) {
+	if x.timeout > 0 {
+		return net.DialTimeout(network, address, x.timeout)
+	}
+	return net.Dial(network, address)
+}
+
+func (x *Logger) setConn(conn net.Conn, network string) {
+	x.conn = conn
+	switch network {
+	case `tcp`, `tcp4`, `tcp6`:
+		x.framing = framingOctetCounting
+	case `unix`:
+		x.framing = framingNonTransparent
+	default:
+		x.framing = framingNone
+	}
+}
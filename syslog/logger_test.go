@@ -0,0 +1,334 @@
+package syslog
+
+import (
+	"bufio"
+	"errors"
+	"github.com/joeycumines/logiface"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fixedTime(t *testing.T) {
+	old := timeNow
+	t.Cleanup(func() { timeNow = old })
+	timeNow = func() time.Time { return time.Date(2023, 4, 5, 11, 21, 19, 496235772, time.UTC) }
+}
+
+func listenUDP(t *testing.T) (net.PacketConn, func() string) {
+	t.Helper()
+	conn, err := net.ListenPacket(`udp`, `127.0.0.1:0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn, func() string {
+		t.Helper()
+		buf := make([]byte, 1<<16)
+		if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(buf[:n])
+	}
+}
+
+// readFrame reads a single octet-counted frame.
+func readFrame(r *bufio.Reader) (string, error) {
+	s, err := r.ReadString(' ')
+	if err != nil {
+		return ``, err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(s, ` `))
+	if err != nil {
+		return ``, err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return ``, err
+	}
+	return string(b), nil
+}
+
+func TestLogger_rfc5424(t *testing.T) {
+	fixedTime(t)
+	conn, read := listenUDP(t)
+
+	logger := L.New(
+		L.WithSyslog(
+			WithDial(`udp`, conn.LocalAddr().String()),
+			WithFacility(Local3),
+			WithHostname(`my host`),
+			WithAppName(`app`),
+			WithProcID(`123`),
+			WithMsgID(`ID47`),
+		),
+		L.WithLevel(logiface.LevelTrace),
+	)
+
+	logger.Info().Log(`hello world`)
+	if s := read(); s != `<158>1 2023-04-05T11:21:19.496235Z my_host app 123 ID47 - hello world` {
+		t.Errorf("unexpected output: %q", s)
+	}
+
+	logger.Err().
+		Str(`a`, `x"y\z]`).
+		Int(`b`, 1).
+		Int64(`c`, -2).
+		Uint64(`d`, 3).
+		Float64(`e`, 1.5).
+		Bool(`f`, true).
+		Dur(`g`, time.Second).
+		Time(`h`, time.Unix(0, 0)).
+		Base64(`i`, []byte(`val 7`), nil).
+		RawJSON(`j`, []byte(`{"k": 1}`)).
+		Any(`k`, []int{1, 2}).
+		Err(errors.New(`some error`)).
+		Str(`bad=name]"`, ``).
+		Str(strings.Repeat(`n`, 40), `long`).
+		Group(`grp`).
+		Str(`l`, `m`).
+		Object().Int(`o`, 1).As(`n`).End().
+		Log(``)
+	if s := read(); s != `<155>1 2023-04-05T11:21:19.496235Z my_host app 123 ID47 [fields@32473`+
+		` a="x\"y\\z\]" b="1" c="-2" d="3" e="1.5" f="true" g="1s" h="1970-01-01T00:00:00Z" i="dmFsIDc="`+
+		` j="{\"k\":1}" k="[1,2\]" err="some error" bad_name__="" nnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnn="long"`+
+		` grp.l="m" grp.n="{\"o\":1}"]` {
+		t.Errorf("unexpected output: %q", s)
+	}
+
+	logger.Trace().Log(`trace`)
+	if s := read(); s != `<159>1 2023-04-05T11:21:19.496235Z my_host app 123 ID47 - trace` {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
+
+func TestLogger_rfc3164(t *testing.T) {
+	fixedTime(t)
+	conn, read := listenUDP(t)
+
+	logger := L.New(L.WithSyslog(
+		WithDial(`udp`, conn.LocalAddr().String()),
+		WithFormat(RFC3164),
+		WithHostname(`host`),
+		WithAppName(`app`),
+		WithProcID(`42`),
+		WithStructuredDataID(`ignored`),
+	))
+
+	logger.Warning().Str(`k`, `v`).Log(`hello`)
+	if s := read(); s != `<12>Apr  5 11:21:19 host app[42]: hello k="v"` {
+		t.Errorf("unexpected output: %q", s)
+	}
+
+	logger.Notice().Log(``)
+	if s := read(); s != `<13>Apr  5 11:21:19 host app[42]:` {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
+
+func TestLogger_tcp(t *testing.T) {
+	fixedTime(t)
+
+	ln, err := net.Listen(`tcp`, `127.0.0.1:0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	logger := L.New(L.WithSyslog(
+		WithDial(`tcp`, ln.Addr().String()),
+		WithHostname(``),
+		WithAppName(``),
+		WithProcID(``),
+	))
+
+	logger.Info().Log("line 1\nline 2")
+	logger.Info().Log(`second`)
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	for _, expected := range [...]string{
+		"<14>1 2023-04-05T11:21:19.496235Z - - - - - line 1\nline 2",
+		`<14>1 2023-04-05T11:21:19.496235Z - - - - - second`,
+	} {
+		if s, err := readFrame(r); err != nil {
+			t.Fatal(err)
+		} else if s != expected {
+			t.Errorf("unexpected output: %q", s)
+		}
+	}
+
+	// close the server side, the logger should reconnect
+	_ = conn.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		logger.Info().Log(`reconnected`)
+		select {
+		case conn := <-accepted:
+			defer conn.Close()
+			if s, err := readFrame(bufio.NewReader(conn)); err != nil {
+				t.Fatal(err)
+			} else if s != `<14>1 2023-04-05T11:21:19.496235Z - - - - - reconnected` {
+				t.Errorf("unexpected output: %q", s)
+			}
+			return
+		case <-timer.C:
+			t.Fatal(`timed out waiting for reconnect`)
+		case <-ticker.C:
+		}
+	}
+}
+
+func TestLogger_unixgram(t *testing.T) {
+	fixedTime(t)
+
+	// note: unix socket paths are length limited
+	dir, err := os.MkdirTemp(``, `syslog`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, `log`)
+
+	conn, err := net.ListenUnixgram(`unixgram`, &net.UnixAddr{Name: path, Net: `unixgram`})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer conn.Close()
+
+	old := unixPaths
+	defer func() { unixPaths = old }()
+	unixPaths = []string{filepath.Join(dir, `missing`), path}
+
+	logger := L.New(L.WithSyslog(WithHostname(`h`), WithAppName(`a`), WithProcID(`p`)))
+	logger.Emerg().Log(`emergency`)
+
+	buf := make([]byte, 1<<16)
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(buf[:n]); s != `<8>1 2023-04-05T11:21:19.496235Z h a p - - emergency` {
+		t.Errorf("unexpected output: %q", s)
+	}
+}
+
+func TestLogger_unixStream(t *testing.T) {
+	fixedTime(t)
+
+	// note: unix socket paths are length limited
+	dir, err := os.MkdirTemp(``, `syslog`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, `log`)
+
+	ln, err := net.Listen(`unix`, path)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ln.Close()
+
+	// the unixgram dial will fail, falling back to unix (stream)
+	old := unixPaths
+	defer func() { unixPaths = old }()
+	unixPaths = []string{path}
+
+	logger := L.New(L.WithSyslog(WithHostname(`h`), WithAppName(`a`), WithProcID(`p`)))
+	logger.Info().Log(`first`)
+	logger.Info().Log(`second`)
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	for _, expected := range [...]string{
+		"<14>1 2023-04-05T11:21:19.496235Z h a p - - first\n",
+		"<14>1 2023-04-05T11:21:19.496235Z h a p - - second\n",
+	} {
+		if s, err := r.ReadString('\n'); err != nil {
+			t.Fatal(err)
+		} else if s != expected {
+			t.Errorf("unexpected output: %q", s)
+		}
+	}
+}
+
+func TestLogger_Write_dialError(t *testing.T) {
+	old := unixPaths
+	defer func() { unixPaths = old }()
+	unixPaths = []string{filepath.Join(t.TempDir(), `missing`)}
+
+	l := NewLogger()
+	logger := L.New(L.WithWriter(l), L.WithEventFactory(l))
+	if err := logger.Log(logiface.LevelError, nil); err == nil {
+		t.Error(`expected error`)
+	}
+	if err := l.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewLogger_defaults(t *testing.T) {
+	l := NewLogger()
+	if l.facility != User || l.format != RFC5424 || l.timeout != DefaultTimeout || l.sdID != DefaultStructuredDataID {
+		t.Errorf(`unexpected logger: %+v`, l)
+	}
+	if l.procID != strconv.Itoa(os.Getpid()) {
+		t.Error(l.procID)
+	}
+	if l.appName != filepath.Base(os.Args[0]) {
+		t.Error(l.appName)
+	}
+}
+
+func TestAppendParamName(t *testing.T) {
+	for _, tc := range [...]struct {
+		val, out string
+	}{
+		{``, `_`},
+		{`a b=c]d"e`, `a_b_c_d_e`},
+		{strings.Repeat(`x`, 32), strings.Repeat(`x`, 32)},
+		{strings.Repeat(`x`, 31) + `yz`, strings.Repeat(`x`, 31) + `y`},
+	} {
+		if s := string(appendParamName(nil, tc.val)); s != tc.out {
+			t.Errorf("%q: unexpected output: %q", tc.val, s)
+		}
+	}
+	if l := NewLogger(WithStructuredDataID(strings.Repeat(`id@`, 20))); len(l.sdID) != 32 {
+		t.Errorf("unexpected SD-ID: %q", l.sdID)
+	}
+}
@@ -0,0 +1,103 @@
+package logiface
+
+import (
+	"sync"
+)
+
+type (
+	// tailWriter implements the per-scope buffering, for Context.TailBuffer
+	tailWriter[E Event] struct {
+		parent *loggerShared[E]
+		flush  Level
+		limit  int
+
+		mu      sync.Mutex
+		events  []E
+		flushed bool
+	}
+)
+
+// TailBuffer configures the sub-logger to capture events at every level,
+// buffering (in order) those that the parent logger would not write, i.e.
+// those below the parent's level. Events that the parent would write are
+// written immediately. If an event at or above the flush level (e.g.
+// [LevelError]) is logged, by the sub-logger or any logger derived from it,
+// all buffered events are written, in order, prior to that event, after which
+// all events will be written immediately.
+//
+// This is intended to be used to derive a logger per scope (e.g. request),
+// providing debug context only for failed requests, without paying the cost
+// of writing it for successful ones. The limit bounds the number of buffered
+// events, discarding the oldest, and is unbounded if non-positive.
+//
+// Buffered events are retained, i.e. they are not released until they are
+// written, or discarded. Any events remaining in the buffer are simply
+// garbage collected. Note that the sub-logger's [Logger.Level] will be
+// [LevelTrace].
+//
+// This method should be called immediately after [Logger.Clone], and, unlike
+// most Context methods, it does not apply to loggers already derived from
+// this Context.
+func (x *Context[E]) TailBuffer(flush Level, limit int) *Context[E] {
+	if !x.Enabled() || !x.logger.Level().Enabled() {
+		return x
+	}
+
+	w := tailWriter[E]{
+		parent: x.logger.shared,
+		flush:  flush,
+		limit:  limit,
+	}
+
+	x.derive(func(shared *loggerShared[E]) {
+		shared.writer = &w
+		// released by the tailWriter, once written or discarded
+		shared.releaser = nil
+		shared.levelVar = nil
+		shared.level = LevelTrace
+	})
+
+	return x
+}
+
+func (x *tailWriter[E]) Write(event E) error {
+	level := event.Level()
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if !x.flushed && level.Enabled() && !level.Custom() && level <= x.flush {
+		x.flushed = true
+		events := x.events
+		x.events = nil
+		for _, e := range events {
+			_ = x.write(e)
+		}
+	}
+
+	if x.flushed || level > LevelTrace || level <= x.parent.currentLevel() {
+		return x.write(event)
+	}
+
+	if x.limit > 0 && len(x.events) >= x.limit {
+		x.release(x.events[0])
+		var zero E
+		x.events[0] = zero
+		x.events = x.events[1:]
+	}
+	x.events = append(x.events, event)
+
+	return nil
+}
+
+// write writes then releases the event, using the parent
+func (x *tailWriter[E]) write(event E) error {
+	defer x.release(event)
+	return x.parent.writer.Write(event)
+}
+
+func (x *tailWriter[E]) release(event E) {
+	if x.parent.releaser != nil {
+		x.parent.releaser.ReleaseEvent(event)
+	}
+}
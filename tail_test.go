@@ -0,0 +1,133 @@
+package logiface
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestContext_TailBuffer(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	released := make(map[*mockSimpleEvent]int)
+	logger := New(
+		WithEventFactory[*mockSimpleEvent](EventFactoryFunc[*mockSimpleEvent](mockSimpleEventFactory)),
+		WithEventReleaser[*mockSimpleEvent](EventReleaserFunc[*mockSimpleEvent](func(event *mockSimpleEvent) { released[event]++ })),
+		WithWriter[*mockSimpleEvent](&mockSimpleWriter{Writer: &buf}),
+	)
+
+	scope := logger.Clone().TailBuffer(LevelError, 0).Str(`req`, `1`).Logger()
+	if scope.Level() != LevelTrace {
+		t.Error(scope.Level())
+	}
+
+	scope.Debug().Log(`a`)
+	scope.Info().Log(`b`)
+	scope.Trace().Log(`c`)
+	scope.Clone().Str(`sub`, `x`).Logger().Debug().Log(`d`)
+	scope.Build(9).Log(`e`)
+	scope.Warning().Log(`f`)
+	logger.Debug().Log(`not in scope`)
+	logger.Info().Log(`g`)
+
+	if s := buf.String(); s != "[info] req=1 msg=b\n[9] req=1 msg=e\n[warning] req=1 msg=f\n[info] msg=g\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	if len(released) != 4 {
+		t.Errorf("unexpected released: %d", len(released))
+	}
+
+	buf.Reset()
+	scope.Err().Log(`h`)
+	scope.Trace().Log(`i`)
+	if s := buf.String(); s != "[debug] req=1 msg=a\n[trace] req=1 msg=c\n[debug] req=1 sub=x msg=d\n[err] req=1 msg=h\n[trace] req=1 msg=i\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	if len(released) != 9 {
+		t.Errorf("unexpected released: %d", len(released))
+	}
+	for event, n := range released {
+		if n != 1 {
+			t.Errorf("event released %d times: %v", n, event)
+		}
+	}
+}
+
+func TestContext_TailBuffer_limit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	var released int
+	logger := New(
+		WithEventFactory[*mockSimpleEvent](EventFactoryFunc[*mockSimpleEvent](mockSimpleEventFactory)),
+		WithEventReleaser[*mockSimpleEvent](EventReleaserFunc[*mockSimpleEvent](func(event *mockSimpleEvent) { released++ })),
+		WithWriter[*mockSimpleEvent](&mockSimpleWriter{Writer: &buf}),
+		WithLevel[*mockSimpleEvent](LevelWarning),
+	)
+
+	scope := logger.Clone().TailBuffer(LevelCritical, 2).Logger()
+	scope.Info().Log(`1`)
+	scope.Notice().Log(`2`)
+	scope.Debug().Log(`3`)
+	if released != 1 {
+		t.Errorf("unexpected released: %d", released)
+	}
+	scope.Err().Log(`4`)
+	if buf.Len() != len("[err] msg=4\n") {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+	if err := scope.Log(LevelCritical, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "[err] msg=4\n[notice] msg=2\n[debug] msg=3\n[crit]\n" {
+		t.Errorf("unexpected output: %q\n%s", s, s)
+	}
+	if released != 5 {
+		t.Errorf("unexpected released: %d", released)
+	}
+}
+
+func TestContext_TailBuffer_fatal(t *testing.T) {
+	old := OsExit
+	defer func() { OsExit = old }()
+
+	var buf bytes.Buffer
+	logger := New(
+		WithEventFactory[*mockSimpleEvent](EventFactoryFunc[*mockSimpleEvent](mockSimpleEventFactory)),
+		WithWriter[*mockSimpleEvent](&mockSimpleWriter{Writer: &buf}),
+	)
+	scope := logger.Clone().TailBuffer(LevelError, 0).Logger()
+
+	var exited bool
+	OsExit = func(code int) {
+		if s := buf.String(); s != "[debug] msg=a\n[alert] msg=b\n" {
+			t.Errorf("unexpected output: %q\n%s", s, s)
+		}
+		exited = true
+	}
+
+	scope.Debug().Log(`a`)
+	scope.Fatal().Log(`b`)
+	if !exited {
+		t.Error(`expected exit`)
+	}
+}
+
+func TestContext_TailBuffer_disabled(t *testing.T) {
+	t.Parallel()
+
+	var c *Context[*mockSimpleEvent]
+	if c.TailBuffer(LevelError, 0) != nil {
+		t.Error(`expected nil`)
+	}
+
+	logger := New(
+		WithEventFactory[*mockSimpleEvent](EventFactoryFunc[*mockSimpleEvent](mockSimpleEventFactory)),
+		WithWriter[*mockSimpleEvent](&mockSimpleWriter{Writer: new(bytes.Buffer)}),
+		WithLevel[*mockSimpleEvent](LevelDisabled),
+	)
+	c = logger.Clone()
+	if l := c.TailBuffer(LevelError, 0).Logger(); l.Level() != LevelDisabled || l.Err() != nil {
+		t.Error(`expected disabled`)
+	}
+}